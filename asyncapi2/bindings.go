@@ -0,0 +1,163 @@
+package asyncapi2
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+	"github.com/charlie-haley/asyncapi-go/internal/validation"
+	"github.com/charlie-haley/asyncapi-go/spec"
+)
+
+// ValidateBindings validates every binding in the document against the
+// official binding schema for its declared bindingVersion. Bindings for
+// protocols without a published schema, such as custom bindings, are skipped.
+func (d *Document) ValidateBindings() spec.Diagnostics {
+	var diags spec.Diagnostics
+	d.forEachBindings(func(raw map[string]any, kind validation.BindingKind, path ...string) {
+		for _, protocol := range sortedKeys(raw) {
+			basePath := spec.JSONPointer(append(path, protocol)...)
+			diags = append(diags, validation.ValidateBinding(basePath, protocol, kind, raw[protocol])...)
+		}
+	})
+	return diags
+}
+
+// DecodedBindings holds the decoded bindings of a document, keyed by the JSON
+// pointer of the object they're attached to, or of the bindings object for
+// the reusable bindings in components, and then by protocol
+type DecodedBindings map[string]map[string]any
+
+// Get returns the bindings of the object at a path, e.g.
+// Get("channels", "user/signedup", "publish", "message")
+func (b DecodedBindings) Get(path ...string) map[string]any {
+	return b[spec.JSONPointer(path...)]
+}
+
+// DecodeBindings decodes every binding in the document into the type
+// registered for its protocol and kind of object in bindings.Default, such as
+// *kafka.ChannelBinding for the kafka binding of a channel. Bindings without
+// a registered type, such as custom bindings, are kept as the maps they were
+// parsed as. Binding packages register their types when imported, and
+// bindings/all imports every built-in one.
+//
+// Bindings are decoded according to their declared bindingVersion. Fields
+// that version doesn't define are left out of a binding, and bindings that
+// fail to decode are left out of the result. Both are reported together in
+// the returned error, where fields are reported as a *bindings.VersionError.
+func (d *Document) DecodeBindings() (DecodedBindings, error) {
+	decoded := DecodedBindings{}
+	var errs []error
+	d.forEachBindings(func(raw map[string]any, kind validation.BindingKind, path ...string) {
+		if len(raw) == 0 {
+			return
+		}
+		// Reusable bindings in components aren't attached to an object
+		objectPath := spec.JSONPointer(path...)
+		if !isReusableBindings(path) {
+			objectPath = spec.JSONPointer(path[:len(path)-1]...)
+		}
+		decoded[objectPath] = make(map[string]any, len(raw))
+		for _, protocol := range sortedKeys(raw) {
+			binding, err := bindings.Decode(protocol, bindings.Kind(kind), raw[protocol])
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", spec.JSONPointer(append(path, protocol)...), err))
+			}
+			if binding != nil {
+				decoded[objectPath][protocol] = binding
+			}
+		}
+	})
+	return decoded, errors.Join(errs...)
+}
+
+// MigrateBindings upgrades every binding in the document with versions
+// registered in bindings.Default to the latest version, replacing them in
+// place. Bindings that fail to migrate are left unchanged, and reported
+// together in the returned error along with the fields that were dropped
+// because their declared version didn't define them.
+func (d *Document) MigrateBindings() error {
+	var errs []error
+	d.forEachBindings(func(raw map[string]any, kind validation.BindingKind, path ...string) {
+		for _, protocol := range sortedKeys(raw) {
+			if _, ok := bindings.Default.LookupVersions(protocol, bindings.Kind(kind)); !ok {
+				continue
+			}
+			migrated, err := bindings.Migrate(protocol, bindings.Kind(kind), raw[protocol])
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", spec.JSONPointer(append(path, protocol)...), err))
+			}
+			if migrated != nil {
+				raw[protocol] = migrated
+			}
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// isReusableBindings reports whether the bindings object at path is one of
+// the reusable bindings in components, such as components/channelBindings/x
+func isReusableBindings(path []string) bool {
+	return len(path) == 3 && path[0] == "components" && strings.HasSuffix(path[1], "Bindings")
+}
+
+// forEachBindings calls fn with the bindings of every server, channel,
+// operation and message in the document, and the reusable bindings in
+// components, along with the kind of object and the path segments locating
+// the bindings object
+func (d *Document) forEachBindings(fn func(raw map[string]any, kind validation.BindingKind, path ...string)) {
+	message := func(message *Message, path ...string) {
+		if message != nil {
+			fn(message.Bindings, validation.MessageBinding, append(path, "bindings")...)
+		}
+	}
+
+	for _, name := range d.Servers.Keys() {
+		if server, _ := d.Servers.Get(name); server != nil {
+			fn(server.Bindings, validation.ServerBinding, "servers", name, "bindings")
+		}
+	}
+
+	for _, name := range d.Channels.Keys() {
+		channel, _ := d.Channels.Get(name)
+		if channel == nil {
+			continue
+		}
+		fn(channel.Bindings, validation.ChannelBinding, "channels", name, "bindings")
+		for _, op := range channelOperations(channel) {
+			fn(op.operation.Bindings, validation.OperationBinding, "channels", name, op.kind, "bindings")
+			message(op.operation.Message, "channels", name, op.kind, "message")
+		}
+	}
+
+	if d.Components == nil {
+		return
+	}
+	for _, name := range d.Components.Servers.Keys() {
+		if server, _ := d.Components.Servers.Get(name); server != nil {
+			fn(server.Bindings, validation.ServerBinding, "components", "servers", name, "bindings")
+		}
+	}
+	for _, name := range d.Components.Messages.Keys() {
+		m, _ := d.Components.Messages.Get(name)
+		message(m, "components", "messages", name)
+	}
+
+	reusable := []struct {
+		field    string
+		bindings *OrderedMap[map[string]any]
+		kind     validation.BindingKind
+	}{
+		{"serverBindings", d.Components.ServerBindings, validation.ServerBinding},
+		{"channelBindings", d.Components.ChannelBindings, validation.ChannelBinding},
+		{"operationBindings", d.Components.OperationBindings, validation.OperationBinding},
+		{"messageBindings", d.Components.MessageBindings, validation.MessageBinding},
+	}
+	for _, r := range reusable {
+		for _, name := range r.bindings.Keys() {
+			raw, _ := r.bindings.Get(name)
+			fn(raw, r.kind, "components", r.field, name)
+		}
+	}
+}
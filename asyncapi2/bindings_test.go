@@ -0,0 +1,132 @@
+package asyncapi2
+
+import (
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/all"
+	"github.com/charlie-haley/asyncapi-go/bindings/amqp"
+	"github.com/charlie-haley/asyncapi-go/bindings/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type carrierBinding struct {
+	Carrier string `json:"carrier"`
+}
+
+func TestDecodeBindings(t *testing.T) {
+	bindings.Register("carrier-test", bindings.Channel, func() any { return &carrierBinding{} })
+
+	doc := NewDocument().
+		WithServer("production", NewServer().
+			WithProtocol("kafka").
+			WithBinding("kafka", map[string]any{"schemaRegistryUrl": "https://registry.example.com"})).
+		WithChannel("user/signedup", NewChannel().
+			WithBinding("kafka", map[string]any{"topic": "user-signedup"}).
+			WithBinding("carrier-test", map[string]any{"carrier": "pigeon"}).
+			WithBinding("unknown", map[string]any{"foo": "bar"}).
+			WithPublish(NewOperation().
+				WithBinding("amqp", map[string]any{"expiration": 100}).
+				WithMessage(NewMessage().WithBinding("kafka", map[string]any{"key": map[string]any{"type": "string"}})))).
+		WithComponents(NewComponents().
+			WithMessage("userSignedUp", NewMessage().WithBinding("amqp", map[string]any{"contentEncoding": "gzip"})))
+
+	decoded, err := doc.DecodeBindings()
+	require.NoError(t, err)
+
+	assert.Equal(t, &kafka.ServerBinding{SchemaRegistryURL: "https://registry.example.com"}, decoded.Get("servers", "production")["kafka"])
+
+	channelBindings := decoded.Get("channels", "user/signedup")
+	assert.Equal(t, &kafka.ChannelBinding{Topic: "user-signedup"}, channelBindings["kafka"])
+	assert.Equal(t, &carrierBinding{Carrier: "pigeon"}, channelBindings["carrier-test"])
+	assert.Equal(t, map[string]any{"foo": "bar"}, channelBindings["unknown"])
+
+	assert.Equal(t, &amqp.OperationBinding{Expiration: 100}, decoded.Get("channels", "user/signedup", "publish")["amqp"])
+	assert.Equal(t, &kafka.MessageBinding{Key: map[string]any{"type": "string"}}, decoded.Get("channels", "user/signedup", "publish", "message")["kafka"])
+	assert.Equal(t, &amqp.MessageBinding{ContentEncoding: "gzip"}, decoded.Get("components", "messages", "userSignedUp")["amqp"])
+	assert.Nil(t, decoded.Get("channels", "missing"))
+}
+
+func TestDecodeBindings_Components(t *testing.T) {
+	doc := NewDocument().
+		WithComponents(NewComponents().
+			WithServerBindings("registry", map[string]any{"kafka": map[string]any{"schemaRegistryUrl": "https://registry.example.com"}}).
+			WithChannelBindings("topic", map[string]any{"kafka": map[string]any{"topic": "user-signedup"}}).
+			WithOperationBindings("expiring", map[string]any{"amqp": map[string]any{"expiration": 100}}).
+			WithMessageBindings("keyed", map[string]any{"kafka": map[string]any{"key": map[string]any{"type": "string"}}}))
+
+	decoded, err := doc.DecodeBindings()
+	require.NoError(t, err)
+	assert.Equal(t, &kafka.ServerBinding{SchemaRegistryURL: "https://registry.example.com"}, decoded.Get("components", "serverBindings", "registry")["kafka"])
+	assert.Equal(t, &kafka.ChannelBinding{Topic: "user-signedup"}, decoded.Get("components", "channelBindings", "topic")["kafka"])
+	assert.Equal(t, &amqp.OperationBinding{Expiration: 100}, decoded.Get("components", "operationBindings", "expiring")["amqp"])
+	assert.Equal(t, &kafka.MessageBinding{Key: map[string]any{"type": "string"}}, decoded.Get("components", "messageBindings", "keyed")["kafka"])
+}
+
+func TestValidateBindings_Components(t *testing.T) {
+	doc := NewDocument().
+		WithComponents(NewComponents().
+			WithChannelBindings("topic", map[string]any{"kafka": map[string]any{"partitions": "ten"}}))
+
+	diags := doc.ValidateBindings()
+	require.Len(t, diags, 1)
+	assert.Equal(t, "/components/channelBindings/topic/kafka/partitions", diags[0].Path)
+}
+
+func TestDecodeBindings_Invalid(t *testing.T) {
+	doc := NewDocument().
+		WithChannel("a", NewChannel().
+			WithBinding("kafka", map[string]any{"partitions": "ten"}).
+			WithBinding("unknown", map[string]any{"foo": "bar"}))
+
+	decoded, err := doc.DecodeBindings()
+	assert.ErrorContains(t, err, "/channels/a/bindings/kafka: failed to unmarshal kafka channel binding")
+	assert.Equal(t, map[string]any{"unknown": map[string]any{"foo": "bar"}}, decoded.Get("channels", "a"))
+}
+
+func TestDecodeBindings_Version(t *testing.T) {
+	doc := NewDocument().
+		WithChannel("a", NewChannel().
+			WithBinding("kafka", map[string]any{
+				"topic":              "a",
+				"topicConfiguration": map[string]any{"cleanup.policy": []any{"delete"}},
+				"bindingVersion":     "0.3.0",
+			}))
+
+	decoded, err := doc.DecodeBindings()
+	var versionErr *bindings.VersionError
+	require.ErrorAs(t, err, &versionErr)
+	assert.Equal(t, []string{"/topicConfiguration"}, versionErr.Fields)
+	assert.ErrorContains(t, err, "/channels/a/bindings/kafka: kafka channel binding version 0.3.0 doesn't define /topicConfiguration")
+	assert.Equal(t, &kafka.ChannelBinding{Topic: "a", BindingVersion: "0.3.0"}, decoded.Get("channels", "a")["kafka"])
+}
+
+func TestMigrateBindings(t *testing.T) {
+	doc := NewDocument().
+		WithServer("production", NewServer().
+			WithProtocol("kafka").
+			WithBinding("kafka", map[string]any{"schemaRegistryUrl": "https://registry.example.com", "bindingVersion": "0.3.0"})).
+		WithChannel("a", NewChannel().
+			WithBinding("unknown", map[string]any{"bindingVersion": "0.1.0"}).
+			WithPublish(NewOperation().
+				WithBinding("amqp", map[string]any{"replyTo": "a.reply", "bindingVersion": "0.2.0"})).
+			WithSubscribe(NewOperation().
+				WithBinding("kafka", map[string]any{"groupId": "a", "bindingVersion": "0.0.1"})))
+
+	err := doc.MigrateBindings()
+	assert.EqualError(t, err, `/channels/a/subscribe/bindings/kafka: unsupported kafka operation binding version "0.0.1", expected one of [0.1.0 0.3.0 0.4.0 0.5.0]`)
+
+	server, _ := doc.Servers.Get("production")
+	assert.Equal(t, map[string]any{"schemaRegistryUrl": "https://registry.example.com", "bindingVersion": kafka.BindingVersion}, server.Bindings["kafka"])
+
+	channel, _ := doc.Channels.Get("a")
+	assert.Equal(t, map[string]any{"bindingVersion": amqp.BindingVersion}, channel.Publish.Bindings["amqp"])
+	assert.Equal(t, map[string]any{"groupId": "a", "bindingVersion": "0.0.1"}, channel.Subscribe.Bindings["kafka"])
+	assert.Equal(t, map[string]any{"bindingVersion": "0.1.0"}, channel.Bindings["unknown"])
+
+	// Only the binding that failed to migrate is still invalid
+	diags := doc.ValidateBindings()
+	require.Len(t, diags, 1)
+	assert.Equal(t, "/channels/a/subscribe/bindings/kafka/bindingVersion", diags[0].Path)
+}
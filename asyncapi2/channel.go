@@ -1,17 +1,27 @@
 package asyncapi2
 
 type Channel struct {
-	Description string                `json:"description,omitempty"`
-	Parameters  map[string]*Parameter `json:"parameters,omitempty"`
-	Publish     *Operation            `json:"publish,omitempty"`
-	Subscribe   *Operation            `json:"subscribe,omitempty"`
-	Bindings    map[string]any        `json:"bindings,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Parameters  *OrderedMap[*Parameter] `json:"parameters,omitempty"`
+	Publish     *Operation              `json:"publish,omitempty"`
+	Subscribe   *Operation              `json:"subscribe,omitempty"`
+	Servers     []string                `json:"servers,omitempty"`
+	Bindings    map[string]any          `json:"bindings,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the rest of the channel
+// when a parameter has the wrong type
+func (c *Channel) UnmarshalJSON(data []byte) error {
+	type Temp Channel
+	aux := &Temp{}
+	err := unmarshalLenient(data, aux)
+	*c = Channel(*aux)
+	return err
 }
 
 func NewChannel() *Channel {
 	return &Channel{
-		Parameters: make(map[string]*Parameter),
-		Bindings:   make(map[string]any),
+		Bindings: make(map[string]any),
 	}
 }
 
@@ -21,7 +31,10 @@ func (c *Channel) WithDescription(description string) *Channel {
 }
 
 func (c *Channel) WithParameter(name string, parameter *Parameter) *Channel {
-	c.Parameters[name] = parameter
+	if c.Parameters == nil {
+		c.Parameters = NewOrderedMap[*Parameter]()
+	}
+	c.Parameters.Set(name, parameter)
 	return c
 }
 
@@ -35,6 +48,11 @@ func (c *Channel) WithSubscribe(operation *Operation) *Channel {
 	return c
 }
 
+func (c *Channel) WithServer(name string) *Channel {
+	c.Servers = append(c.Servers, name)
+	return c
+}
+
 func (c *Channel) WithBinding(name string, binding any) *Channel {
 	c.Bindings[name] = binding
 	return c
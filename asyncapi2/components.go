@@ -1,30 +1,81 @@
 package asyncapi2
 
 type Components struct {
-	Messages map[string]*Message `json:"messages,omitempty"`
-	Schemas  map[string]any      `json:"schemas,omitempty"`
-	Servers  map[string]*Server  `json:"servers,omitempty"`
+	Messages          *OrderedMap[*Message]       `json:"messages,omitempty"`
+	Schemas           *OrderedMap[any]            `json:"schemas,omitempty"`
+	Servers           *OrderedMap[*Server]        `json:"servers,omitempty"`
+	ServerBindings    *OrderedMap[map[string]any] `json:"serverBindings,omitempty"`
+	ChannelBindings   *OrderedMap[map[string]any] `json:"channelBindings,omitempty"`
+	OperationBindings *OrderedMap[map[string]any] `json:"operationBindings,omitempty"`
+	MessageBindings   *OrderedMap[map[string]any] `json:"messageBindings,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the rest of the
+// components when one has the wrong type
+func (c *Components) UnmarshalJSON(data []byte) error {
+	type Temp Components
+	aux := &Temp{}
+	err := unmarshalLenient(data, aux)
+	*c = Components(*aux)
+	return err
 }
 
 func NewComponents() *Components {
-	return &Components{
-		Messages: make(map[string]*Message),
-		Schemas:  make(map[string]any),
-		Servers:  make(map[string]*Server),
-	}
+	return &Components{}
 }
 
 func (c *Components) WithMessage(name string, message *Message) *Components {
-	c.Messages[name] = message
+	if c.Messages == nil {
+		c.Messages = NewOrderedMap[*Message]()
+	}
+	c.Messages.Set(name, message)
 	return c
 }
 
 func (c *Components) WithSchema(name string, schema any) *Components {
-	c.Schemas[name] = schema
+	if c.Schemas == nil {
+		c.Schemas = NewOrderedMap[any]()
+	}
+	c.Schemas.Set(name, schema)
 	return c
 }
 
 func (c *Components) WithServer(name string, server *Server) *Components {
-	c.Servers[name] = server
+	if c.Servers == nil {
+		c.Servers = NewOrderedMap[*Server]()
+	}
+	c.Servers.Set(name, server)
+	return c
+}
+
+func (c *Components) WithServerBindings(name string, bindings map[string]any) *Components {
+	if c.ServerBindings == nil {
+		c.ServerBindings = NewOrderedMap[map[string]any]()
+	}
+	c.ServerBindings.Set(name, bindings)
+	return c
+}
+
+func (c *Components) WithChannelBindings(name string, bindings map[string]any) *Components {
+	if c.ChannelBindings == nil {
+		c.ChannelBindings = NewOrderedMap[map[string]any]()
+	}
+	c.ChannelBindings.Set(name, bindings)
+	return c
+}
+
+func (c *Components) WithOperationBindings(name string, bindings map[string]any) *Components {
+	if c.OperationBindings == nil {
+		c.OperationBindings = NewOrderedMap[map[string]any]()
+	}
+	c.OperationBindings.Set(name, bindings)
+	return c
+}
+
+func (c *Components) WithMessageBindings(name string, bindings map[string]any) *Components {
+	if c.MessageBindings == nil {
+		c.MessageBindings = NewOrderedMap[map[string]any]()
+	}
+	c.MessageBindings.Set(name, bindings)
 	return c
 }
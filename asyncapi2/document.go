@@ -3,24 +3,29 @@ package asyncapi2
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 
 	"github.com/charlie-haley/asyncapi-go/internal/validation"
+	"github.com/charlie-haley/asyncapi-go/spec"
 )
 
 type Document struct {
-	AsyncAPI   string              `json:"asyncapi"`
-	Info       *Info               `json:"info"`
-	Channels   map[string]*Channel `json:"channels"`
-	Servers    map[string]*Server  `json:"servers,omitempty"`
-	Components *Components         `json:"components,omitempty"`
+	AsyncAPI           string                `json:"asyncapi"`
+	Info               *Info                 `json:"info"`
+	DefaultContentType string                `json:"defaultContentType,omitempty"`
+	Channels           *OrderedMap[*Channel] `json:"channels"`
+	Servers            *OrderedMap[*Server]  `json:"servers,omitempty"`
+	Components         *Components           `json:"components,omitempty"`
+
+	// source is the JSON the document was parsed from, with refs resolved
+	source map[string]any
 }
 
 func NewDocument() *Document {
 	return &Document{
 		AsyncAPI:   "2.6.0",
 		Info:       NewInfo(),
-		Channels:   make(map[string]*Channel),
-		Servers:    make(map[string]*Server),
+		Channels:   NewOrderedMap[*Channel](),
 		Components: NewComponents(),
 	}
 }
@@ -30,13 +35,24 @@ func (d *Document) WithInfo(info *Info) *Document {
 	return d
 }
 
+func (d *Document) WithDefaultContentType(contentType string) *Document {
+	d.DefaultContentType = contentType
+	return d
+}
+
 func (d *Document) WithChannel(name string, channel *Channel) *Document {
-	d.Channels[name] = channel
+	if d.Channels == nil {
+		d.Channels = NewOrderedMap[*Channel]()
+	}
+	d.Channels.Set(name, channel)
 	return d
 }
 
 func (d *Document) WithServer(name string, server *Server) *Document {
-	d.Servers[name] = server
+	if d.Servers == nil {
+		d.Servers = NewOrderedMap[*Server]()
+	}
+	d.Servers.Set(name, server)
 	return d
 }
 
@@ -45,6 +61,19 @@ func (d *Document) WithComponents(components *Components) *Document {
 	return d
 }
 
+// WithSource sets the JSON the document was parsed from, with refs resolved
+func (d *Document) WithSource(source map[string]any) *Document {
+	d.source = source
+	return d
+}
+
+// Source returns the JSON the document was parsed from, with refs resolved,
+// including the fields the model doesn't hold such as specification
+// extensions. It's nil for documents built in code.
+func (d *Document) Source() map[string]any {
+	return d.source
+}
+
 func (d *Document) Validate() error {
 	// Basic validation for now
 	if d.AsyncAPI == "" {
@@ -58,7 +87,48 @@ func (d *Document) Validate() error {
 	}
 
 	// Schema validation
-	return validation.ValidateDocument(d)
+	if err := validation.ValidateDocument(d.schemaDocument()); err != nil {
+		return err
+	}
+
+	// Semantic, binding and payload schema validation, only errors fail the document
+	if errs := d.ValidateModel().Errors(); len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// Diagnose runs every validation pass and returns all of their findings.
+// Unlike Validate, it doesn't stop at the first failing pass, so it can be
+// used on documents that don't match the specification. The document is
+// checked against metaSchema, or the official AsyncAPI JSON Schema for its
+// version when metaSchema is nil. An error is returned only if the
+// meta-schema can't be loaded.
+func (d *Document) Diagnose(metaSchema []byte) (spec.Diagnostics, error) {
+	diags, err := validation.DocumentDiagnostics(d.schemaDocument(), metaSchema)
+	if err != nil {
+		return nil, err
+	}
+	return append(diags, d.ValidateModel()...), nil
+}
+
+// schemaDocument returns the JSON checked against the AsyncAPI JSON Schema,
+// the source of a parsed document so fields the model doesn't hold are
+// checked too, or the model for documents built in code. Unknown fields are
+// reported by DiagnoseSource instead.
+func (d *Document) schemaDocument() interface{} {
+	if d.source != nil {
+		return withoutUnknownFields(reflect.TypeOf(Document{}), d.source)
+	}
+	return d
+}
+
+// ValidateModel runs the semantic, binding and payload schema passes, which
+// check the parsed model rather than its JSON
+func (d *Document) ValidateModel() spec.Diagnostics {
+	diags := append(d.ValidateSemantics(), d.ValidateBindings()...)
+	return append(diags, d.ValidateSchemas()...)
 }
 
 // GetVersion implements spec.Document.
@@ -76,11 +146,11 @@ func (d *Document) UnmarshalJSON(data []byte) error {
 	// Create an temp type to prevent infinite recursion
 	type Temp Document
 	aux := &Temp{}
-	if err := json.Unmarshal(data, aux); err != nil {
-		return err
-	}
-	// Copy the data from the temp type to the main struct
+	err := unmarshalLenient(data, aux)
+	// Copy the data from the temp type to the main struct, even if a value
+	// had the wrong type, so that the rest of the document can be inspected
 	*d = Document(*aux)
+	d.linkMessages()
 
-	return nil
+	return err
 }
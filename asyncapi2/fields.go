@@ -0,0 +1,256 @@
+package asyncapi2
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/charlie-haley/asyncapi-go/internal/suggest"
+	"github.com/charlie-haley/asyncapi-go/spec"
+)
+
+// Diagnostic codes reported by DiagnoseSource
+const (
+	CodeUnknownField = "unknown-field"
+	CodeIgnoredField = "ignored-field"
+	CodeDeprecated   = "deprecated"
+)
+
+// specFields lists the fields the specification defines for each object, in
+// the order it lists them. Those the model doesn't hold are dropped when
+// decoding.
+var specFields = map[reflect.Type][]string{
+	reflect.TypeOf(Document{}):  {"asyncapi", "id", "info", "servers", "defaultContentType", "channels", "components", "tags", "externalDocs"},
+	reflect.TypeOf(Info{}):      {"title", "version", "description", "termsOfService", "contact", "license"},
+	reflect.TypeOf(Contact{}):   {"name", "url", "email"},
+	reflect.TypeOf(Server{}):    {"url", "protocol", "protocolVersion", "description", "variables", "security", "tags", "bindings"},
+	reflect.TypeOf(Channel{}):   {"description", "servers", "subscribe", "publish", "parameters", "bindings", "deprecated"},
+	reflect.TypeOf(Operation{}): {"operationId", "summary", "description", "security", "tags", "externalDocs", "bindings", "traits", "message"},
+	reflect.TypeOf(Message{}): {"messageId", "headers", "payload", "correlationId", "schemaFormat", "contentType", "name", "title",
+		"summary", "description", "tags", "externalDocs", "bindings", "examples", "traits", "deprecated", "oneOf"},
+	reflect.TypeOf(Parameter{}): {"description", "schema", "location"},
+	reflect.TypeOf(Tag{}):       {"name", "description", "externalDocs"},
+	reflect.TypeOf(Components{}): {"schemas", "servers", "serverVariables", "channels", "messages", "securitySchemes", "parameters",
+		"correlationIds", "operationTraits", "messageTraits", "serverBindings", "channelBindings", "operationBindings", "messageBindings"},
+}
+
+// deprecatable are the objects whose deprecated field is reported when set
+var deprecatable = map[reflect.Type]string{
+	reflect.TypeOf(Channel{}): "channel",
+	reflect.TypeOf(Message{}): "message",
+}
+
+// DiagnoseSource reports what decoding the JSON of a document into the model
+// hides: unknown fields, with a suggestion when they look like a misspelling,
+// fields of the specification that the model ignores, and channels and
+// messages marked deprecated. source is the document with refs resolved, as
+// decoded by encoding/json. Specification extensions (x-*) are allowed
+// anywhere. Schemas and bindings are free-form and aren't checked.
+func DiagnoseSource(source any) spec.Diagnostics {
+	var diags spec.Diagnostics
+	checkFields(reflect.TypeOf(Document{}), source, nil, &diags)
+	return diags
+}
+
+// FieldOrder returns the fields of the object at path in a document, in the
+// order the specification lists them, and whether the object's fields are
+// known. Maps, such as channels, free-form values, such as schemas and
+// bindings, and objects the model doesn't hold have no known fields.
+func FieldOrder(path ...string) ([]string, bool) {
+	t := reflect.TypeOf(Document{})
+	for _, segment := range path {
+		switch t = elemType(t); t.Kind() {
+		case reflect.Struct:
+			field, ok := jsonFields(t)[segment]
+			if !ok {
+				return nil, false
+			}
+			t = field.Type
+		case reflect.Map, reflect.Slice:
+			t = t.Elem()
+		default:
+			return nil, false
+		}
+	}
+
+	if t = elemType(t); t.Kind() != reflect.Struct {
+		return nil, false
+	}
+	return specFields[t], true
+}
+
+// elemType returns the type a value decodes into, following pointers, with
+// an OrderedMap as the equivalent map type
+func elemType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		if ordered, ok := reflect.Zero(t).Interface().(interface{ valueType() reflect.Type }); ok {
+			return reflect.MapOf(reflect.TypeOf(""), ordered.valueType())
+		}
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// checkFields walks value alongside the type it decodes into
+func checkFields(t reflect.Type, value any, path []string, diags *spec.Diagnostics) {
+	switch t = elemType(t); t.Kind() {
+	case reflect.Struct:
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+		checkObject(t, obj, path, diags)
+	case reflect.Map:
+		obj, ok := value.(map[string]any)
+		if !ok || t.Key().Kind() != reflect.String {
+			return
+		}
+		for _, key := range sortedKeys(obj) {
+			checkFields(t.Elem(), obj[key], appendPath(path, key), diags)
+		}
+	case reflect.Slice:
+		items, ok := value.([]any)
+		if !ok {
+			return
+		}
+		for i, item := range items {
+			checkFields(t.Elem(), item, appendPath(path, fmt.Sprint(i)), diags)
+		}
+	}
+}
+
+func checkObject(t reflect.Type, obj map[string]any, path []string, diags *spec.Diagnostics) {
+	fields := jsonFields(t)
+
+	for _, key := range sortedKeys(obj) {
+		keyPath := appendPath(path, key)
+		if field, ok := fields[key]; ok {
+			checkFields(field.Type, obj[key], keyPath, diags)
+			continue
+		}
+		if strings.HasPrefix(key, "x-") {
+			continue
+		}
+
+		if key == "deprecated" {
+			if kind, ok := deprecatable[t]; ok {
+				if deprecated, _ := obj[key].(bool); deprecated {
+					*diags = append(*diags, spec.Diagnostic{
+						Code:     CodeDeprecated,
+						Severity: spec.SeverityWarning,
+						Path:     spec.JSONPointer(path...),
+						Message:  fmt.Sprintf("%s is deprecated", kind),
+					})
+				}
+				continue
+			}
+		}
+
+		if containsString(specFields[t], key) {
+			*diags = append(*diags, spec.Diagnostic{
+				Code:     CodeIgnoredField,
+				Severity: spec.SeverityInfo,
+				Path:     spec.JSONPointer(keyPath...),
+				Message:  fmt.Sprintf("%s isn't supported yet and is ignored", key),
+			})
+			continue
+		}
+
+		message := fmt.Sprintf("unknown field %q is ignored", key)
+		if suggestion := suggest.Closest(key, specFields[t]); suggestion != "" {
+			message = fmt.Sprintf("unknown field %q is ignored, did you mean %q?", key, suggestion)
+		}
+		*diags = append(*diags, spec.Diagnostic{
+			Code:     CodeUnknownField,
+			Severity: spec.SeverityWarning,
+			Path:     spec.JSONPointer(keyPath...),
+			Message:  message,
+		})
+	}
+}
+
+// withoutUnknownFields returns a copy of value, which decodes into t, without
+// the unknown fields checkObject reports. They're only warned about, so
+// they're left out of the JSON Schema pass rather than failing it.
+func withoutUnknownFields(t reflect.Type, value any) any {
+	switch t = elemType(t); t.Kind() {
+	case reflect.Struct:
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return value
+		}
+		fields := jsonFields(t)
+		out := make(map[string]any, len(obj))
+		for key, v := range obj {
+			if field, ok := fields[key]; ok {
+				out[key] = withoutUnknownFields(field.Type, v)
+				continue
+			}
+			_, deprecated := deprecatable[t]
+			if strings.HasPrefix(key, "x-") || containsString(specFields[t], key) || (key == "deprecated" && deprecated) {
+				out[key] = v
+			}
+		}
+		return out
+	case reflect.Map:
+		obj, ok := value.(map[string]any)
+		if !ok || t.Key().Kind() != reflect.String {
+			return value
+		}
+		out := make(map[string]any, len(obj))
+		for key, v := range obj {
+			out[key] = withoutUnknownFields(t.Elem(), v)
+		}
+		return out
+	case reflect.Slice:
+		items, ok := value.([]any)
+		if !ok {
+			return value
+		}
+		out := make([]any, len(items))
+		for i, item := range items {
+			out[i] = withoutUnknownFields(t.Elem(), item)
+		}
+		return out
+	}
+	return value
+}
+
+// jsonFields maps the JSON names of a struct's exported fields to the fields
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		fields[name] = field
+	}
+	return fields
+}
+
+// appendPath appends a segment to a copy of path, so sibling paths don't
+// share a backing array
+func appendPath(path []string, segment string) []string {
+	out := make([]string, len(path), len(path)+1)
+	copy(out, path)
+	return append(out, segment)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package asyncapi2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnoseSource(t *testing.T) {
+	var source any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"asyncapi": "2.6.0",
+		"info": {"title": "Users", "version": "1.0.0", "x-owner": "identity"},
+		"tags": [{"name": "users"}],
+		"channels": {
+			"user/signedup": {
+				"deprecated": true,
+				"bindngs": {"kafka": {"topic": "user-signedup"}},
+				"subcribe": {"message": {"payload": {"type": "string"}}},
+				"publish": {
+					"message": {"name": "UserSignedUp", "deprecated": true, "payload": {"anything": "goes"}}
+				}
+			},
+			"user/deleted": {"deprecated": false, "x-internal": true}
+		},
+		"servers": {"production": {"url": "broker", "protocol": "kafka", "tagz": []}}
+	}`), &source))
+
+	assert.Equal(t, spec.Diagnostics{
+		{Code: CodeUnknownField, Severity: spec.SeverityWarning, Path: "/channels/user~1signedup/bindngs", Message: `unknown field "bindngs" is ignored, did you mean "bindings"?`},
+		{Code: CodeDeprecated, Severity: spec.SeverityWarning, Path: "/channels/user~1signedup", Message: "channel is deprecated"},
+		{Code: CodeDeprecated, Severity: spec.SeverityWarning, Path: "/channels/user~1signedup/publish/message", Message: "message is deprecated"},
+		{Code: CodeUnknownField, Severity: spec.SeverityWarning, Path: "/channels/user~1signedup/subcribe", Message: `unknown field "subcribe" is ignored, did you mean "subscribe"?`},
+		{Code: CodeUnknownField, Severity: spec.SeverityWarning, Path: "/servers/production/tagz", Message: `unknown field "tagz" is ignored, did you mean "tags"?`},
+		{Code: CodeIgnoredField, Severity: spec.SeverityInfo, Path: "/tags", Message: "tags isn't supported yet and is ignored"},
+	}, DiagnoseSource(source))
+}
+
+func TestDiagnoseSource_NoSuggestion(t *testing.T) {
+	var source any
+	require.NoError(t, json.Unmarshal([]byte(`{"asyncapi": "2.6.0", "owner": "identity"}`), &source))
+
+	diags := DiagnoseSource(source)
+	require.Len(t, diags, 1)
+	assert.Equal(t, `unknown field "owner" is ignored`, diags[0].Message)
+}
+
+func TestSpecFields_CoverModel(t *testing.T) {
+	// Every field the model holds must be in the specification's order
+	for typ, fields := range specFields {
+		for name := range jsonFields(typ) {
+			assert.Contains(t, fields, name, "%s.%s", typ.Name(), name)
+		}
+	}
+}
+
+func TestFieldOrder(t *testing.T) {
+	tests := []struct {
+		path     []string
+		expected string
+		known    bool
+	}{
+		{path: nil, expected: "asyncapi", known: true},
+		{path: []string{"channels", "user/signedup"}, expected: "description", known: true},
+		{path: []string{"channels", "user/signedup", "parameters", "id"}, expected: "description", known: true},
+		{path: []string{"channels", "user/signedup", "publish", "message"}, expected: "messageId", known: true},
+		{path: []string{"channels", "user/signedup", "publish", "tags", "0"}, expected: "name", known: true},
+		{path: []string{"components", "messages", "UserSignedUp"}, expected: "messageId", known: true},
+		{path: []string{"channels"}},
+		{path: []string{"channels", "user/signedup", "bindings", "kafka"}},
+		{path: []string{"components", "schemas", "User"}},
+		{path: []string{"externalDocs"}},
+	}
+
+	for _, tt := range tests {
+		fields, known := FieldOrder(tt.path...)
+		assert.Equal(t, tt.known, known, tt.path)
+		if tt.known {
+			require.NotEmpty(t, fields)
+			assert.Equal(t, tt.expected, fields[0], tt.path)
+		}
+	}
+}
@@ -1,9 +1,16 @@
 package asyncapi2
 
 type Info struct {
-	Title       string `json:"title"`
-	Version     string `json:"version"`
-	Description string `json:"description,omitempty"`
+	Title       string   `json:"title"`
+	Version     string   `json:"version"`
+	Description string   `json:"description,omitempty"`
+	Contact     *Contact `json:"contact,omitempty"`
+}
+
+type Contact struct {
+	Name  string `json:"name,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Email string `json:"email,omitempty"`
 }
 
 func NewInfo() *Info {
@@ -24,3 +31,27 @@ func (i *Info) WithDescription(description string) *Info {
 	i.Description = description
 	return i
 }
+
+func (i *Info) WithContact(contact *Contact) *Info {
+	i.Contact = contact
+	return i
+}
+
+func NewContact() *Contact {
+	return &Contact{}
+}
+
+func (c *Contact) WithName(name string) *Contact {
+	c.Name = name
+	return c
+}
+
+func (c *Contact) WithURL(url string) *Contact {
+	c.URL = url
+	return c
+}
+
+func (c *Contact) WithEmail(email string) *Contact {
+	c.Email = email
+	return c
+}
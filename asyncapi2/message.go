@@ -1,6 +1,6 @@
 package asyncapi2
 
-import "encoding/json"
+import "github.com/charlie-haley/asyncapi-go/jsonnumber"
 
 type Message struct {
 	Headers      any            `json:"headers,omitempty"`
@@ -12,12 +12,16 @@ type Message struct {
 	Description  string         `json:"description,omitempty"`
 	ContentType  string         `json:"contentType,omitempty"`
 	Bindings     map[string]any `json:"bindings,omitempty"`
+
+	// document is the document containing the message, which provides
+	// defaults for the schemaFormat and contentType
+	document *Document
 }
 
 func (m *Message) UnmarshalJSON(data []byte) error {
 	type MessageAlias Message
 	temp := &MessageAlias{}
-	if err := json.Unmarshal(data, temp); err != nil {
+	if err := jsonnumber.Unmarshal(data, temp); err != nil {
 		return err
 	}
 	*m = Message(*temp)
@@ -39,3 +43,23 @@ func (m *Message) WithHeaders(headers any) *Message {
 	m.Headers = headers
 	return m
 }
+
+func (m *Message) WithBinding(name string, binding any) *Message {
+	m.Bindings[name] = binding
+	return m
+}
+
+func (m *Message) WithName(name string) *Message {
+	m.Name = name
+	return m
+}
+
+func (m *Message) WithContentType(contentType string) *Message {
+	m.ContentType = contentType
+	return m
+}
+
+func (m *Message) WithSchemaFormat(schemaFormat string) *Message {
+	m.SchemaFormat = schemaFormat
+	return m
+}
@@ -0,0 +1,203 @@
+package asyncapi2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+)
+
+// OrderedMap is a map from names to values that keeps its keys in the order
+// they were added, or decoded from JSON, so that documents keep the order of
+// their source when they're marshalled
+type OrderedMap[V any] struct {
+	keys   []string
+	values map[string]V
+}
+
+// NewOrderedMap creates an empty OrderedMap
+func NewOrderedMap[V any]() *OrderedMap[V] {
+	return &OrderedMap[V]{values: make(map[string]V)}
+}
+
+// Get returns the value for a key, and whether it's in the map. A nil map is
+// empty.
+func (m *OrderedMap[V]) Get(key string) (V, bool) {
+	if m == nil {
+		var zero V
+		return zero, false
+	}
+	value, ok := m.values[key]
+	return value, ok
+}
+
+// Set sets the value for a key. New keys are added to the end, existing
+// keys keep their position.
+func (m *OrderedMap[V]) Set(key string, value V) {
+	if m.values == nil {
+		m.values = make(map[string]V)
+	}
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// Delete removes a key from the map
+func (m *OrderedMap[V]) Delete(key string) {
+	if m == nil {
+		return
+	}
+	if _, ok := m.values[key]; !ok {
+		return
+	}
+	delete(m.values, key)
+	for i, k := range m.keys {
+		if k == key {
+			m.keys = append(m.keys[:i:i], m.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// Len returns the number of keys in the map
+func (m *OrderedMap[V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.keys)
+}
+
+// Keys returns the keys of the map in order
+func (m *OrderedMap[V]) Keys() []string {
+	if m == nil {
+		return nil
+	}
+	return append([]string(nil), m.keys...)
+}
+
+// valueType returns the type of the map's values. It's safe to call on a nil
+// map so that it can be called on the zero value of the type.
+func (m *OrderedMap[V]) valueType() reflect.Type {
+	return reflect.TypeOf((*V)(nil)).Elem()
+}
+
+// MarshalJSON implements json.Marshaler, writing keys in order
+func (m *OrderedMap[V]) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		keyData, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		valueData, err := json.Marshal(m.values[key])
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %q: %w", key, err)
+		}
+		buf.Write(keyData)
+		buf.WriteByte(':')
+		buf.Write(valueData)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping keys in the order they
+// appear. Like encoding/json, a value with the wrong type doesn't stop the
+// rest of the map being decoded, and the first such error is returned.
+func (m *OrderedMap[V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		// Like encoding/json, null leaves the map unchanged
+		return nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return &json.UnmarshalTypeError{Value: describeToken(token), Type: reflect.TypeOf(m), Offset: dec.InputOffset()}
+	}
+
+	*m = OrderedMap[V]{values: make(map[string]V)}
+	var typeErr error
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := token.(string)
+
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			var unmarshalTypeErr *json.UnmarshalTypeError
+			if !errors.As(err, &unmarshalTypeErr) {
+				return err
+			}
+			if typeErr == nil {
+				typeErr = err
+			}
+		}
+		m.Set(key, value)
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	return typeErr
+}
+
+// describeToken names the kind of a JSON token the way encoding/json does
+// in an UnmarshalTypeError
+func describeToken(token json.Token) string {
+	switch token.(type) {
+	case json.Delim:
+		return "array"
+	case string:
+		return "string"
+	case float64, json.Number:
+		return "number"
+	case bool:
+		return "bool"
+	default:
+		return "null"
+	}
+}
+
+// unmarshalLenient decodes data into v, a pointer to a struct without its
+// own UnmarshalJSON. encoding/json stops decoding an object when one of its
+// fields fails to unmarshal itself, as an OrderedMap does when it holds a
+// value with the wrong type, so the fields are then decoded one at a time to
+// keep the rest of the object.
+func unmarshalLenient(data []byte, v any) error {
+	err := jsonnumber.Unmarshal(data, v)
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if jsonnumber.Unmarshal(data, &fields) != nil {
+		return err
+	}
+	for key, value := range fields {
+		field, marshalErr := json.Marshal(map[string]json.RawMessage{key: value})
+		if marshalErr != nil {
+			continue
+		}
+		// Errors were returned by the first pass
+		_ = jsonnumber.Unmarshal(field, v)
+	}
+	return err
+}
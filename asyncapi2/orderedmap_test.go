@@ -0,0 +1,67 @@
+package asyncapi2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderedMap(t *testing.T) {
+	m := NewOrderedMap[int]()
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("c", 3)
+	m.Set("b", 4)
+	assert.Equal(t, []string{"b", "a", "c"}, m.Keys())
+
+	value, ok := m.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 4, value)
+
+	m.Delete("a")
+	m.Delete("missing")
+	assert.Equal(t, []string{"b", "c"}, m.Keys())
+	assert.Equal(t, 2, m.Len())
+
+	var empty *OrderedMap[int]
+	_, ok = empty.Get("b")
+	assert.False(t, ok)
+	assert.Zero(t, empty.Len())
+	assert.Nil(t, empty.Keys())
+}
+
+func TestOrderedMap_JSON(t *testing.T) {
+	var m OrderedMap[*Server]
+	require.NoError(t, json.Unmarshal([]byte(`{"z": {"url": "z"}, "a": {"url": "a"}, "m": null}`), &m))
+	assert.Equal(t, []string{"z", "a", "m"}, m.Keys())
+
+	data, err := json.Marshal(&m)
+	require.NoError(t, err)
+	assert.Equal(t, `{"z":{"url":"z","protocol":""},"a":{"url":"a","protocol":""},"m":null}`, string(data))
+
+	var typeErr *json.UnmarshalTypeError
+	assert.ErrorAs(t, json.Unmarshal([]byte(`[]`), &m), &typeErr)
+}
+
+func TestDocument_UnmarshalWrongType(t *testing.T) {
+	// A wrong type inside a map doesn't stop the rest of the document being
+	// decoded, as it would for a field of the object holding the map
+	var doc Document
+	err := json.Unmarshal([]byte(`{
+		"asyncapi": "2.6.0",
+		"channels": {
+			"b": {"parameters": {"id": {"description": 1}}, "description": "B"},
+			"a": {"description": "A"}
+		},
+		"servers": {"production": {"url": "broker"}}
+	}`), &doc)
+
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+	assert.Equal(t, []string{"b", "a"}, doc.Channels.Keys())
+	b, _ := doc.Channels.Get("b")
+	assert.Equal(t, "B", b.Description)
+	assert.Equal(t, []string{"production"}, doc.Servers.Keys())
+}
@@ -0,0 +1,126 @@
+package asyncapi2
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/charlie-haley/asyncapi-go/schemaformat"
+	"github.com/charlie-haley/asyncapi-go/spec"
+)
+
+// Codes of the diagnostics reported by ValidateSchemas
+const (
+	CodeInvalidPayloadSchema    = "invalid-payload-schema"
+	CodeUnsupportedSchemaFormat = "unsupported-schema-format"
+)
+
+// ValidateSchemas parses every message payload with the handler registered
+// in schemaformat.Default for the message's schemaFormat, reporting invalid
+// schemas as errors and schema formats without a handler as warnings.
+func (d *Document) ValidateSchemas() spec.Diagnostics {
+	d.linkMessages()
+
+	var diags spec.Diagnostics
+	d.forEachMessage(func(message *Message, path ...string) {
+		if message.Payload == nil {
+			return
+		}
+		payloadPath := spec.JSONPointer(append(path, "payload")...)
+
+		_, err := message.PayloadSchema()
+		var schemaErr *schemaformat.SchemaError
+		switch {
+		case err == nil:
+		case errors.Is(err, schemaformat.ErrUnsupportedSchemaFormat):
+			diags = append(diags, spec.Diagnostic{
+				Code:     CodeUnsupportedSchemaFormat,
+				Severity: spec.SeverityWarning,
+				Path:     spec.JSONPointer(append(path, "schemaFormat")...),
+				Message:  fmt.Sprintf("no handler is registered for schema format %s, the payload can't be checked", message.SchemaFormat),
+			})
+		case errors.As(err, &schemaErr):
+			diags = append(diags, spec.Diagnostic{
+				Code:     CodeInvalidPayloadSchema,
+				Severity: spec.SeverityError,
+				Path:     payloadPath + schemaErr.Path,
+				Message:  schemaErr.Message,
+			})
+		default:
+			diags = append(diags, spec.Diagnostic{
+				Code:     CodeInvalidPayloadSchema,
+				Severity: spec.SeverityError,
+				Path:     payloadPath,
+				Message:  err.Error(),
+			})
+		}
+	})
+	return diags
+}
+
+// EffectiveSchemaFormat returns the message's schemaFormat, defaulting to
+// the AsyncAPI Schema Object format for the version of its document
+func (m *Message) EffectiveSchemaFormat() string {
+	if m.SchemaFormat != "" {
+		return m.SchemaFormat
+	}
+	if m.document != nil && m.document.AsyncAPI != "" {
+		return schemaformat.AsyncAPI + ";version=" + m.document.AsyncAPI
+	}
+	return schemaformat.AsyncAPI
+}
+
+// EffectiveContentType returns the message's contentType, defaulting to the
+// defaultContentType of its document
+func (m *Message) EffectiveContentType() string {
+	if m.ContentType == "" && m.document != nil {
+		return m.document.DefaultContentType
+	}
+	return m.ContentType
+}
+
+// PayloadSchema parses the message's payload with the handler registered in
+// schemaformat.Default for its effective schemaFormat. The concrete type
+// depends on the format, e.g. *schemaformat.AvroSchema for Avro payloads.
+// It returns nil if the message has no payload.
+//
+// Messages are linked to their document, which provides the defaults for the
+// schemaFormat and contentType, when the document is parsed or validated.
+func (m *Message) PayloadSchema() (schemaformat.Schema, error) {
+	return schemaformat.Default.Parse(schemaformat.ParseRequest{
+		SchemaFormat: m.EffectiveSchemaFormat(),
+		Schema:       m.Payload,
+		MessageName:  m.Name,
+	})
+}
+
+// linkMessages points every message at the document, so that they can use
+// its defaults
+func (d *Document) linkMessages() {
+	d.forEachMessage(func(message *Message, path ...string) {
+		message.document = d
+	})
+}
+
+// forEachMessage calls fn for every message defined in channel operations
+// and components, along with the path segments locating it
+func (d *Document) forEachMessage(fn func(message *Message, path ...string)) {
+	for _, name := range d.Channels.Keys() {
+		channel, _ := d.Channels.Get(name)
+		if channel == nil {
+			continue
+		}
+		for _, op := range channelOperations(channel) {
+			if op.operation.Message != nil {
+				fn(op.operation.Message, "channels", name, op.kind, "message")
+			}
+		}
+	}
+
+	if d.Components != nil {
+		for _, name := range d.Components.Messages.Keys() {
+			if message, _ := d.Components.Messages.Get(name); message != nil {
+				fn(message, "components", "messages", name)
+			}
+		}
+	}
+}
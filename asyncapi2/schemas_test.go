@@ -0,0 +1,122 @@
+package asyncapi2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/schemaformat"
+	"github.com/charlie-haley/asyncapi-go/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchemas(t *testing.T) {
+	valid := NewMessage().
+		WithSchemaFormat("application/vnd.apache.avro;version=1.9.0").
+		WithPayload(map[string]any{
+			"type":   "record",
+			"name":   "User",
+			"fields": []any{map[string]any{"name": "id", "type": "long"}},
+		})
+	invalid := NewMessage().
+		WithSchemaFormat("application/vnd.apache.avro+yaml;version=1.9.0").
+		WithPayload(map[string]any{
+			"type":   "record",
+			"name":   "User",
+			"fields": []any{map[string]any{"name": "id", "type": "uuid"}},
+		})
+	// Payloads without a schemaFormat are AsyncAPI Schema Objects
+	jsonSchema := NewMessage().WithPayload(map[string]any{"type": "record"})
+
+	doc := NewDocument().
+		WithInfo(NewInfo().WithTitle("Test").WithVersion("1.0.0")).
+		WithChannel("users", NewChannel().WithSubscribe(NewOperation().WithMessage(valid))).
+		WithChannel("events", NewChannel().WithPublish(NewOperation().WithMessage(jsonSchema))).
+		WithComponents(NewComponents().WithMessage("Invalid", invalid))
+
+	diags := doc.ValidateSchemas()
+	require.Len(t, diags, 2)
+	assert.Equal(t, "/channels/events/publish/message/payload", diags[0].Path)
+	assert.Contains(t, diags[0].Message, "invalid JSON Schema")
+	assert.Equal(t, spec.Diagnostic{
+		Code:     CodeInvalidPayloadSchema,
+		Severity: spec.SeverityError,
+		Path:     "/components/messages/Invalid/payload/fields/0/type",
+		Message:  `invalid Avro schema: unknown type "uuid"`,
+	}, diags[1])
+}
+
+func TestValidateSchemas_UnsupportedSchemaFormat(t *testing.T) {
+	doc := NewDocument().
+		WithInfo(NewInfo().WithTitle("Test").WithVersion("1.0.0")).
+		WithComponents(NewComponents().WithMessage("Raml", NewMessage().
+			WithSchemaFormat("application/raml+yaml;version=1.0").
+			WithPayload(map[string]any{"type": "string"})))
+
+	diags := doc.ValidateSchemas()
+	require.Len(t, diags, 1)
+	assert.Equal(t, CodeUnsupportedSchemaFormat, diags[0].Code)
+	assert.Equal(t, spec.SeverityWarning, diags[0].Severity)
+	assert.Equal(t, "/components/messages/Raml/schemaFormat", diags[0].Path)
+}
+
+func TestMessage_PayloadSchema(t *testing.T) {
+	data := []byte(`{
+		"asyncapi": "2.6.0",
+		"info": {"title": "Test", "version": "1.0.0"},
+		"defaultContentType": "application/vnd.apache.avro+json",
+		"channels": {},
+		"components": {
+			"messages": {
+				"Plain": {"payload": {"type": "string"}},
+				"Avro": {
+					"schemaFormat": "application/vnd.apache.avro;version=1.9.0",
+					"contentType": "avro/binary",
+					"payload": {"type": "record", "name": "User", "fields": [{"name": "id", "type": "long"}]}
+				}
+			}
+		}
+	}`)
+	var doc Document
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	plain, _ := doc.Components.Messages.Get("Plain")
+	assert.Equal(t, "application/vnd.aai.asyncapi;version=2.6.0", plain.EffectiveSchemaFormat())
+	assert.Equal(t, "application/vnd.apache.avro+json", plain.EffectiveContentType())
+	schema, err := plain.PayloadSchema()
+	require.NoError(t, err)
+	assert.IsType(t, &schemaformat.JSONSchemaDocument{}, schema)
+
+	avro, _ := doc.Components.Messages.Get("Avro")
+	assert.Equal(t, "avro/binary", avro.EffectiveContentType())
+	schema, err = avro.PayloadSchema()
+	require.NoError(t, err)
+	require.IsType(t, &schemaformat.AvroSchema{}, schema)
+	assert.Equal(t, "User", schema.(*schemaformat.AvroSchema).Schema.Name)
+}
+
+func TestValidateSchemas_Protobuf(t *testing.T) {
+	valid := NewMessage().
+		WithSchemaFormat("application/vnd.google.protobuf;version=3").
+		WithPayload(`syntax = "proto3"; message User { string id = 1; }`)
+	invalid := NewMessage().
+		WithSchemaFormat("application/vnd.google.protobuf;version=3").
+		WithPayload(`syntax = "proto3"; message User { Missing id = 1; }`)
+	notSource := NewMessage().
+		WithSchemaFormat("application/vnd.google.protobuf;version=3").
+		WithPayload(map[string]any{"type": "object"})
+
+	doc := NewDocument().
+		WithInfo(NewInfo().WithTitle("Test").WithVersion("1.0.0")).
+		WithComponents(NewComponents().
+			WithMessage("Valid", valid).
+			WithMessage("Invalid", invalid).
+			WithMessage("NotSource", notSource))
+
+	diags := doc.ValidateSchemas()
+	require.Len(t, diags, 2)
+	assert.Equal(t, "/components/messages/Invalid/payload", diags[0].Path)
+	assert.Equal(t, "invalid proto definition at 1:35: unknown type Missing", diags[0].Message)
+	assert.Equal(t, "/components/messages/NotSource/payload", diags[1].Path)
+	assert.Contains(t, diags[1].Message, "protobuf payloads must be .proto source")
+}
@@ -0,0 +1,225 @@
+package asyncapi2
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/charlie-haley/asyncapi-go/spec"
+)
+
+// Diagnostic codes reported by ValidateSemantics
+const (
+	CodeDuplicateOperationID     = "duplicate-operation-id"
+	CodeChannelParameterMissing  = "channel-parameter-missing"
+	CodeChannelParameterUnused   = "channel-parameter-unused"
+	CodeChannelServerUndefined   = "channel-server-undefined"
+	CodeBindingProtocolNotServed = "binding-protocol-not-served"
+)
+
+// channelParameterPattern matches parameter expressions such as {userId} in a channel address
+var channelParameterPattern = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// bindingProtocols maps server protocols to the key used for their bindings,
+// for protocols where the two differ.
+var bindingProtocols = map[string]string{
+	"amqps":        "amqp",
+	"https":        "http",
+	"kafka-secure": "kafka",
+	"mqtts":        "mqtt",
+	"secure-mqtt":  "mqtt",
+	"stomps":       "stomp",
+	"wss":          "ws",
+	"websockets":   "ws",
+}
+
+// ValidateSemantics runs checks that the JSON Schema for the specification
+// cannot express, such as uniqueness of operationIds and consistency between
+// channel addresses and their parameters.
+func (d *Document) ValidateSemantics() spec.Diagnostics {
+	var diags spec.Diagnostics
+	diags = append(diags, d.checkOperationIDs()...)
+	diags = append(diags, d.checkChannelParameters()...)
+	diags = append(diags, d.checkChannelServers()...)
+	diags = append(diags, d.checkBindingProtocols()...)
+	return diags
+}
+
+func (d *Document) checkOperationIDs() spec.Diagnostics {
+	var diags spec.Diagnostics
+	seen := make(map[string]string)
+	for _, name := range d.Channels.Keys() {
+		channel, _ := d.Channels.Get(name)
+		if channel == nil {
+			continue
+		}
+		for _, op := range channelOperations(channel) {
+			if op.operation.OperationID == "" {
+				continue
+			}
+			path := spec.JSONPointer("channels", name, op.kind, "operationId")
+			if first, ok := seen[op.operation.OperationID]; ok {
+				diags = append(diags, spec.Diagnostic{
+					Code:     CodeDuplicateOperationID,
+					Severity: spec.SeverityError,
+					Path:     path,
+					Message:  fmt.Sprintf("operationId %q is already used at %s", op.operation.OperationID, first),
+				})
+				continue
+			}
+			seen[op.operation.OperationID] = path
+		}
+	}
+	return diags
+}
+
+func (d *Document) checkChannelParameters() spec.Diagnostics {
+	var diags spec.Diagnostics
+	for _, name := range d.Channels.Keys() {
+		channel, _ := d.Channels.Get(name)
+		if channel == nil {
+			continue
+		}
+
+		inAddress := make(map[string]bool)
+		for _, match := range channelParameterPattern.FindAllStringSubmatch(name, -1) {
+			param := match[1]
+			if inAddress[param] {
+				continue
+			}
+			inAddress[param] = true
+			if _, ok := channel.Parameters.Get(param); !ok {
+				diags = append(diags, spec.Diagnostic{
+					Code:     CodeChannelParameterMissing,
+					Severity: spec.SeverityError,
+					Path:     spec.JSONPointer("channels", name),
+					Message:  fmt.Sprintf("channel address parameter %q is not defined in parameters", param),
+				})
+			}
+		}
+
+		for _, param := range channel.Parameters.Keys() {
+			if !inAddress[param] {
+				diags = append(diags, spec.Diagnostic{
+					Code:     CodeChannelParameterUnused,
+					Severity: spec.SeverityError,
+					Path:     spec.JSONPointer("channels", name, "parameters", param),
+					Message:  fmt.Sprintf("parameter %q does not appear in the channel address", param),
+				})
+			}
+		}
+	}
+	return diags
+}
+
+func (d *Document) checkChannelServers() spec.Diagnostics {
+	var diags spec.Diagnostics
+	for _, name := range d.Channels.Keys() {
+		channel, _ := d.Channels.Get(name)
+		if channel == nil {
+			continue
+		}
+		for i, server := range channel.Servers {
+			if _, ok := d.Servers.Get(server); !ok {
+				diags = append(diags, spec.Diagnostic{
+					Code:     CodeChannelServerUndefined,
+					Severity: spec.SeverityError,
+					Path:     spec.JSONPointer("channels", name, "servers", fmt.Sprint(i)),
+					Message:  fmt.Sprintf("server %q is not defined in servers", server),
+				})
+			}
+		}
+	}
+	return diags
+}
+
+func (d *Document) checkBindingProtocols() spec.Diagnostics {
+	// Without servers there is nothing to compare the bindings against
+	if d.Servers.Len() == 0 {
+		return nil
+	}
+
+	var diags spec.Diagnostics
+	check := func(bindings map[string]any, protocols map[string]bool, path ...string) {
+		for _, protocol := range sortedKeys(bindings) {
+			if protocols[protocol] {
+				continue
+			}
+			diags = append(diags, spec.Diagnostic{
+				Code:     CodeBindingProtocolNotServed,
+				Severity: spec.SeverityWarning,
+				Path:     spec.JSONPointer(append(path, "bindings", protocol)...),
+				Message:  fmt.Sprintf("binding %q does not match the protocol of any server it is served on", protocol),
+			})
+		}
+	}
+
+	for _, name := range d.Servers.Keys() {
+		server, _ := d.Servers.Get(name)
+		if server == nil {
+			continue
+		}
+		check(server.Bindings, map[string]bool{bindingProtocol(server.Protocol): true}, "servers", name)
+	}
+
+	for _, name := range d.Channels.Keys() {
+		channel, _ := d.Channels.Get(name)
+		if channel == nil {
+			continue
+		}
+
+		servers := channel.Servers
+		if len(servers) == 0 {
+			servers = d.Servers.Keys()
+		}
+		protocols := make(map[string]bool)
+		for _, server := range servers {
+			if s, ok := d.Servers.Get(server); ok && s != nil {
+				protocols[bindingProtocol(s.Protocol)] = true
+			}
+		}
+
+		check(channel.Bindings, protocols, "channels", name)
+		for _, op := range channelOperations(channel) {
+			check(op.operation.Bindings, protocols, "channels", name, op.kind)
+			if op.operation.Message != nil {
+				check(op.operation.Message.Bindings, protocols, "channels", name, op.kind, "message")
+			}
+		}
+	}
+	return diags
+}
+
+// bindingProtocol returns the bindings key for a server protocol
+func bindingProtocol(protocol string) string {
+	if key, ok := bindingProtocols[protocol]; ok {
+		return key
+	}
+	return protocol
+}
+
+type channelOperation struct {
+	kind      string
+	operation *Operation
+}
+
+// channelOperations returns the operations defined on a channel in a stable order
+func channelOperations(c *Channel) []channelOperation {
+	var ops []channelOperation
+	if c.Publish != nil {
+		ops = append(ops, channelOperation{kind: "publish", operation: c.Publish})
+	}
+	if c.Subscribe != nil {
+		ops = append(ops, channelOperation{kind: "subscribe", operation: c.Subscribe})
+	}
+	return ops
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
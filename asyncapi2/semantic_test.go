@@ -0,0 +1,123 @@
+package asyncapi2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSemantics_Valid(t *testing.T) {
+	doc := NewDocument().
+		WithInfo(NewInfo().WithTitle("Test").WithVersion("1.0.0")).
+		WithServer("production", NewServer().WithURL("broker:9092").WithProtocol("kafka-secure")).
+		WithChannel("user/{userId}/signedup", NewChannel().
+			WithParameter("userId", NewParameter().WithSchema(map[string]any{"type": "string"})).
+			WithServer("production").
+			WithBinding("kafka", map[string]any{"topic": "user-signedup"}).
+			WithPublish(NewOperation().WithOperationID("userSignedUp")))
+
+	assert.Empty(t, doc.ValidateSemantics())
+	assert.NoError(t, doc.Validate())
+}
+
+func TestValidateSemantics_DuplicateOperationID(t *testing.T) {
+	doc := NewDocument().
+		WithChannel("a", NewChannel().
+			WithPublish(NewOperation().WithOperationID("send")).
+			WithSubscribe(NewOperation().WithOperationID("receive"))).
+		WithChannel("b", NewChannel().
+			WithPublish(NewOperation().WithOperationID("send")))
+
+	diags := doc.ValidateSemantics()
+	require.Len(t, diags, 1)
+	assert.Equal(t, CodeDuplicateOperationID, diags[0].Code)
+	assert.Equal(t, spec.SeverityError, diags[0].Severity)
+	assert.Equal(t, "/channels/b/publish/operationId", diags[0].Path)
+	assert.Contains(t, diags[0].Message, "/channels/a/publish/operationId")
+}
+
+func TestValidateSemantics_ChannelParameters(t *testing.T) {
+	doc := NewDocument().
+		WithChannel("user/{userId}/{action}", NewChannel().
+			WithParameter("userId", NewParameter()).
+			WithParameter("tenant", NewParameter()))
+
+	diags := doc.ValidateSemantics()
+	require.Len(t, diags, 2)
+
+	assert.Equal(t, CodeChannelParameterMissing, diags[0].Code)
+	assert.Equal(t, "/channels/user~1{userId}~1{action}", diags[0].Path)
+	assert.Contains(t, diags[0].Message, `"action"`)
+
+	assert.Equal(t, CodeChannelParameterUnused, diags[1].Code)
+	assert.Equal(t, "/channels/user~1{userId}~1{action}/parameters/tenant", diags[1].Path)
+}
+
+func TestValidateSemantics_UnknownServer(t *testing.T) {
+	doc := NewDocument().
+		WithServer("production", NewServer().WithURL("broker:5672").WithProtocol("amqp")).
+		WithChannel("events", NewChannel().WithServer("production").WithServer("staging"))
+
+	diags := doc.ValidateSemantics()
+	require.Len(t, diags, 1)
+	assert.Equal(t, CodeChannelServerUndefined, diags[0].Code)
+	assert.Equal(t, "/channels/events/servers/1", diags[0].Path)
+}
+
+func TestValidateSemantics_BindingProtocols(t *testing.T) {
+	amqpChannel := map[string]any{"is": "queue", "queue": map[string]any{"name": "events"}}
+	doc := NewDocument().
+		WithServer("kafka", NewServer().WithURL("broker:9092").WithProtocol("kafka").
+			WithBinding("amqp", map[string]any{})).
+		WithServer("rabbit", NewServer().WithURL("broker:5672").WithProtocol("amqp")).
+		WithChannel("events", NewChannel().
+			WithServer("kafka").
+			WithBinding("amqp", amqpChannel).
+			WithPublish(NewOperation().
+				WithBinding("kafka", map[string]any{}).
+				WithMessage(NewMessage().WithBinding("sqs", map[string]any{})))).
+		WithChannel("everywhere", NewChannel().
+			WithBinding("amqp", amqpChannel).
+			WithBinding("kafka", map[string]any{}))
+
+	diags := doc.ValidateSemantics()
+	require.Len(t, diags, 3)
+	for _, diag := range diags {
+		assert.Equal(t, CodeBindingProtocolNotServed, diag.Code)
+		assert.Equal(t, spec.SeverityWarning, diag.Severity)
+	}
+	assert.Equal(t, "/servers/kafka/bindings/amqp", diags[0].Path)
+	assert.Equal(t, "/channels/events/bindings/amqp", diags[1].Path)
+	assert.Equal(t, "/channels/events/publish/message/bindings/sqs", diags[2].Path)
+
+	// Warnings alone do not fail validation
+	doc.WithInfo(NewInfo().WithTitle("Test").WithVersion("1.0.0"))
+	assert.NoError(t, doc.Validate())
+}
+
+func TestValidate_SemanticErrors(t *testing.T) {
+	data := []byte(`{
+		"asyncapi": "2.6.0",
+		"info": {"title": "Test", "version": "1.0.0"},
+		"channels": {
+			"user/{userId}": {
+				"publish": {"operationId": "publishUser"}
+			}
+		}
+	}`)
+
+	var doc Document
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	err := doc.Validate()
+	require.Error(t, err)
+
+	var diags spec.Diagnostics
+	require.ErrorAs(t, err, &diags)
+	require.Len(t, diags, 1)
+	assert.Equal(t, CodeChannelParameterMissing, diags[0].Code)
+	assert.Contains(t, err.Error(), "- /channels/user~1{userId}: channel address parameter \"userId\" is not defined in parameters")
+}
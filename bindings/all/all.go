@@ -0,0 +1,24 @@
+// Package all registers every built-in binding package with
+// bindings.Default. Import it for its side effects when decoding documents
+// with bindings for any protocol, or import the binding packages needed
+// instead:
+//
+//	import _ "github.com/charlie-haley/asyncapi-go/bindings/all"
+package all
+
+import (
+	_ "github.com/charlie-haley/asyncapi-go/bindings/amqp"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/anypointmq"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/googlepubsub"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/http"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/ibmmq"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/jms"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/kafka"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/mqtt"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/nats"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/pulsar"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/sns"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/solace"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/sqs"
+	_ "github.com/charlie-haley/asyncapi-go/bindings/websockets"
+)
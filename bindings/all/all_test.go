@@ -0,0 +1,16 @@
+package all
+
+import (
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistersBuiltIns(t *testing.T) {
+	assert.Subset(t, bindings.Default.Protocols(bindings.Channel), []string{
+		"amqp", "anypointmq", "googlepubsub", "ibmmq", "jms", "kafka", "pulsar",
+		"sns", "sqs", "ws",
+	})
+	assert.Subset(t, bindings.Default.Protocols(bindings.Operation), []string{"http", "mqtt", "nats", "solace"})
+}
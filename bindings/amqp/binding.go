@@ -1,5 +1,29 @@
 package amqp
 
+import "github.com/charlie-haley/asyncapi-go/bindings"
+
 //go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen
 
+// Protocol is the key amqp bindings appear under in a bindings object
+const Protocol = "amqp"
+
 const BindingVersion = "0.3.0"
+
+func init() {
+	bindings.Register(Protocol, bindings.Channel, func() any { return &ChannelBinding{} })
+	bindings.Register(Protocol, bindings.Operation, func() any { return &OperationBinding{} })
+	bindings.Register(Protocol, bindings.Message, func() any { return &MessageBinding{} })
+
+	bindings.RegisterVersions(Protocol, bindings.Channel, bindings.Versions{
+		Supported: []string{"0.2.0", "0.3.0"},
+	})
+	bindings.RegisterVersions(Protocol, bindings.Operation, bindings.Versions{
+		Supported: []string{"0.2.0", "0.3.0"},
+		Fields: []bindings.Field{
+			{Path: "replyTo", Removed: "0.3.0"},
+		},
+	})
+	bindings.RegisterVersions(Protocol, bindings.Message, bindings.Versions{
+		Supported: []string{"0.2.0", "0.3.0"},
+	})
+}
@@ -5,6 +5,7 @@ package amqp
 // This object contains information about the channel representation in AMQP.
 // +binding
 type ChannelBinding struct {
+	// +binding:enum=routingKey;queue
 	Is             string    `json:"is"`
 	Exchange       *Exchange `json:"exchange,omitempty"`
 	Queue          *Queue    `json:"queue,omitempty"`
@@ -13,11 +14,12 @@ type ChannelBinding struct {
 
 // Exchange is the object that defines the exchange properties when the channel is a routing key.
 type Exchange struct {
-	Name       string `json:"name"`
+	Name string `json:"name"`
+	// +binding:enum=topic;direct;fanout;default;headers
 	Type       string `json:"type"`
 	Durable    bool   `json:"durable"`
 	AutoDelete bool   `json:"autoDelete"`
-    VHost      string `json:"vhost,omitempty" default:"\"/\""`
+	VHost      string `json:"vhost,omitempty" default:"\"/\""`
 }
 
 // ExchangeType represents the different types of exchanges.
@@ -35,7 +37,7 @@ type Queue struct {
 	Durable    bool   `json:"durable"`
 	Exclusive  bool   `json:"exclusive"`
 	AutoDelete bool   `json:"autoDelete"`
-    VHost      string `json:"vhost,omitempty" default:"\"/\""`
+	VHost      string `json:"vhost,omitempty" default:"\"/\""`
 }
 
 // ChannelIs represents the different types of channels.
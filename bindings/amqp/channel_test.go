@@ -184,3 +184,16 @@ func TestQueue_WithDefaults(t *testing.T) {
 	q := NewQueue()
 	assert.Equal(t, "/", q.VHost)
 }
+
+func TestChannelBinding_Validate(t *testing.T) {
+	cb := NewChannelBinding().
+		WithIs(ChannelIsRoutingKey).
+		WithExchange(NewExchange().WithName("test").WithType(ExchangeTypeTopic))
+	assert.NoError(t, cb.Validate())
+
+	cb.Is = "stream"
+	cb.Exchange.Type = "random"
+	err := cb.Validate()
+	assert.EqualError(t, err, "is must be one of routingKey, queue, got \"stream\"\n"+
+		"exchange.type must be one of topic, direct, fanout, default, headers, got \"random\"")
+}
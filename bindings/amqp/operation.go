@@ -5,10 +5,13 @@ package amqp
 // This object contains information about the operation representation in AMQP.
 // +binding
 type OperationBinding struct {
-	Expiration     int      `json:"expiration,omitempty"`
-	UserID         string   `json:"userId,omitempty"`
-	CC             []string `json:"cc,omitempty"`
-	Priority       int      `json:"priority,omitempty"`
+	// +binding:min=0
+	Expiration int      `json:"expiration,omitempty"`
+	UserID     string   `json:"userId,omitempty"`
+	CC         []string `json:"cc,omitempty"`
+	Priority   int      `json:"priority,omitempty"`
+	// +binding:min=1
+	// +binding:max=2
 	DeliveryMode   int      `json:"deliveryMode,omitempty"`
 	Mandatory      bool     `json:"mandatory,omitempty"`
 	BCC            []string `json:"bcc,omitempty"`
@@ -93,3 +93,9 @@ ack: true
 	assert.False(t, ob.Timestamp)
 	assert.True(t, ob.Ack)
 }
+
+func TestOperationBinding_Validate(t *testing.T) {
+	assert.NoError(t, NewOperationBinding().Validate())
+	assert.NoError(t, NewOperationBinding().WithDeliveryMode(OperationDeliveryModePersistent).Validate())
+	assert.EqualError(t, NewOperationBinding().WithDeliveryMode(3).Validate(), "deliveryMode must be at most 2, got 3")
+}
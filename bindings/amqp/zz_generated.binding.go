@@ -10,20 +10,21 @@
 package amqp
 
 import (
-
 	"encoding/json"
 	"sigs.k8s.io/yaml"
 
-)
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+
+	"errors"
 
+	"github.com/charlie-haley/asyncapi-go/bindings"
+)
 
 // NewChannelBinding creates a new ChannelBinding object
 func NewChannelBinding() *ChannelBinding {
-	return &ChannelBinding{
-	}
+	return &ChannelBinding{}
 }
 
-
 // WithIs sets the 'is' field of ChannelBinding
 func (obj *ChannelBinding) WithIs(is string) *ChannelBinding {
 	obj.Is = is
@@ -48,30 +49,28 @@ func (obj *ChannelBinding) WithBindingVersion(bindingVersion string) *ChannelBin
 	return obj
 }
 
-
-
 // MarshalYAML is a custom marshaller that converts ChannelBinding to YAML
 func (t ChannelBinding) MarshalYAML() (interface{}, error) {
-    bytes, err := json.Marshal(t)
-    if err != nil {
-        return nil, err
-    }
-    var out interface{}
-    err = yaml.Unmarshal(bytes, &out) 
-    return out, err
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
 }
 
 // UnmarshalYAML is a custom unmarshaler that converts YAML to ChannelBinding
 func (t *ChannelBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
-    var temp interface{}
-    if err := unmarshal(&temp); err != nil {
-        return err
-    }
-    bytes, err := yaml.Marshal(temp)
-    if err != nil {
-        return err
-    }
-    return json.Unmarshal(bytes, t)
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
 }
 
 // MarshalJSON is a custom marshaller that converts ChannelBinding to JSON
@@ -84,10 +83,22 @@ func (t ChannelBinding) MarshalJSON() ([]byte, error) {
 func (t *ChannelBinding) UnmarshalJSON(data []byte) error {
 	type Alias ChannelBinding
 	aux := struct{ *Alias }{Alias: (*Alias)(t)}
-	return json.Unmarshal(data, &aux)
+	return jsonnumber.Unmarshal(data, &aux)
 }
 
-
+// Validate checks the fields of ChannelBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ChannelBinding) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Enum("is", t.Is, "routingKey", "queue"))
+	if t.Exchange != nil {
+		errs = append(errs, bindings.Nest("exchange", t.Exchange.Validate()))
+	}
+	if t.Queue != nil {
+		errs = append(errs, bindings.Nest("queue", t.Queue.Validate()))
+	}
+	return errors.Join(errs...)
+}
 
 // NewExchange creates a new Exchange object
 func NewExchange() *Exchange {
@@ -96,7 +107,6 @@ func NewExchange() *Exchange {
 	}
 }
 
-
 // WithName sets the 'name' field of Exchange
 func (obj *Exchange) WithName(name string) *Exchange {
 	obj.Name = name
@@ -127,9 +137,13 @@ func (obj *Exchange) WithVHost(vhost string) *Exchange {
 	return obj
 }
 
-
-
-
+// Validate checks the fields of Exchange, returning a
+// *bindings.FieldError for each invalid field
+func (t Exchange) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Enum("type", t.Type, "topic", "direct", "fanout", "default", "headers"))
+	return errors.Join(errs...)
+}
 
 // NewQueue creates a new Queue object
 func NewQueue() *Queue {
@@ -138,7 +152,6 @@ func NewQueue() *Queue {
 	}
 }
 
-
 // WithName sets the 'name' field of Queue
 func (obj *Queue) WithName(name string) *Queue {
 	obj.Name = name
@@ -169,17 +182,17 @@ func (obj *Queue) WithVHost(vhost string) *Queue {
 	return obj
 }
 
-
-
-
+// Validate checks the fields of Queue, returning a
+// *bindings.FieldError for each invalid field
+func (t Queue) Validate() error {
+	return nil
+}
 
 // NewMessageBinding creates a new MessageBinding object
 func NewMessageBinding() *MessageBinding {
-	return &MessageBinding{
-	}
+	return &MessageBinding{}
 }
 
-
 // WithContentEncoding sets the 'contentEncoding' field of MessageBinding
 func (obj *MessageBinding) WithContentEncoding(contentEncoding string) *MessageBinding {
 	obj.ContentEncoding = contentEncoding
@@ -198,30 +211,28 @@ func (obj *MessageBinding) WithBindingVersion(bindingVersion string) *MessageBin
 	return obj
 }
 
-
-
 // MarshalYAML is a custom marshaller that converts MessageBinding to YAML
 func (t MessageBinding) MarshalYAML() (interface{}, error) {
-    bytes, err := json.Marshal(t)
-    if err != nil {
-        return nil, err
-    }
-    var out interface{}
-    err = yaml.Unmarshal(bytes, &out) 
-    return out, err
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
 }
 
 // UnmarshalYAML is a custom unmarshaler that converts YAML to MessageBinding
 func (t *MessageBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
-    var temp interface{}
-    if err := unmarshal(&temp); err != nil {
-        return err
-    }
-    bytes, err := yaml.Marshal(temp)
-    if err != nil {
-        return err
-    }
-    return json.Unmarshal(bytes, t)
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
 }
 
 // MarshalJSON is a custom marshaller that converts MessageBinding to JSON
@@ -234,18 +245,20 @@ func (t MessageBinding) MarshalJSON() ([]byte, error) {
 func (t *MessageBinding) UnmarshalJSON(data []byte) error {
 	type Alias MessageBinding
 	aux := struct{ *Alias }{Alias: (*Alias)(t)}
-	return json.Unmarshal(data, &aux)
+	return jsonnumber.Unmarshal(data, &aux)
 }
 
-
+// Validate checks the fields of MessageBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t MessageBinding) Validate() error {
+	return nil
+}
 
 // NewOperationBinding creates a new OperationBinding object
 func NewOperationBinding() *OperationBinding {
-	return &OperationBinding{
-	}
+	return &OperationBinding{}
 }
 
-
 // WithExpiration sets the 'expiration' field of OperationBinding
 func (obj *OperationBinding) WithExpiration(expiration int) *OperationBinding {
 	obj.Expiration = expiration
@@ -306,30 +319,28 @@ func (obj *OperationBinding) WithBindingVersion(bindingVersion string) *Operatio
 	return obj
 }
 
-
-
 // MarshalYAML is a custom marshaller that converts OperationBinding to YAML
 func (t OperationBinding) MarshalYAML() (interface{}, error) {
-    bytes, err := json.Marshal(t)
-    if err != nil {
-        return nil, err
-    }
-    var out interface{}
-    err = yaml.Unmarshal(bytes, &out) 
-    return out, err
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
 }
 
 // UnmarshalYAML is a custom unmarshaler that converts YAML to OperationBinding
 func (t *OperationBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
-    var temp interface{}
-    if err := unmarshal(&temp); err != nil {
-        return err
-    }
-    bytes, err := yaml.Marshal(temp)
-    if err != nil {
-        return err
-    }
-    return json.Unmarshal(bytes, t)
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
 }
 
 // MarshalJSON is a custom marshaller that converts OperationBinding to JSON
@@ -342,7 +353,19 @@ func (t OperationBinding) MarshalJSON() ([]byte, error) {
 func (t *OperationBinding) UnmarshalJSON(data []byte) error {
 	type Alias OperationBinding
 	aux := struct{ *Alias }{Alias: (*Alias)(t)}
-	return json.Unmarshal(data, &aux)
+	return jsonnumber.Unmarshal(data, &aux)
 }
 
-
+// Validate checks the fields of OperationBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t OperationBinding) Validate() error {
+	var errs []error
+	if t.Expiration != 0 {
+		errs = append(errs, bindings.Min("expiration", t.Expiration, 0))
+	}
+	if t.DeliveryMode != 0 {
+		errs = append(errs, bindings.Min("deliveryMode", t.DeliveryMode, 1))
+		errs = append(errs, bindings.Max("deliveryMode", t.DeliveryMode, 2))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,19 @@
+package anypointmq
+
+// ChannelBinding represents the Anypoint MQ Channel Binding object.
+//
+// This object contains configuration for describing an Anypoint MQ exchange, queue, or FIFO queue as an AsyncAPI channel.
+// +binding
+type ChannelBinding struct {
+	Destination string `json:"destination,omitempty"`
+	// +binding:enum=exchange;queue;fifo-queue
+	DestinationType string `json:"destinationType,omitempty" default:"\"queue\""`
+	BindingVersion  string `json:"bindingVersion,omitempty"`
+}
+
+// DestinationType represents the messaging model a channel supports.
+const (
+	DestinationTypeExchange  = "exchange"
+	DestinationTypeQueue     = "queue"
+	DestinationTypeFIFOQueue = "fifo-queue"
+)
@@ -0,0 +1,74 @@
+package anypointmq
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestDestinationTypeConstants(t *testing.T) {
+	assert.Equal(t, "exchange", DestinationTypeExchange)
+	assert.Equal(t, "queue", DestinationTypeQueue)
+	assert.Equal(t, "fifo-queue", DestinationTypeFIFOQueue)
+}
+
+func TestChannelBinding_BuildObject(t *testing.T) {
+	cb := NewChannelBinding()
+	assert.Equal(t, DestinationTypeQueue, cb.DestinationType)
+
+	cb.WithDestination("user-signup-exchg").WithDestinationType(DestinationTypeExchange)
+
+	assert.Equal(t, "user-signup-exchg", cb.Destination)
+	assert.Equal(t, DestinationTypeExchange, cb.DestinationType)
+}
+
+func TestChannelBinding_MarshalYAML(t *testing.T) {
+	cb := NewChannelBinding().
+		WithDestination("user-signup-exchg").
+		WithDestinationType(DestinationTypeExchange)
+
+	expectedYAML := `destination: user-signup-exchg
+destinationType: exchange
+`
+	marshaledYAML, err := yaml.Marshal(cb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestChannelBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+destination: user-signup-queue
+destinationType: fifo-queue
+bindingVersion: 0.0.1
+`
+	var cb ChannelBinding
+	err := yaml.Unmarshal([]byte(yamlString), &cb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "user-signup-queue", cb.Destination)
+	assert.Equal(t, DestinationTypeFIFOQueue, cb.DestinationType)
+	assert.Equal(t, "0.0.1", cb.BindingVersion)
+}
+
+func TestChannelBinding_MarshalJSON(t *testing.T) {
+	cb := NewChannelBinding().
+		WithDestination("user-signup-queue").
+		WithBindingVersion(BindingVersion)
+
+	expectedJSON := `{"destination":"user-signup-queue","destinationType":"queue","bindingVersion":"0.0.1"}`
+
+	marshaledJSON, err := json.Marshal(cb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestChannelBinding_UnmarshalJSON(t *testing.T) {
+	var cb ChannelBinding
+	err := json.Unmarshal([]byte(`{"destinationType": "exchange"}`), &cb)
+	assert.NoError(t, err)
+
+	assert.Empty(t, cb.Destination)
+	assert.Equal(t, DestinationTypeExchange, cb.DestinationType)
+}
@@ -0,0 +1,10 @@
+package anypointmq
+
+// MessageBinding represents the Anypoint MQ Message Binding object.
+//
+// This object contains configuration for describing an Anypoint MQ message as an AsyncAPI message.
+// +binding
+type MessageBinding struct {
+	Headers        interface{} `json:"headers,omitempty"`
+	BindingVersion string      `json:"bindingVersion,omitempty"`
+}
@@ -0,0 +1,151 @@
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// THIS FILE IS GENERATED. DO NOT EDIT
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// If you would like to update properties for a binding,
+// edit the struct for the binding you'd like to update.
+// e.g anypointmq/channel.go and run `make generate` to re-gen
+// this file.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+package anypointmq
+
+import (
+	"encoding/json"
+	"sigs.k8s.io/yaml"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+
+	"errors"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+)
+
+// NewChannelBinding creates a new ChannelBinding object
+func NewChannelBinding() *ChannelBinding {
+	return &ChannelBinding{
+		DestinationType: "queue",
+	}
+}
+
+// WithDestination sets the 'destination' field of ChannelBinding
+func (obj *ChannelBinding) WithDestination(destination string) *ChannelBinding {
+	obj.Destination = destination
+	return obj
+}
+
+// WithDestinationType sets the 'destinationType' field of ChannelBinding
+func (obj *ChannelBinding) WithDestinationType(destinationType string) *ChannelBinding {
+	obj.DestinationType = destinationType
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of ChannelBinding
+func (obj *ChannelBinding) WithBindingVersion(bindingVersion string) *ChannelBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts ChannelBinding to YAML
+func (t ChannelBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to ChannelBinding
+func (t *ChannelBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts ChannelBinding to JSON
+func (t ChannelBinding) MarshalJSON() ([]byte, error) {
+	type Alias ChannelBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to ChannelBinding
+func (t *ChannelBinding) UnmarshalJSON(data []byte) error {
+	type Alias ChannelBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of ChannelBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ChannelBinding) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Enum("destinationType", t.DestinationType, "exchange", "queue", "fifo-queue"))
+	return errors.Join(errs...)
+}
+
+// NewMessageBinding creates a new MessageBinding object
+func NewMessageBinding() *MessageBinding {
+	return &MessageBinding{}
+}
+
+// WithHeaders sets the 'headers' field of MessageBinding
+func (obj *MessageBinding) WithHeaders(headers interface{}) *MessageBinding {
+	obj.Headers = headers
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of MessageBinding
+func (obj *MessageBinding) WithBindingVersion(bindingVersion string) *MessageBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts MessageBinding to YAML
+func (t MessageBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to MessageBinding
+func (t *MessageBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts MessageBinding to JSON
+func (t MessageBinding) MarshalJSON() ([]byte, error) {
+	type Alias MessageBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to MessageBinding
+func (t *MessageBinding) UnmarshalJSON(data []byte) error {
+	type Alias MessageBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of MessageBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t MessageBinding) Validate() error {
+	return nil
+}
@@ -0,0 +1,28 @@
+package googlepubsub
+
+import "github.com/charlie-haley/asyncapi-go/bindings"
+
+//go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen
+
+// Protocol is the key googlepubsub bindings appear under in a bindings object
+const Protocol = "googlepubsub"
+
+const BindingVersion = "0.2.0"
+
+func init() {
+	bindings.Register(Protocol, bindings.Channel, func() any { return &ChannelBinding{} })
+	bindings.Register(Protocol, bindings.Message, func() any { return &MessageBinding{} })
+
+	bindings.RegisterVersions(Protocol, bindings.Channel, bindings.Versions{
+		Supported: []string{"0.1.0", "0.2.0"},
+		Fields: []bindings.Field{
+			{Path: "topic", Removed: "0.2.0"},
+		},
+	})
+	bindings.RegisterVersions(Protocol, bindings.Message, bindings.Versions{
+		Supported: []string{"0.1.0", "0.2.0"},
+		Fields: []bindings.Field{
+			{Path: "schema/type", Removed: "0.2.0"},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package googlepubsub
+
+// ChannelBinding represents the Google Cloud Pub/Sub Channel Binding object.
+// This object contains information about the topic representation in Pub/Sub.
+// +binding
+type ChannelBinding struct {
+	Labels                   map[string]string     `json:"labels,omitempty"`
+	MessageRetentionDuration string                `json:"messageRetentionDuration,omitempty"`
+	MessageStoragePolicy     *MessageStoragePolicy `json:"messageStoragePolicy,omitempty"`
+	// +binding:required
+	SchemaSettings *SchemaSettings `json:"schemaSettings,omitempty"`
+	BindingVersion string          `json:"bindingVersion,omitempty"`
+}
+
+// MessageStoragePolicy represents the regions a Pub/Sub topic may store messages in
+type MessageStoragePolicy struct {
+	AllowedPersistenceRegions []string `json:"allowedPersistenceRegions,omitempty"`
+}
+
+// SchemaSettings represents the schema a Pub/Sub topic validates messages against
+type SchemaSettings struct {
+	// +binding:required
+	Encoding        string `json:"encoding"`
+	FirstRevisionID string `json:"firstRevisionId,omitempty"`
+	LastRevisionID  string `json:"lastRevisionId,omitempty"`
+	// +binding:required
+	Name string `json:"name"`
+}
+
+// SchemaEncoding represents the encoding of messages published to a topic
+const (
+	SchemaEncodingJSON   = "JSON"
+	SchemaEncodingBinary = "BINARY"
+)
@@ -0,0 +1,114 @@
+package googlepubsub
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestChannelBinding_BuildObject(t *testing.T) {
+	cb := NewChannelBinding().
+		WithLabels(map[string]string{"team": "orders"}).
+		WithMessageRetentionDuration("86400s").
+		WithMessageStoragePolicy(NewMessageStoragePolicy().WithAllowedPersistenceRegions([]string{"us-central1", "us-east1"})).
+		WithSchemaSettings(NewSchemaSettings().
+			WithEncoding(SchemaEncodingJSON).
+			WithName("projects/my-project/schemas/order").
+			WithFirstRevisionID("1").
+			WithLastRevisionID("3"))
+
+	assert.Equal(t, map[string]string{"team": "orders"}, cb.Labels)
+	assert.Equal(t, "86400s", cb.MessageRetentionDuration)
+	assert.Equal(t, []string{"us-central1", "us-east1"}, cb.MessageStoragePolicy.AllowedPersistenceRegions)
+	assert.Equal(t, &SchemaSettings{
+		Encoding:        SchemaEncodingJSON,
+		FirstRevisionID: "1",
+		LastRevisionID:  "3",
+		Name:            "projects/my-project/schemas/order",
+	}, cb.SchemaSettings)
+}
+
+func TestChannelBinding_MarshalYAML(t *testing.T) {
+	cb := NewChannelBinding().
+		WithMessageRetentionDuration("86400s").
+		WithSchemaSettings(NewSchemaSettings().
+			WithEncoding(SchemaEncodingBinary).
+			WithName("projects/my-project/schemas/order"))
+
+	expectedYAML := `messageRetentionDuration: 86400s
+schemaSettings:
+  encoding: BINARY
+  name: projects/my-project/schemas/order
+`
+	marshaledYAML, err := yaml.Marshal(cb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestChannelBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+labels:
+  label1: value1
+  label2: value2
+messageRetentionDuration: 86400s
+messageStoragePolicy:
+  allowedPersistenceRegions:
+    - us-central1
+    - us-east1
+schemaSettings:
+  encoding: json
+  name: projects/your-project/schemas/message-avro
+bindingVersion: 0.2.0
+`
+	var cb ChannelBinding
+	err := yaml.Unmarshal([]byte(yamlString), &cb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"label1": "value1", "label2": "value2"}, cb.Labels)
+	assert.Equal(t, "86400s", cb.MessageRetentionDuration)
+	assert.Equal(t, &MessageStoragePolicy{AllowedPersistenceRegions: []string{"us-central1", "us-east1"}}, cb.MessageStoragePolicy)
+	assert.Equal(t, &SchemaSettings{Encoding: "json", Name: "projects/your-project/schemas/message-avro"}, cb.SchemaSettings)
+	assert.Equal(t, "0.2.0", cb.BindingVersion)
+}
+
+func TestChannelBinding_MarshalJSON(t *testing.T) {
+	cb := NewChannelBinding().
+		WithLabels(map[string]string{"team": "orders"}).
+		WithSchemaSettings(NewSchemaSettings().
+			WithEncoding(SchemaEncodingJSON).
+			WithName("projects/my-project/schemas/order")).
+		WithBindingVersion(BindingVersion)
+
+	expectedJSON := `{"labels":{"team":"orders"},"schemaSettings":{"encoding":"JSON","name":"projects/my-project/schemas/order"},"bindingVersion":"0.2.0"}`
+
+	marshaledJSON, err := json.Marshal(cb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestChannelBinding_UnmarshalJSON(t *testing.T) {
+	jsonString := `{
+		"messageStoragePolicy": {"allowedPersistenceRegions": ["europe-west1"]},
+		"schemaSettings": {
+			"encoding": "BINARY",
+			"firstRevisionId": "a1b2",
+			"lastRevisionId": "c3d4",
+			"name": "projects/my-project/schemas/order"
+		}
+	}`
+
+	var cb ChannelBinding
+	err := json.Unmarshal([]byte(jsonString), &cb)
+	assert.NoError(t, err)
+
+	assert.Nil(t, cb.Labels)
+	assert.Equal(t, []string{"europe-west1"}, cb.MessageStoragePolicy.AllowedPersistenceRegions)
+	assert.Equal(t, &SchemaSettings{
+		Encoding:        SchemaEncodingBinary,
+		FirstRevisionID: "a1b2",
+		LastRevisionID:  "c3d4",
+		Name:            "projects/my-project/schemas/order",
+	}, cb.SchemaSettings)
+}
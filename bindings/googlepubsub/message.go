@@ -0,0 +1,25 @@
+package googlepubsub
+
+// MessageBinding represents the Google Cloud Pub/Sub Message Binding object.
+// This object contains information about the message representation in Pub/Sub.
+// +binding
+type MessageBinding struct {
+	Attributes     map[string]string `json:"attributes,omitempty"`
+	OrderingKey    string            `json:"orderingKey,omitempty"`
+	Schema         *MessageSchema    `json:"schema,omitempty"`
+	BindingVersion string            `json:"bindingVersion,omitempty"`
+}
+
+// MessageSchema represents the Pub/Sub schema a message is published with.
+// Type is only defined by binding version 0.1.0.
+type MessageSchema struct {
+	// +binding:required
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+}
+
+// MessageSchemaType represents the type of a Pub/Sub schema
+const (
+	MessageSchemaTypeAvro     = "avro"
+	MessageSchemaTypeProtobuf = "protobuf"
+)
@@ -0,0 +1,79 @@
+package googlepubsub
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestMessageBinding_BuildObject(t *testing.T) {
+	mb := NewMessageBinding().
+		WithAttributes(map[string]string{"source": "checkout"}).
+		WithOrderingKey("customer-42").
+		WithSchema(NewMessageSchema().WithName("projects/my-project/schemas/order").WithType(MessageSchemaTypeAvro))
+
+	assert.Equal(t, map[string]string{"source": "checkout"}, mb.Attributes)
+	assert.Equal(t, "customer-42", mb.OrderingKey)
+	assert.Equal(t, &MessageSchema{Name: "projects/my-project/schemas/order", Type: MessageSchemaTypeAvro}, mb.Schema)
+}
+
+func TestMessageBinding_MarshalYAML(t *testing.T) {
+	mb := NewMessageBinding().
+		WithOrderingKey("customer-42").
+		WithSchema(NewMessageSchema().WithName("projects/my-project/schemas/order"))
+
+	expectedYAML := `orderingKey: customer-42
+schema:
+  name: projects/my-project/schemas/order
+`
+	marshaledYAML, err := yaml.Marshal(mb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestMessageBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+attributes:
+  source: checkout
+schema:
+  name: projects/your-project-id/schemas/your-protobuf-schema-id
+  type: protobuf
+bindingVersion: 0.1.0
+`
+	var mb MessageBinding
+	err := yaml.Unmarshal([]byte(yamlString), &mb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"source": "checkout"}, mb.Attributes)
+	assert.Equal(t, &MessageSchema{Name: "projects/your-project-id/schemas/your-protobuf-schema-id", Type: MessageSchemaTypeProtobuf}, mb.Schema)
+	assert.Equal(t, "0.1.0", mb.BindingVersion)
+}
+
+func TestMessageBinding_MarshalJSON(t *testing.T) {
+	mb := NewMessageBinding().
+		WithAttributes(map[string]string{"source": "checkout"}).
+		WithBindingVersion(BindingVersion)
+
+	expectedJSON := `{"attributes":{"source":"checkout"},"bindingVersion":"0.2.0"}`
+
+	marshaledJSON, err := json.Marshal(mb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestMessageBinding_UnmarshalJSON(t *testing.T) {
+	jsonString := `{
+		"orderingKey": "customer-42",
+		"schema": {"name": "projects/my-project/schemas/order"}
+	}`
+
+	var mb MessageBinding
+	err := json.Unmarshal([]byte(jsonString), &mb)
+	assert.NoError(t, err)
+
+	assert.Nil(t, mb.Attributes)
+	assert.Equal(t, "customer-42", mb.OrderingKey)
+	assert.Equal(t, &MessageSchema{Name: "projects/my-project/schemas/order"}, mb.Schema)
+}
@@ -0,0 +1,78 @@
+package googlepubsub
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charlie-haley/asyncapi-go/schemaformat"
+)
+
+// CheckMessageSchema checks a message's schema definition against the schema
+// settings of the topic it's published to. Pub/Sub only validates messages
+// against Avro and Protocol Buffer schemas, encoded either as JSON or in
+// their binary format, so the check reports:
+//
+//   - an encoding other than JSON or BINARY
+//   - a message binding schema name that differs from the topic's
+//   - a schemaFormat that isn't Avro or Protocol Buffers, or that disagrees
+//     with the message binding's schema type
+//   - a contentType that disagrees with the encoding, e.g. application/json
+//     on a topic with BINARY encoding
+//
+// schemaFormat and contentType are the message's effective values, and may
+// be empty. A nil settings means the topic has no schema, and every message
+// is accepted.
+func CheckMessageSchema(settings *SchemaSettings, message *MessageBinding, schemaFormat, contentType string) error {
+	if settings == nil {
+		return nil
+	}
+
+	var errs []error
+	encoding := strings.ToUpper(settings.Encoding)
+	if encoding != SchemaEncodingJSON && encoding != SchemaEncodingBinary {
+		errs = append(errs, fmt.Errorf("schemaSettings encoding %q must be %s or %s", settings.Encoding, SchemaEncodingJSON, SchemaEncodingBinary))
+	}
+
+	var schemaType string
+	if message != nil && message.Schema != nil {
+		if settings.Name != "" && message.Schema.Name != "" && message.Schema.Name != settings.Name {
+			errs = append(errs, fmt.Errorf("message schema %q doesn't match topic schema %q", message.Schema.Name, settings.Name))
+		}
+		schemaType = strings.ToLower(message.Schema.Type)
+	}
+
+	if schemaFormat != "" {
+		formatType := schemaTypeOf(schemaFormat)
+		switch {
+		case formatType == "":
+			errs = append(errs, fmt.Errorf("schemaFormat %q isn't supported by Pub/Sub, expected Avro or Protocol Buffers", schemaFormat))
+		case schemaType != "" && schemaType != formatType:
+			errs = append(errs, fmt.Errorf("message schema type %q doesn't match schemaFormat %q", message.Schema.Type, schemaFormat))
+		}
+	}
+
+	if contentType != "" {
+		isJSON := schemaformat.IsJSONContentType(contentType)
+		switch {
+		case encoding == SchemaEncodingJSON && !isJSON:
+			errs = append(errs, fmt.Errorf("contentType %q isn't JSON, but the topic's encoding is %s", contentType, SchemaEncodingJSON))
+		case encoding == SchemaEncodingBinary && isJSON:
+			errs = append(errs, fmt.Errorf("contentType %q is JSON, but the topic's encoding is %s", contentType, SchemaEncodingBinary))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// schemaTypeOf returns the Pub/Sub schema type of a schemaFormat, or an empty
+// string if Pub/Sub doesn't support it
+func schemaTypeOf(schemaFormat string) string {
+	switch schemaformat.MediaType(schemaFormat) {
+	case schemaformat.Avro, schemaformat.AvroJSON, schemaformat.AvroYAML:
+		return MessageSchemaTypeAvro
+	case schemaformat.Protobuf:
+		return MessageSchemaTypeProtobuf
+	}
+	return ""
+}
@@ -0,0 +1,93 @@
+package googlepubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckMessageSchema(t *testing.T) {
+	const schemaName = "projects/my-project/schemas/order"
+
+	tests := []struct {
+		name         string
+		settings     *SchemaSettings
+		message      *MessageBinding
+		schemaFormat string
+		contentType  string
+		errs         []string
+	}{
+		{
+			name:     "no schema settings",
+			settings: nil,
+			message:  NewMessageBinding().WithSchema(NewMessageSchema().WithName("other")),
+		},
+		{
+			name:         "json encoding with avro schema",
+			settings:     &SchemaSettings{Encoding: "json", Name: schemaName},
+			message:      NewMessageBinding().WithSchema(NewMessageSchema().WithName(schemaName).WithType(MessageSchemaTypeAvro)),
+			schemaFormat: "application/vnd.apache.avro;version=1.9.0",
+			contentType:  "application/json",
+		},
+		{
+			name:         "binary encoding with protobuf schema",
+			settings:     &SchemaSettings{Encoding: SchemaEncodingBinary, Name: schemaName},
+			message:      NewMessageBinding(),
+			schemaFormat: "application/vnd.google.protobuf;version=3",
+			contentType:  "application/octet-stream",
+		},
+		{
+			name:     "invalid encoding",
+			settings: &SchemaSettings{Encoding: "XML", Name: schemaName},
+			errs:     []string{`schemaSettings encoding "XML" must be JSON or BINARY`},
+		},
+		{
+			name:     "schema name mismatch",
+			settings: &SchemaSettings{Encoding: SchemaEncodingJSON, Name: schemaName},
+			message:  NewMessageBinding().WithSchema(NewMessageSchema().WithName("projects/my-project/schemas/invoice")),
+			errs:     []string{`message schema "projects/my-project/schemas/invoice" doesn't match topic schema "projects/my-project/schemas/order"`},
+		},
+		{
+			name:         "unsupported schema format",
+			settings:     &SchemaSettings{Encoding: SchemaEncodingJSON, Name: schemaName},
+			schemaFormat: "application/schema+json;version=draft-07",
+			errs:         []string{`schemaFormat "application/schema+json;version=draft-07" isn't supported by Pub/Sub, expected Avro or Protocol Buffers`},
+		},
+		{
+			name:         "schema type mismatch",
+			settings:     &SchemaSettings{Encoding: SchemaEncodingBinary, Name: schemaName},
+			message:      NewMessageBinding().WithSchema(NewMessageSchema().WithName(schemaName).WithType(MessageSchemaTypeProtobuf)),
+			schemaFormat: "application/vnd.apache.avro;version=1.9.0",
+			errs:         []string{`message schema type "protobuf" doesn't match schemaFormat "application/vnd.apache.avro;version=1.9.0"`},
+		},
+		{
+			name:        "json content type with binary encoding",
+			settings:    &SchemaSettings{Encoding: SchemaEncodingBinary, Name: schemaName},
+			contentType: "application/json",
+			errs:        []string{`contentType "application/json" is JSON, but the topic's encoding is BINARY`},
+		},
+		{
+			name:        "multiple violations",
+			settings:    &SchemaSettings{Encoding: SchemaEncodingJSON, Name: schemaName},
+			message:     NewMessageBinding().WithSchema(NewMessageSchema().WithName("other")),
+			contentType: "avro/binary",
+			errs: []string{
+				`message schema "other" doesn't match topic schema "projects/my-project/schemas/order"`,
+				`contentType "avro/binary" isn't JSON, but the topic's encoding is JSON`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckMessageSchema(tt.settings, tt.message, tt.schemaFormat, tt.contentType)
+			if len(tt.errs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, msg := range tt.errs {
+				assert.ErrorContains(t, err, msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// THIS FILE IS GENERATED. DO NOT EDIT
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// If you would like to update properties for a binding,
+// edit the struct for the binding you'd like to update.
+// e.g googlepubsub/channel.go and run `make generate` to re-gen
+// this file.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+package googlepubsub
+
+import (
+	"encoding/json"
+	"sigs.k8s.io/yaml"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+
+	"errors"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+)
+
+// NewChannelBinding creates a new ChannelBinding object
+func NewChannelBinding() *ChannelBinding {
+	return &ChannelBinding{}
+}
+
+// WithLabels sets the 'labels' field of ChannelBinding
+func (obj *ChannelBinding) WithLabels(labels map[string]string) *ChannelBinding {
+	obj.Labels = labels
+	return obj
+}
+
+// WithMessageRetentionDuration sets the 'messageRetentionDuration' field of ChannelBinding
+func (obj *ChannelBinding) WithMessageRetentionDuration(messageRetentionDuration string) *ChannelBinding {
+	obj.MessageRetentionDuration = messageRetentionDuration
+	return obj
+}
+
+// WithMessageStoragePolicy sets the 'messageStoragePolicy' field of ChannelBinding
+func (obj *ChannelBinding) WithMessageStoragePolicy(messageStoragePolicy *MessageStoragePolicy) *ChannelBinding {
+	obj.MessageStoragePolicy = messageStoragePolicy
+	return obj
+}
+
+// WithSchemaSettings sets the 'schemaSettings' field of ChannelBinding
+func (obj *ChannelBinding) WithSchemaSettings(schemaSettings *SchemaSettings) *ChannelBinding {
+	obj.SchemaSettings = schemaSettings
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of ChannelBinding
+func (obj *ChannelBinding) WithBindingVersion(bindingVersion string) *ChannelBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts ChannelBinding to YAML
+func (t ChannelBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to ChannelBinding
+func (t *ChannelBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts ChannelBinding to JSON
+func (t ChannelBinding) MarshalJSON() ([]byte, error) {
+	type Alias ChannelBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to ChannelBinding
+func (t *ChannelBinding) UnmarshalJSON(data []byte) error {
+	type Alias ChannelBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of ChannelBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ChannelBinding) Validate() error {
+	var errs []error
+	if t.MessageStoragePolicy != nil {
+		errs = append(errs, bindings.Nest("messageStoragePolicy", t.MessageStoragePolicy.Validate()))
+	}
+	errs = append(errs, bindings.Required("schemaSettings", t.SchemaSettings != nil))
+	if t.SchemaSettings != nil {
+		errs = append(errs, bindings.Nest("schemaSettings", t.SchemaSettings.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// NewMessageStoragePolicy creates a new MessageStoragePolicy object
+func NewMessageStoragePolicy() *MessageStoragePolicy {
+	return &MessageStoragePolicy{}
+}
+
+// WithAllowedPersistenceRegions sets the 'allowedPersistenceRegions' field of MessageStoragePolicy
+func (obj *MessageStoragePolicy) WithAllowedPersistenceRegions(allowedPersistenceRegions []string) *MessageStoragePolicy {
+	obj.AllowedPersistenceRegions = allowedPersistenceRegions
+	return obj
+}
+
+// Validate checks the fields of MessageStoragePolicy, returning a
+// *bindings.FieldError for each invalid field
+func (t MessageStoragePolicy) Validate() error {
+	return nil
+}
+
+// NewSchemaSettings creates a new SchemaSettings object
+func NewSchemaSettings() *SchemaSettings {
+	return &SchemaSettings{}
+}
+
+// WithEncoding sets the 'encoding' field of SchemaSettings
+func (obj *SchemaSettings) WithEncoding(encoding string) *SchemaSettings {
+	obj.Encoding = encoding
+	return obj
+}
+
+// WithFirstRevisionID sets the 'firstRevisionId' field of SchemaSettings
+func (obj *SchemaSettings) WithFirstRevisionID(firstRevisionId string) *SchemaSettings {
+	obj.FirstRevisionID = firstRevisionId
+	return obj
+}
+
+// WithLastRevisionID sets the 'lastRevisionId' field of SchemaSettings
+func (obj *SchemaSettings) WithLastRevisionID(lastRevisionId string) *SchemaSettings {
+	obj.LastRevisionID = lastRevisionId
+	return obj
+}
+
+// WithName sets the 'name' field of SchemaSettings
+func (obj *SchemaSettings) WithName(name string) *SchemaSettings {
+	obj.Name = name
+	return obj
+}
+
+// Validate checks the fields of SchemaSettings, returning a
+// *bindings.FieldError for each invalid field
+func (t SchemaSettings) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("encoding", t.Encoding != ""))
+	errs = append(errs, bindings.Required("name", t.Name != ""))
+	return errors.Join(errs...)
+}
+
+// NewMessageBinding creates a new MessageBinding object
+func NewMessageBinding() *MessageBinding {
+	return &MessageBinding{}
+}
+
+// WithAttributes sets the 'attributes' field of MessageBinding
+func (obj *MessageBinding) WithAttributes(attributes map[string]string) *MessageBinding {
+	obj.Attributes = attributes
+	return obj
+}
+
+// WithOrderingKey sets the 'orderingKey' field of MessageBinding
+func (obj *MessageBinding) WithOrderingKey(orderingKey string) *MessageBinding {
+	obj.OrderingKey = orderingKey
+	return obj
+}
+
+// WithSchema sets the 'schema' field of MessageBinding
+func (obj *MessageBinding) WithSchema(schema *MessageSchema) *MessageBinding {
+	obj.Schema = schema
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of MessageBinding
+func (obj *MessageBinding) WithBindingVersion(bindingVersion string) *MessageBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts MessageBinding to YAML
+func (t MessageBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to MessageBinding
+func (t *MessageBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts MessageBinding to JSON
+func (t MessageBinding) MarshalJSON() ([]byte, error) {
+	type Alias MessageBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to MessageBinding
+func (t *MessageBinding) UnmarshalJSON(data []byte) error {
+	type Alias MessageBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of MessageBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t MessageBinding) Validate() error {
+	var errs []error
+	if t.Schema != nil {
+		errs = append(errs, bindings.Nest("schema", t.Schema.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// NewMessageSchema creates a new MessageSchema object
+func NewMessageSchema() *MessageSchema {
+	return &MessageSchema{}
+}
+
+// WithName sets the 'name' field of MessageSchema
+func (obj *MessageSchema) WithName(name string) *MessageSchema {
+	obj.Name = name
+	return obj
+}
+
+// WithType sets the 'type' field of MessageSchema
+func (obj *MessageSchema) WithType(typeValue string) *MessageSchema {
+	obj.Type = typeValue
+	return obj
+}
+
+// Validate checks the fields of MessageSchema, returning a
+// *bindings.FieldError for each invalid field
+func (t MessageSchema) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("name", t.Name != ""))
+	return errors.Join(errs...)
+}
@@ -0,0 +1,32 @@
+package http
+
+import "github.com/charlie-haley/asyncapi-go/bindings"
+
+//go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen
+
+// Protocol is the key http bindings appear under in a bindings object
+const Protocol = "http"
+
+const BindingVersion = "0.3.0"
+
+// bindingVersions are the binding versions this package decodes
+var bindingVersions = []string{"0.1.0", "0.2.0", "0.3.0"}
+
+func init() {
+	bindings.Register(Protocol, bindings.Operation, func() any { return &OperationBinding{} })
+	bindings.Register(Protocol, bindings.Message, func() any { return &MessageBinding{} })
+
+	bindings.RegisterVersions(Protocol, bindings.Operation, bindings.Versions{
+		Supported: bindingVersions,
+		Fields: []bindings.Field{
+			{Path: "type", Removed: "0.2.0"},
+			{Path: "is", Removed: "0.2.0"},
+		},
+	})
+	bindings.RegisterVersions(Protocol, bindings.Message, bindings.Versions{
+		Supported: bindingVersions,
+		Fields: []bindings.Field{
+			{Path: "statusCode", Added: "0.3.0"},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"encoding/json"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+)
+
+// MessageBinding represents the HTTP Message Binding object.
+//
+// This object contains information about the message representation in HTTP.
+// Headers holds a Schema Object of type object, with a property for each
+// header. StatusCode, the status code of a response, is only part of binding
+// version 0.3.0.
+// +binding
+// +binding:marshal:no-gen
+type MessageBinding struct {
+	Headers        interface{} `json:"headers,omitempty"`
+	StatusCode     int         `json:"statusCode,omitempty"`
+	BindingVersion string      `json:"bindingVersion,omitempty"`
+}
+
+// MarshalJSON is a custom marshaller that converts MessageBinding to JSON
+func (m MessageBinding) MarshalJSON() ([]byte, error) {
+	type Alias MessageBinding
+	return json.Marshal(struct{ Alias }{Alias(m)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to
+// MessageBinding. A status code before binding version 0.3.0 is kept so it
+// round-trips, and is reported by binding schema validation and
+// bindings.Migrate.
+func (m *MessageBinding) UnmarshalJSON(data []byte) error {
+	type Alias MessageBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(m)}
+	if err := jsonnumber.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	return checkVersion(m.BindingVersion)
+}
+
+// MarshalYAML is a custom marshaller that converts MessageBinding to YAML
+func (m MessageBinding) MarshalYAML() (interface{}, error) {
+	return marshalYAML(m)
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to MessageBinding
+func (m *MessageBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := yamlToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return m.UnmarshalJSON(data)
+}
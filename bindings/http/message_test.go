@@ -0,0 +1,67 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestMessageBinding_BuildObject(t *testing.T) {
+	mb := NewMessageBinding().
+		WithHeaders(map[string]interface{}{"type": "object"}).
+		WithStatusCode(http.StatusAccepted)
+
+	assert.Equal(t, map[string]interface{}{"type": "object"}, mb.Headers)
+	assert.Equal(t, http.StatusAccepted, mb.StatusCode)
+}
+
+func TestMessageBinding_RoundTrip(t *testing.T) {
+	mb := NewMessageBinding().
+		WithHeaders(map[string]interface{}{"type": "object", "properties": map[string]interface{}{"Content-Type": map[string]interface{}{"type": "string", "enum": []interface{}{"application/json"}}}}).
+		WithStatusCode(http.StatusCreated).
+		WithBindingVersion("0.3.0")
+
+	data, err := json.Marshal(mb)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"headers":{"properties":{"Content-Type":{"enum":["application/json"],"type":"string"}},"type":"object"},"statusCode":201,"bindingVersion":"0.3.0"}`, string(data))
+
+	var fromJSON MessageBinding
+	assert.NoError(t, json.Unmarshal(data, &fromJSON))
+	assert.Equal(t, mb, &fromJSON)
+
+	data, err = yaml.Marshal(mb)
+	assert.NoError(t, err)
+	assert.Equal(t, `bindingVersion: 0.3.0
+headers:
+  properties:
+    Content-Type:
+      enum:
+      - application/json
+      type: string
+  type: object
+statusCode: 201
+`, string(data))
+
+	var fromYAML MessageBinding
+	assert.NoError(t, yaml.Unmarshal(data, &fromYAML))
+	assert.Equal(t, mb, &fromYAML)
+}
+
+func TestMessageBinding_UnmarshalJSON_Versions(t *testing.T) {
+	// statusCode is kept for every version, though it's only defined by 0.3.0
+	for _, version := range []string{"0.1.0", "0.2.0", "0.3.0", "latest", ""} {
+		data := `{"statusCode": 200, "bindingVersion": "` + version + `"}`
+		if version == "" {
+			data = `{"statusCode": 200}`
+		}
+		var mb MessageBinding
+		assert.NoError(t, json.Unmarshal([]byte(data), &mb))
+		assert.Equal(t, http.StatusOK, mb.StatusCode, "version %q", version)
+	}
+
+	var mb MessageBinding
+	assert.ErrorContains(t, json.Unmarshal([]byte(`{"bindingVersion": "1.0.0"}`), &mb), `unsupported http binding version "1.0.0"`)
+}
@@ -0,0 +1,68 @@
+package http
+
+import (
+	"encoding/json"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+)
+
+// OperationBinding represents the HTTP Operation Binding object.
+//
+// This object contains information about the operation representation in HTTP.
+// Query holds a Schema Object of type object, with a property for each query
+// parameter. Type is only part of binding version 0.1.0, where the method of a
+// response operation is ignored; later versions describe requests only.
+// +binding
+// +binding:marshal:no-gen
+type OperationBinding struct {
+	Type string `json:"type,omitempty"`
+	// +binding:enum=GET;PUT;POST;PATCH;DELETE;HEAD;OPTIONS;CONNECT;TRACE
+	Method         string      `json:"method,omitempty"`
+	Query          interface{} `json:"query,omitempty"`
+	BindingVersion string      `json:"bindingVersion,omitempty"`
+}
+
+// OperationType represents the types of operation in binding version 0.1.0.
+const (
+	OperationTypeRequest  = "request"
+	OperationTypeResponse = "response"
+)
+
+// IsRequest reports whether the operation is an HTTP request, which is
+// always the case after binding version 0.1.0
+func (o *OperationBinding) IsRequest() bool {
+	return effectiveVersion(o.BindingVersion) != "0.1.0" || o.Type == OperationTypeRequest
+}
+
+// MarshalJSON is a custom marshaller that converts OperationBinding to JSON
+func (o OperationBinding) MarshalJSON() ([]byte, error) {
+	type Alias OperationBinding
+	return json.Marshal(struct{ Alias }{Alias(o)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to
+// OperationBinding. Fields its binding version doesn't define, type after
+// 0.1.0 and the method of a 0.1.0 response, are kept so they round-trip, and
+// are reported by binding schema validation and bindings.Migrate.
+func (o *OperationBinding) UnmarshalJSON(data []byte) error {
+	type Alias OperationBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(o)}
+	if err := jsonnumber.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	return checkVersion(o.BindingVersion)
+}
+
+// MarshalYAML is a custom marshaller that converts OperationBinding to YAML
+func (o OperationBinding) MarshalYAML() (interface{}, error) {
+	return marshalYAML(o)
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to OperationBinding
+func (o *OperationBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := yamlToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
+}
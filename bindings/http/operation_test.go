@@ -0,0 +1,133 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func TestOperationBinding_BuildObject(t *testing.T) {
+	ob := NewOperationBinding().
+		WithMethod(http.MethodPost).
+		WithQuery(map[string]interface{}{"type": "object"}).
+		WithBindingVersion(BindingVersion)
+
+	assert.Equal(t, http.MethodPost, ob.Method)
+	assert.Equal(t, map[string]interface{}{"type": "object"}, ob.Query)
+	assert.True(t, ob.IsRequest())
+}
+
+func TestOperationBinding_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		binding *OperationBinding
+		json    string
+		yaml    string
+	}{
+		{
+			name: "latest",
+			binding: NewOperationBinding().
+				WithMethod(http.MethodPost).
+				WithQuery(map[string]interface{}{"type": "object", "properties": map[string]interface{}{"id": map[string]interface{}{"type": "integer", "minimum": json.Number("1")}}}).
+				WithBindingVersion("0.3.0"),
+			json: `{"method":"POST","query":{"properties":{"id":{"minimum":1,"type":"integer"}},"type":"object"},"bindingVersion":"0.3.0"}`,
+			yaml: "bindingVersion: 0.3.0\nmethod: POST\nquery:\n  properties:\n    id:\n      minimum: 1\n      type: integer\n  type: object\n",
+		},
+		{
+			name:    "0.1.0 request",
+			binding: NewOperationBinding().WithType(OperationTypeRequest).WithMethod(http.MethodGet).WithBindingVersion("0.1.0"),
+			json:    `{"type":"request","method":"GET","bindingVersion":"0.1.0"}`,
+			yaml:    "bindingVersion: 0.1.0\nmethod: GET\ntype: request\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.binding)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.json, string(data))
+
+			var fromJSON OperationBinding
+			assert.NoError(t, json.Unmarshal(data, &fromJSON))
+			assert.Equal(t, tt.binding, &fromJSON)
+
+			data, err = yaml.Marshal(tt.binding)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.yaml, string(data))
+
+			var fromYAML OperationBinding
+			assert.NoError(t, yaml.Unmarshal(data, &fromYAML))
+			assert.Equal(t, tt.binding, &fromYAML)
+		})
+	}
+}
+
+func TestOperationBinding_UnmarshalJSON_Versions(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		expected  OperationBinding
+		isRequest bool
+	}{
+		{
+			name:      "0.1.0 response keeps the ignored method",
+			json:      `{"type": "response", "method": "GET", "bindingVersion": "0.1.0"}`,
+			expected:  OperationBinding{Type: OperationTypeResponse, Method: http.MethodGet, BindingVersion: "0.1.0"},
+			isRequest: false,
+		},
+		{
+			name:      "0.2.0 keeps the undefined type",
+			json:      `{"type": "request", "method": "GET", "bindingVersion": "0.2.0"}`,
+			expected:  OperationBinding{Type: OperationTypeRequest, Method: http.MethodGet, BindingVersion: "0.2.0"},
+			isRequest: true,
+		},
+		{
+			name:      "0.2.0 response is a request",
+			json:      `{"type": "response", "method": "GET", "bindingVersion": "0.2.0"}`,
+			expected:  OperationBinding{Type: OperationTypeResponse, Method: http.MethodGet, BindingVersion: "0.2.0"},
+			isRequest: true,
+		},
+		{
+			name:      "omitted version is the latest",
+			json:      `{"method": "PUT"}`,
+			expected:  OperationBinding{Method: http.MethodPut},
+			isRequest: true,
+		},
+		{
+			name:      "latest",
+			json:      `{"method": "PUT", "bindingVersion": "latest"}`,
+			expected:  OperationBinding{Method: http.MethodPut, BindingVersion: "latest"},
+			isRequest: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ob OperationBinding
+			assert.NoError(t, json.Unmarshal([]byte(tt.json), &ob))
+			assert.Equal(t, tt.expected, ob)
+			assert.Equal(t, tt.isRequest, ob.IsRequest())
+		})
+	}
+}
+
+func TestOperationBinding_Migrate(t *testing.T) {
+	ob := NewOperationBinding().WithType(OperationTypeRequest).WithMethod(http.MethodGet).WithBindingVersion("0.2.0")
+
+	migrated, err := bindings.Migrate(Protocol, bindings.Operation, ob)
+	var versionErr *bindings.VersionError
+	require.ErrorAs(t, err, &versionErr)
+	assert.Equal(t, []string{"/type"}, versionErr.Fields)
+	assert.Equal(t, map[string]any{"method": "GET", "bindingVersion": "0.3.0"}, migrated)
+}
+
+func TestOperationBinding_UnmarshalJSON_UnsupportedVersion(t *testing.T) {
+	var ob OperationBinding
+	err := json.Unmarshal([]byte(`{"method": "GET", "bindingVersion": "0.4.0"}`), &ob)
+	assert.EqualError(t, err, `unsupported http binding version "0.4.0", expected one of [0.1.0 0.2.0 0.3.0]`)
+}
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+	"sigs.k8s.io/yaml"
+)
+
+// effectiveVersion returns the binding version a binding follows, which is
+// the latest when it's omitted or "latest"
+func effectiveVersion(version string) string {
+	if version == "" || version == bindings.LatestVersion {
+		return BindingVersion
+	}
+	return version
+}
+
+// checkVersion returns an error for binding versions this package can't
+// decode
+func checkVersion(version string) error {
+	if !slices.Contains(bindingVersions, effectiveVersion(version)) {
+		return fmt.Errorf("unsupported http binding version %q, expected one of %v", version, bindingVersions)
+	}
+	return nil
+}
+
+// marshalYAML returns a binding as the generic value YAML marshals
+func marshalYAML(v any) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(data, &out)
+	return out, err
+}
+
+// yamlToJSON returns the YAML value being unmarshalled as JSON
+func yamlToJSON(unmarshal func(interface{}) error) ([]byte, error) {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return nil, err
+	}
+	data, err := yaml.Marshal(temp)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.YAMLToJSON(data)
+}
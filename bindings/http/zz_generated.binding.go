@@ -0,0 +1,82 @@
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// THIS FILE IS GENERATED. DO NOT EDIT
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// If you would like to update properties for a binding,
+// edit the struct for the binding you'd like to update.
+// e.g http/channel.go and run `make generate` to re-gen
+// this file.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+package http
+
+import (
+	"errors"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+)
+
+// NewMessageBinding creates a new MessageBinding object
+func NewMessageBinding() *MessageBinding {
+	return &MessageBinding{}
+}
+
+// WithHeaders sets the 'headers' field of MessageBinding
+func (obj *MessageBinding) WithHeaders(headers interface{}) *MessageBinding {
+	obj.Headers = headers
+	return obj
+}
+
+// WithStatusCode sets the 'statusCode' field of MessageBinding
+func (obj *MessageBinding) WithStatusCode(statusCode int) *MessageBinding {
+	obj.StatusCode = statusCode
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of MessageBinding
+func (obj *MessageBinding) WithBindingVersion(bindingVersion string) *MessageBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// Validate checks the fields of MessageBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t MessageBinding) Validate() error {
+	return nil
+}
+
+// NewOperationBinding creates a new OperationBinding object
+func NewOperationBinding() *OperationBinding {
+	return &OperationBinding{}
+}
+
+// WithType sets the 'type' field of OperationBinding
+func (obj *OperationBinding) WithType(typeValue string) *OperationBinding {
+	obj.Type = typeValue
+	return obj
+}
+
+// WithMethod sets the 'method' field of OperationBinding
+func (obj *OperationBinding) WithMethod(method string) *OperationBinding {
+	obj.Method = method
+	return obj
+}
+
+// WithQuery sets the 'query' field of OperationBinding
+func (obj *OperationBinding) WithQuery(query interface{}) *OperationBinding {
+	obj.Query = query
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of OperationBinding
+func (obj *OperationBinding) WithBindingVersion(bindingVersion string) *OperationBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// Validate checks the fields of OperationBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t OperationBinding) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Enum("method", t.Method, "GET", "PUT", "POST", "PATCH", "DELETE", "HEAD", "OPTIONS", "CONNECT", "TRACE"))
+	return errors.Join(errs...)
+}
@@ -0,0 +1,20 @@
+package ibmmq
+
+import "github.com/charlie-haley/asyncapi-go/bindings"
+
+//go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen
+
+// Protocol is the key ibmmq bindings appear under in a bindings object
+const Protocol = "ibmmq"
+
+const BindingVersion = "0.1.0"
+
+func init() {
+	bindings.Register(Protocol, bindings.Server, func() any { return &ServerBinding{} })
+	bindings.Register(Protocol, bindings.Channel, func() any { return &ChannelBinding{} })
+	bindings.Register(Protocol, bindings.Message, func() any { return &MessageBinding{} })
+
+	bindings.RegisterVersions(Protocol, bindings.Server, bindings.Versions{Supported: []string{BindingVersion}})
+	bindings.RegisterVersions(Protocol, bindings.Channel, bindings.Versions{Supported: []string{BindingVersion}})
+	bindings.RegisterVersions(Protocol, bindings.Message, bindings.Versions{Supported: []string{BindingVersion}})
+}
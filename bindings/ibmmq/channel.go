@@ -0,0 +1,40 @@
+package ibmmq
+
+// ChannelBinding represents the IBM MQ Channel Binding object.
+//
+// This object contains information about the channel representation in IBM MQ.
+// A channel is either a topic or a queue, according to its DestinationType.
+// +binding
+type ChannelBinding struct {
+	// +binding:enum=topic;queue
+	DestinationType string `json:"destinationType,omitempty" default:"\"topic\""`
+	Queue           *Queue `json:"queue,omitempty"`
+	Topic           *Topic `json:"topic,omitempty"`
+	// +binding:min=0
+	// +binding:max=104857600
+	MaxMsgLength   int    `json:"maxMsgLength,omitempty"`
+	BindingVersion string `json:"bindingVersion,omitempty"`
+}
+
+// DestinationType represents the type of IBM MQ object a channel maps to.
+const (
+	DestinationTypeTopic = "topic"
+	DestinationTypeQueue = "queue"
+)
+
+// Queue defines the properties of the queue when the channel is a queue.
+type Queue struct {
+	// +binding:required
+	ObjectName    string `json:"objectName"`
+	IsPartitioned bool   `json:"isPartitioned,omitempty"`
+	Exclusive     bool   `json:"exclusive,omitempty"`
+}
+
+// Topic defines the properties of the topic when the channel is a topic.
+type Topic struct {
+	String     string `json:"string,omitempty"`
+	ObjectName string `json:"objectName,omitempty"`
+	// DurablePermitted defaults to true when omitted.
+	DurablePermitted *bool `json:"durablePermitted,omitempty"`
+	LastMsgRetained  bool  `json:"lastMsgRetained,omitempty"`
+}
@@ -0,0 +1,108 @@
+package ibmmq
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestDestinationTypeConstants(t *testing.T) {
+	assert.Equal(t, "topic", DestinationTypeTopic)
+	assert.Equal(t, "queue", DestinationTypeQueue)
+}
+
+func TestChannelBinding_BuildObject(t *testing.T) {
+	cb := NewChannelBinding()
+	assert.Equal(t, DestinationTypeTopic, cb.DestinationType)
+
+	durablePermitted := false
+	cb.WithTopic(NewTopic().
+		WithString("product/categories/electronics").
+		WithObjectName("ELECTRONICS").
+		WithDurablePermitted(&durablePermitted).
+		WithLastMsgRetained(true),
+	).WithMaxMsgLength(1048576)
+
+	assert.Equal(t, "product/categories/electronics", cb.Topic.String)
+	assert.Equal(t, "ELECTRONICS", cb.Topic.ObjectName)
+	assert.False(t, *cb.Topic.DurablePermitted)
+	assert.True(t, cb.Topic.LastMsgRetained)
+	assert.Equal(t, 1048576, cb.MaxMsgLength)
+
+	cb = NewChannelBinding().
+		WithDestinationType(DestinationTypeQueue).
+		WithQueue(NewQueue().WithObjectName("PRODUCT.DATA").WithIsPartitioned(true).WithExclusive(true))
+
+	assert.Equal(t, DestinationTypeQueue, cb.DestinationType)
+	assert.Equal(t, &Queue{ObjectName: "PRODUCT.DATA", IsPartitioned: true, Exclusive: true}, cb.Queue)
+	assert.Nil(t, cb.Topic)
+}
+
+func TestChannelBinding_MarshalYAML(t *testing.T) {
+	cb := NewChannelBinding().
+		WithDestinationType(DestinationTypeQueue).
+		WithQueue(NewQueue().WithObjectName("PRODUCT.DATA").WithExclusive(true))
+
+	expectedYAML := `destinationType: queue
+queue:
+  exclusive: true
+  objectName: PRODUCT.DATA
+`
+	marshaledYAML, err := yaml.Marshal(cb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestChannelBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+destinationType: topic
+topic:
+  string: product/categories/electronics
+  objectName: ELECTRONICS
+  durablePermitted: false
+maxMsgLength: 1048576
+bindingVersion: 0.1.0
+`
+	var cb ChannelBinding
+	err := yaml.Unmarshal([]byte(yamlString), &cb)
+	assert.NoError(t, err)
+
+	durablePermitted := false
+	assert.Equal(t, DestinationTypeTopic, cb.DestinationType)
+	assert.Equal(t, &Topic{
+		String:           "product/categories/electronics",
+		ObjectName:       "ELECTRONICS",
+		DurablePermitted: &durablePermitted,
+	}, cb.Topic)
+	assert.Equal(t, 1048576, cb.MaxMsgLength)
+	assert.Equal(t, "0.1.0", cb.BindingVersion)
+}
+
+func TestChannelBinding_MarshalJSON(t *testing.T) {
+	cb := NewChannelBinding().
+		WithTopic(NewTopic().WithString("price/updates")).
+		WithBindingVersion(BindingVersion)
+
+	expectedJSON := `{"destinationType":"topic","topic":{"string":"price/updates"},"bindingVersion":"0.1.0"}`
+
+	marshaledJSON, err := json.Marshal(cb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestChannelBinding_UnmarshalJSON(t *testing.T) {
+	jsonString := `{
+		"destinationType": "queue",
+		"queue": {"objectName": "PRODUCT.DATA", "isPartitioned": true}
+	}`
+
+	var cb ChannelBinding
+	err := json.Unmarshal([]byte(jsonString), &cb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, DestinationTypeQueue, cb.DestinationType)
+	assert.Equal(t, &Queue{ObjectName: "PRODUCT.DATA", IsPartitioned: true}, cb.Queue)
+	assert.Nil(t, cb.Topic)
+}
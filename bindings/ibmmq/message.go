@@ -0,0 +1,26 @@
+package ibmmq
+
+// MessageBinding represents the IBM MQ Message Binding object.
+//
+// This object contains information about the message representation in IBM MQ.
+// +binding
+type MessageBinding struct {
+	// +binding:enum=string;jms;binary
+	Type string `json:"type,omitempty" default:"\"string\""`
+	// Headers is a comma separated list of the MQ headers included with a
+	// binary message.
+	Headers     string `json:"headers,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Expiry is the recommended time to live of the message in milliseconds,
+	// where zero means unlimited.
+	// +binding:min=0
+	Expiry         int    `json:"expiry,omitempty"`
+	BindingVersion string `json:"bindingVersion,omitempty"`
+}
+
+// MessageType represents the type of an IBM MQ message.
+const (
+	MessageTypeString = "string"
+	MessageTypeJMS    = "jms"
+	MessageTypeBinary = "binary"
+)
@@ -0,0 +1,83 @@
+package ibmmq
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestMessageTypeConstants(t *testing.T) {
+	assert.Equal(t, "string", MessageTypeString)
+	assert.Equal(t, "jms", MessageTypeJMS)
+	assert.Equal(t, "binary", MessageTypeBinary)
+}
+
+func TestMessageBinding_BuildObject(t *testing.T) {
+	mb := NewMessageBinding()
+	assert.Equal(t, MessageTypeString, mb.Type)
+
+	mb.WithType(MessageTypeBinary).
+		WithHeaders("MQMD,MQRFH2").
+		WithDescription("Product catalogue update").
+		WithExpiry(60000)
+
+	assert.Equal(t, MessageTypeBinary, mb.Type)
+	assert.Equal(t, "MQMD,MQRFH2", mb.Headers)
+	assert.Equal(t, "Product catalogue update", mb.Description)
+	assert.Equal(t, 60000, mb.Expiry)
+}
+
+func TestMessageBinding_MarshalYAML(t *testing.T) {
+	mb := NewMessageBinding().
+		WithType(MessageTypeBinary).
+		WithHeaders("MQMD,MQRFH2")
+
+	expectedYAML := `headers: MQMD,MQRFH2
+type: binary
+`
+	marshaledYAML, err := yaml.Marshal(mb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestMessageBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+type: jms
+description: JMS stream message
+expiry: 30000
+bindingVersion: 0.1.0
+`
+	var mb MessageBinding
+	err := yaml.Unmarshal([]byte(yamlString), &mb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, MessageTypeJMS, mb.Type)
+	assert.Empty(t, mb.Headers)
+	assert.Equal(t, "JMS stream message", mb.Description)
+	assert.Equal(t, 30000, mb.Expiry)
+	assert.Equal(t, "0.1.0", mb.BindingVersion)
+}
+
+func TestMessageBinding_MarshalJSON(t *testing.T) {
+	mb := NewMessageBinding().
+		WithExpiry(1000).
+		WithBindingVersion(BindingVersion)
+
+	expectedJSON := `{"type":"string","expiry":1000,"bindingVersion":"0.1.0"}`
+
+	marshaledJSON, err := json.Marshal(mb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestMessageBinding_UnmarshalJSON(t *testing.T) {
+	var mb MessageBinding
+	err := json.Unmarshal([]byte(`{"type": "binary", "headers": "MQMD"}`), &mb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, MessageTypeBinary, mb.Type)
+	assert.Equal(t, "MQMD", mb.Headers)
+	assert.Zero(t, mb.Expiry)
+}
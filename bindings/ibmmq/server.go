@@ -0,0 +1,18 @@
+package ibmmq
+
+// ServerBinding represents the IBM MQ Server Binding object.
+//
+// This object contains server connection information about the IBM MQ queue manager.
+// +binding
+type ServerBinding struct {
+	GroupID              string `json:"groupId,omitempty"`
+	CCDTQueueManagerName string `json:"ccdtQueueManagerName,omitempty" default:"\"*\""`
+	CipherSpec           string `json:"cipherSpec,omitempty"`
+	MultiEndpointServer  bool   `json:"multiEndpointServer,omitempty"`
+	// HeartBeatInterval is in seconds, and zero disables heartbeats. The
+	// specification's default is 300.
+	// +binding:min=0
+	// +binding:max=999999
+	HeartBeatInterval *int   `json:"heartBeatInterval,omitempty"`
+	BindingVersion    string `json:"bindingVersion,omitempty"`
+}
@@ -0,0 +1,87 @@
+package ibmmq
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestServerBinding_BuildObject(t *testing.T) {
+	sb := NewServerBinding()
+	assert.Equal(t, "*", sb.CCDTQueueManagerName)
+
+	heartBeatInterval := 0
+	sb.WithGroupID("PRODCLSTR1").
+		WithCCDTQueueManagerName("QM1").
+		WithCipherSpec("ANY_TLS12_OR_HIGHER").
+		WithMultiEndpointServer(true).
+		WithHeartBeatInterval(&heartBeatInterval)
+
+	assert.Equal(t, "PRODCLSTR1", sb.GroupID)
+	assert.Equal(t, "QM1", sb.CCDTQueueManagerName)
+	assert.Equal(t, "ANY_TLS12_OR_HIGHER", sb.CipherSpec)
+	assert.True(t, sb.MultiEndpointServer)
+	assert.Equal(t, 0, *sb.HeartBeatInterval)
+}
+
+func TestServerBinding_MarshalYAML(t *testing.T) {
+	heartBeatInterval := 0
+	sb := NewServerBinding().
+		WithGroupID("PRODCLSTR1").
+		WithCipherSpec("ANY_TLS12_OR_HIGHER").
+		WithHeartBeatInterval(&heartBeatInterval)
+
+	expectedYAML := `ccdtQueueManagerName: '*'
+cipherSpec: ANY_TLS12_OR_HIGHER
+groupId: PRODCLSTR1
+heartBeatInterval: 0
+`
+	marshaledYAML, err := yaml.Marshal(sb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestServerBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+groupId: PRODCLSTR1
+cipherSpec: ANY_TLS12_OR_HIGHER
+multiEndpointServer: true
+heartBeatInterval: 120
+bindingVersion: 0.1.0
+`
+	var sb ServerBinding
+	err := yaml.Unmarshal([]byte(yamlString), &sb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "PRODCLSTR1", sb.GroupID)
+	assert.Empty(t, sb.CCDTQueueManagerName)
+	assert.Equal(t, "ANY_TLS12_OR_HIGHER", sb.CipherSpec)
+	assert.True(t, sb.MultiEndpointServer)
+	assert.Equal(t, 120, *sb.HeartBeatInterval)
+	assert.Equal(t, "0.1.0", sb.BindingVersion)
+}
+
+func TestServerBinding_MarshalJSON(t *testing.T) {
+	sb := NewServerBinding().
+		WithGroupID("PRODCLSTR1").
+		WithBindingVersion(BindingVersion)
+
+	expectedJSON := `{"groupId":"PRODCLSTR1","ccdtQueueManagerName":"*","bindingVersion":"0.1.0"}`
+
+	marshaledJSON, err := json.Marshal(sb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestServerBinding_UnmarshalJSON(t *testing.T) {
+	var sb ServerBinding
+	err := json.Unmarshal([]byte(`{"ccdtQueueManagerName": "QM1", "heartBeatInterval": 0}`), &sb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "QM1", sb.CCDTQueueManagerName)
+	assert.NotNil(t, sb.HeartBeatInterval)
+	assert.Equal(t, 0, *sb.HeartBeatInterval)
+	assert.False(t, sb.MultiEndpointServer)
+}
@@ -0,0 +1,355 @@
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// THIS FILE IS GENERATED. DO NOT EDIT
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// If you would like to update properties for a binding,
+// edit the struct for the binding you'd like to update.
+// e.g ibmmq/channel.go and run `make generate` to re-gen
+// this file.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+package ibmmq
+
+import (
+	"encoding/json"
+	"sigs.k8s.io/yaml"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+
+	"errors"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+)
+
+// NewChannelBinding creates a new ChannelBinding object
+func NewChannelBinding() *ChannelBinding {
+	return &ChannelBinding{
+		DestinationType: "topic",
+	}
+}
+
+// WithDestinationType sets the 'destinationType' field of ChannelBinding
+func (obj *ChannelBinding) WithDestinationType(destinationType string) *ChannelBinding {
+	obj.DestinationType = destinationType
+	return obj
+}
+
+// WithQueue sets the 'queue' field of ChannelBinding
+func (obj *ChannelBinding) WithQueue(queue *Queue) *ChannelBinding {
+	obj.Queue = queue
+	return obj
+}
+
+// WithTopic sets the 'topic' field of ChannelBinding
+func (obj *ChannelBinding) WithTopic(topic *Topic) *ChannelBinding {
+	obj.Topic = topic
+	return obj
+}
+
+// WithMaxMsgLength sets the 'maxMsgLength' field of ChannelBinding
+func (obj *ChannelBinding) WithMaxMsgLength(maxMsgLength int) *ChannelBinding {
+	obj.MaxMsgLength = maxMsgLength
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of ChannelBinding
+func (obj *ChannelBinding) WithBindingVersion(bindingVersion string) *ChannelBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts ChannelBinding to YAML
+func (t ChannelBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to ChannelBinding
+func (t *ChannelBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts ChannelBinding to JSON
+func (t ChannelBinding) MarshalJSON() ([]byte, error) {
+	type Alias ChannelBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to ChannelBinding
+func (t *ChannelBinding) UnmarshalJSON(data []byte) error {
+	type Alias ChannelBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of ChannelBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ChannelBinding) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Enum("destinationType", t.DestinationType, "topic", "queue"))
+	if t.Queue != nil {
+		errs = append(errs, bindings.Nest("queue", t.Queue.Validate()))
+	}
+	if t.Topic != nil {
+		errs = append(errs, bindings.Nest("topic", t.Topic.Validate()))
+	}
+	if t.MaxMsgLength != 0 {
+		errs = append(errs, bindings.Min("maxMsgLength", t.MaxMsgLength, 0))
+		errs = append(errs, bindings.Max("maxMsgLength", t.MaxMsgLength, 104857600))
+	}
+	return errors.Join(errs...)
+}
+
+// NewQueue creates a new Queue object
+func NewQueue() *Queue {
+	return &Queue{}
+}
+
+// WithObjectName sets the 'objectName' field of Queue
+func (obj *Queue) WithObjectName(objectName string) *Queue {
+	obj.ObjectName = objectName
+	return obj
+}
+
+// WithIsPartitioned sets the 'isPartitioned' field of Queue
+func (obj *Queue) WithIsPartitioned(isPartitioned bool) *Queue {
+	obj.IsPartitioned = isPartitioned
+	return obj
+}
+
+// WithExclusive sets the 'exclusive' field of Queue
+func (obj *Queue) WithExclusive(exclusive bool) *Queue {
+	obj.Exclusive = exclusive
+	return obj
+}
+
+// Validate checks the fields of Queue, returning a
+// *bindings.FieldError for each invalid field
+func (t Queue) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("objectName", t.ObjectName != ""))
+	return errors.Join(errs...)
+}
+
+// NewTopic creates a new Topic object
+func NewTopic() *Topic {
+	return &Topic{}
+}
+
+// WithString sets the 'string' field of Topic
+func (obj *Topic) WithString(stringValue string) *Topic {
+	obj.String = stringValue
+	return obj
+}
+
+// WithObjectName sets the 'objectName' field of Topic
+func (obj *Topic) WithObjectName(objectName string) *Topic {
+	obj.ObjectName = objectName
+	return obj
+}
+
+// WithDurablePermitted sets the 'durablePermitted' field of Topic
+func (obj *Topic) WithDurablePermitted(durablePermitted *bool) *Topic {
+	obj.DurablePermitted = durablePermitted
+	return obj
+}
+
+// WithLastMsgRetained sets the 'lastMsgRetained' field of Topic
+func (obj *Topic) WithLastMsgRetained(lastMsgRetained bool) *Topic {
+	obj.LastMsgRetained = lastMsgRetained
+	return obj
+}
+
+// Validate checks the fields of Topic, returning a
+// *bindings.FieldError for each invalid field
+func (t Topic) Validate() error {
+	return nil
+}
+
+// NewMessageBinding creates a new MessageBinding object
+func NewMessageBinding() *MessageBinding {
+	return &MessageBinding{
+		Type: "string",
+	}
+}
+
+// WithType sets the 'type' field of MessageBinding
+func (obj *MessageBinding) WithType(typeValue string) *MessageBinding {
+	obj.Type = typeValue
+	return obj
+}
+
+// WithHeaders sets the 'headers' field of MessageBinding
+func (obj *MessageBinding) WithHeaders(headers string) *MessageBinding {
+	obj.Headers = headers
+	return obj
+}
+
+// WithDescription sets the 'description' field of MessageBinding
+func (obj *MessageBinding) WithDescription(description string) *MessageBinding {
+	obj.Description = description
+	return obj
+}
+
+// WithExpiry sets the 'expiry' field of MessageBinding
+func (obj *MessageBinding) WithExpiry(expiry int) *MessageBinding {
+	obj.Expiry = expiry
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of MessageBinding
+func (obj *MessageBinding) WithBindingVersion(bindingVersion string) *MessageBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts MessageBinding to YAML
+func (t MessageBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to MessageBinding
+func (t *MessageBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts MessageBinding to JSON
+func (t MessageBinding) MarshalJSON() ([]byte, error) {
+	type Alias MessageBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to MessageBinding
+func (t *MessageBinding) UnmarshalJSON(data []byte) error {
+	type Alias MessageBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of MessageBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t MessageBinding) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Enum("type", t.Type, "string", "jms", "binary"))
+	if t.Expiry != 0 {
+		errs = append(errs, bindings.Min("expiry", t.Expiry, 0))
+	}
+	return errors.Join(errs...)
+}
+
+// NewServerBinding creates a new ServerBinding object
+func NewServerBinding() *ServerBinding {
+	return &ServerBinding{
+		CCDTQueueManagerName: "*",
+	}
+}
+
+// WithGroupID sets the 'groupId' field of ServerBinding
+func (obj *ServerBinding) WithGroupID(groupId string) *ServerBinding {
+	obj.GroupID = groupId
+	return obj
+}
+
+// WithCCDTQueueManagerName sets the 'ccdtQueueManagerName' field of ServerBinding
+func (obj *ServerBinding) WithCCDTQueueManagerName(ccdtQueueManagerName string) *ServerBinding {
+	obj.CCDTQueueManagerName = ccdtQueueManagerName
+	return obj
+}
+
+// WithCipherSpec sets the 'cipherSpec' field of ServerBinding
+func (obj *ServerBinding) WithCipherSpec(cipherSpec string) *ServerBinding {
+	obj.CipherSpec = cipherSpec
+	return obj
+}
+
+// WithMultiEndpointServer sets the 'multiEndpointServer' field of ServerBinding
+func (obj *ServerBinding) WithMultiEndpointServer(multiEndpointServer bool) *ServerBinding {
+	obj.MultiEndpointServer = multiEndpointServer
+	return obj
+}
+
+// WithHeartBeatInterval sets the 'heartBeatInterval' field of ServerBinding
+func (obj *ServerBinding) WithHeartBeatInterval(heartBeatInterval *int) *ServerBinding {
+	obj.HeartBeatInterval = heartBeatInterval
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of ServerBinding
+func (obj *ServerBinding) WithBindingVersion(bindingVersion string) *ServerBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts ServerBinding to YAML
+func (t ServerBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to ServerBinding
+func (t *ServerBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts ServerBinding to JSON
+func (t ServerBinding) MarshalJSON() ([]byte, error) {
+	type Alias ServerBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to ServerBinding
+func (t *ServerBinding) UnmarshalJSON(data []byte) error {
+	type Alias ServerBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of ServerBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ServerBinding) Validate() error {
+	var errs []error
+	if t.HeartBeatInterval != nil {
+		errs = append(errs, bindings.Min("heartBeatInterval", *t.HeartBeatInterval, 0))
+		errs = append(errs, bindings.Max("heartBeatInterval", *t.HeartBeatInterval, 999999))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,18 @@
+package jms
+
+// ChannelBinding represents the JMS Channel Binding object.
+//
+// This object contains configuration for describing a JMS queue as an AsyncAPI channel.
+// +binding
+type ChannelBinding struct {
+	Destination string `json:"destination,omitempty"`
+	// +binding:enum=queue;fifo-queue
+	DestinationType string `json:"destinationType,omitempty" default:"\"queue\""`
+	BindingVersion  string `json:"bindingVersion,omitempty"`
+}
+
+// DestinationType represents the messaging model a channel supports.
+const (
+	DestinationTypeQueue     = "queue"
+	DestinationTypeFIFOQueue = "fifo-queue"
+)
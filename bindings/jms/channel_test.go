@@ -0,0 +1,71 @@
+package jms
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestDestinationTypeConstants(t *testing.T) {
+	assert.Equal(t, "queue", DestinationTypeQueue)
+	assert.Equal(t, "fifo-queue", DestinationTypeFIFOQueue)
+}
+
+func TestChannelBinding_BuildObject(t *testing.T) {
+	cb := NewChannelBinding()
+	assert.Equal(t, DestinationTypeQueue, cb.DestinationType)
+
+	cb.WithDestination("user-signed-up").WithDestinationType(DestinationTypeFIFOQueue)
+
+	assert.Equal(t, "user-signed-up", cb.Destination)
+	assert.Equal(t, DestinationTypeFIFOQueue, cb.DestinationType)
+}
+
+func TestChannelBinding_MarshalYAML(t *testing.T) {
+	cb := NewChannelBinding().WithDestination("user-signed-up")
+
+	expectedYAML := `destination: user-signed-up
+destinationType: queue
+`
+	marshaledYAML, err := yaml.Marshal(cb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestChannelBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+destination: user-signed-up
+destinationType: fifo-queue
+bindingVersion: 0.0.1
+`
+	var cb ChannelBinding
+	err := yaml.Unmarshal([]byte(yamlString), &cb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "user-signed-up", cb.Destination)
+	assert.Equal(t, DestinationTypeFIFOQueue, cb.DestinationType)
+	assert.Equal(t, "0.0.1", cb.BindingVersion)
+}
+
+func TestChannelBinding_MarshalJSON(t *testing.T) {
+	cb := NewChannelBinding().
+		WithDestination("user-signed-up").
+		WithBindingVersion(BindingVersion)
+
+	expectedJSON := `{"destination":"user-signed-up","destinationType":"queue","bindingVersion":"0.0.1"}`
+
+	marshaledJSON, err := json.Marshal(cb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestChannelBinding_UnmarshalJSON(t *testing.T) {
+	var cb ChannelBinding
+	err := json.Unmarshal([]byte(`{"destination": "user-signed-up"}`), &cb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "user-signed-up", cb.Destination)
+	assert.Empty(t, cb.DestinationType)
+}
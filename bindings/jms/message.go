@@ -0,0 +1,10 @@
+package jms
+
+// MessageBinding represents the JMS Message Binding object.
+//
+// This object contains configuration for describing a JMS message as an AsyncAPI message.
+// +binding
+type MessageBinding struct {
+	Headers        interface{} `json:"headers,omitempty"`
+	BindingVersion string      `json:"bindingVersion,omitempty"`
+}
@@ -0,0 +1,82 @@
+package jms
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+var headers = map[string]interface{}{
+	"type":     "object",
+	"required": []interface{}{"JMSMessageID"},
+	"properties": map[string]interface{}{
+		"JMSMessageID": map[string]interface{}{"type": []interface{}{"string", "null"}},
+	},
+}
+
+func TestMessageBinding_BuildObject(t *testing.T) {
+	mb := NewMessageBinding().WithHeaders(headers)
+
+	assert.Equal(t, headers, mb.Headers)
+}
+
+func TestMessageBinding_MarshalYAML(t *testing.T) {
+	mb := NewMessageBinding().WithHeaders(headers)
+
+	expectedYAML := `headers:
+  properties:
+    JMSMessageID:
+      type:
+      - string
+      - "null"
+  required:
+  - JMSMessageID
+  type: object
+`
+	marshaledYAML, err := yaml.Marshal(mb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestMessageBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+headers:
+  type: object
+  required:
+    - JMSMessageID
+  properties:
+    JMSMessageID:
+      type:
+        - string
+        - "null"
+bindingVersion: 0.0.1
+`
+	var mb MessageBinding
+	err := yaml.Unmarshal([]byte(yamlString), &mb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, headers, mb.Headers)
+	assert.Equal(t, "0.0.1", mb.BindingVersion)
+}
+
+func TestMessageBinding_MarshalJSON(t *testing.T) {
+	mb := NewMessageBinding().
+		WithHeaders(map[string]interface{}{"$ref": "#/components/schemas/JMSHeaders"}).
+		WithBindingVersion(BindingVersion)
+
+	expectedJSON := `{"headers":{"$ref":"#/components/schemas/JMSHeaders"},"bindingVersion":"0.0.1"}`
+
+	marshaledJSON, err := json.Marshal(mb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestMessageBinding_UnmarshalJSON(t *testing.T) {
+	var mb MessageBinding
+	err := json.Unmarshal([]byte(`{"headers": {"$ref": "#/components/schemas/JMSHeaders"}}`), &mb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"$ref": "#/components/schemas/JMSHeaders"}, mb.Headers)
+}
@@ -0,0 +1,21 @@
+package jms
+
+// ServerBinding represents the JMS Server Binding object.
+//
+// This object contains configuration for describing a JMS broker as an AsyncAPI server.
+// +binding
+type ServerBinding struct {
+	// +binding:required
+	JMSConnectionFactory string     `json:"jmsConnectionFactory"`
+	Properties           []Property `json:"properties,omitempty"`
+	ClientID             string     `json:"clientID,omitempty"`
+	BindingVersion       string     `json:"bindingVersion,omitempty"`
+}
+
+// Property is an additional property to set on the JMS ConnectionFactory.
+type Property struct {
+	// +binding:required
+	Name string `json:"name"`
+	// +binding:required
+	Value interface{} `json:"value"`
+}
@@ -0,0 +1,88 @@
+package jms
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestServerBinding_BuildObject(t *testing.T) {
+	sb := NewServerBinding().
+		WithJMSConnectionFactory("org.apache.activemq.ActiveMQConnectionFactory").
+		WithProperties([]Property{{Name: "disableTimeStampsByDefault", Value: false}}).
+		WithClientID("my-application-1")
+
+	assert.Equal(t, "org.apache.activemq.ActiveMQConnectionFactory", sb.JMSConnectionFactory)
+	assert.Equal(t, []Property{{Name: "disableTimeStampsByDefault", Value: false}}, sb.Properties)
+	assert.Equal(t, "my-application-1", sb.ClientID)
+}
+
+func TestServerBinding_MarshalYAML(t *testing.T) {
+	sb := NewServerBinding().
+		WithJMSConnectionFactory("org.apache.activemq.ActiveMQConnectionFactory").
+		WithProperties([]Property{{Name: "disableTimeStampsByDefault", Value: false}}).
+		WithClientID("my-application-1")
+
+	expectedYAML := `clientID: my-application-1
+jmsConnectionFactory: org.apache.activemq.ActiveMQConnectionFactory
+properties:
+- name: disableTimeStampsByDefault
+  value: false
+`
+	marshaledYAML, err := yaml.Marshal(sb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestServerBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+jmsConnectionFactory: org.apache.activemq.ActiveMQConnectionFactory
+properties:
+  - name: disableTimeStampsByDefault
+    value: false
+  - name: redeliveryDelay
+    value: 1000
+clientID: my-application-1
+bindingVersion: 0.0.1
+`
+	var sb ServerBinding
+	err := yaml.Unmarshal([]byte(yamlString), &sb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "org.apache.activemq.ActiveMQConnectionFactory", sb.JMSConnectionFactory)
+	assert.Equal(t, []Property{
+		{Name: "disableTimeStampsByDefault", Value: false},
+		{Name: "redeliveryDelay", Value: json.Number("1000")},
+	}, sb.Properties)
+	assert.Equal(t, "my-application-1", sb.ClientID)
+	assert.Equal(t, "0.0.1", sb.BindingVersion)
+}
+
+func TestServerBinding_MarshalJSON(t *testing.T) {
+	sb := NewServerBinding().
+		WithJMSConnectionFactory("org.apache.activemq.ActiveMQConnectionFactory").
+		WithBindingVersion(BindingVersion)
+
+	expectedJSON := `{"jmsConnectionFactory":"org.apache.activemq.ActiveMQConnectionFactory","bindingVersion":"0.0.1"}`
+
+	marshaledJSON, err := json.Marshal(sb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestServerBinding_UnmarshalJSON(t *testing.T) {
+	jsonString := `{
+		"jmsConnectionFactory": "org.apache.activemq.ActiveMQConnectionFactory",
+		"properties": [{"name": "brokerURL", "value": "tcp://localhost:61616"}]
+	}`
+
+	var sb ServerBinding
+	err := json.Unmarshal([]byte(jsonString), &sb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "org.apache.activemq.ActiveMQConnectionFactory", sb.JMSConnectionFactory)
+	assert.Equal(t, []Property{{Name: "brokerURL", Value: "tcp://localhost:61616"}}, sb.Properties)
+	assert.Empty(t, sb.ClientID)
+}
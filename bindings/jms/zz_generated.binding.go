@@ -0,0 +1,237 @@
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// THIS FILE IS GENERATED. DO NOT EDIT
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// If you would like to update properties for a binding,
+// edit the struct for the binding you'd like to update.
+// e.g jms/channel.go and run `make generate` to re-gen
+// this file.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+package jms
+
+import (
+	"encoding/json"
+	"sigs.k8s.io/yaml"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+
+	"errors"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+)
+
+// NewChannelBinding creates a new ChannelBinding object
+func NewChannelBinding() *ChannelBinding {
+	return &ChannelBinding{
+		DestinationType: "queue",
+	}
+}
+
+// WithDestination sets the 'destination' field of ChannelBinding
+func (obj *ChannelBinding) WithDestination(destination string) *ChannelBinding {
+	obj.Destination = destination
+	return obj
+}
+
+// WithDestinationType sets the 'destinationType' field of ChannelBinding
+func (obj *ChannelBinding) WithDestinationType(destinationType string) *ChannelBinding {
+	obj.DestinationType = destinationType
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of ChannelBinding
+func (obj *ChannelBinding) WithBindingVersion(bindingVersion string) *ChannelBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts ChannelBinding to YAML
+func (t ChannelBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to ChannelBinding
+func (t *ChannelBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts ChannelBinding to JSON
+func (t ChannelBinding) MarshalJSON() ([]byte, error) {
+	type Alias ChannelBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to ChannelBinding
+func (t *ChannelBinding) UnmarshalJSON(data []byte) error {
+	type Alias ChannelBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of ChannelBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ChannelBinding) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Enum("destinationType", t.DestinationType, "queue", "fifo-queue"))
+	return errors.Join(errs...)
+}
+
+// NewMessageBinding creates a new MessageBinding object
+func NewMessageBinding() *MessageBinding {
+	return &MessageBinding{}
+}
+
+// WithHeaders sets the 'headers' field of MessageBinding
+func (obj *MessageBinding) WithHeaders(headers interface{}) *MessageBinding {
+	obj.Headers = headers
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of MessageBinding
+func (obj *MessageBinding) WithBindingVersion(bindingVersion string) *MessageBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts MessageBinding to YAML
+func (t MessageBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to MessageBinding
+func (t *MessageBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts MessageBinding to JSON
+func (t MessageBinding) MarshalJSON() ([]byte, error) {
+	type Alias MessageBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to MessageBinding
+func (t *MessageBinding) UnmarshalJSON(data []byte) error {
+	type Alias MessageBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of MessageBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t MessageBinding) Validate() error {
+	return nil
+}
+
+// NewServerBinding creates a new ServerBinding object
+func NewServerBinding() *ServerBinding {
+	return &ServerBinding{}
+}
+
+// WithJMSConnectionFactory sets the 'jmsConnectionFactory' field of ServerBinding
+func (obj *ServerBinding) WithJMSConnectionFactory(jmsConnectionFactory string) *ServerBinding {
+	obj.JMSConnectionFactory = jmsConnectionFactory
+	return obj
+}
+
+// WithProperties sets the 'properties' field of ServerBinding
+func (obj *ServerBinding) WithProperties(properties []Property) *ServerBinding {
+	obj.Properties = properties
+	return obj
+}
+
+// WithClientID sets the 'clientID' field of ServerBinding
+func (obj *ServerBinding) WithClientID(clientID string) *ServerBinding {
+	obj.ClientID = clientID
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of ServerBinding
+func (obj *ServerBinding) WithBindingVersion(bindingVersion string) *ServerBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts ServerBinding to YAML
+func (t ServerBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to ServerBinding
+func (t *ServerBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts ServerBinding to JSON
+func (t ServerBinding) MarshalJSON() ([]byte, error) {
+	type Alias ServerBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to ServerBinding
+func (t *ServerBinding) UnmarshalJSON(data []byte) error {
+	type Alias ServerBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of ServerBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ServerBinding) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("jmsConnectionFactory", t.JMSConnectionFactory != ""))
+	for i, item := range t.Properties {
+		errs = append(errs, bindings.Nest(bindings.Index("properties", i), item.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the fields of Property, returning a
+// *bindings.FieldError for each invalid field
+func (t Property) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("name", t.Name != ""))
+	errs = append(errs, bindings.Required("value", t.Value != nil))
+	return errors.Join(errs...)
+}
@@ -1,5 +1,42 @@
 package kafka
 
+import "github.com/charlie-haley/asyncapi-go/bindings"
+
 //go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen
 
+// Protocol is the key kafka bindings appear under in a bindings object
+const Protocol = "kafka"
+
 const BindingVersion = "0.5.0"
+
+func init() {
+	bindings.Register(Protocol, bindings.Server, func() any { return &ServerBinding{} })
+	bindings.Register(Protocol, bindings.Channel, func() any { return &ChannelBinding{} })
+	bindings.Register(Protocol, bindings.Operation, func() any { return &OperationBinding{} })
+	bindings.Register(Protocol, bindings.Message, func() any { return &MessageBinding{} })
+
+	bindings.RegisterVersions(Protocol, bindings.Server, bindings.Versions{
+		Supported: []string{"0.3.0", "0.4.0", "0.5.0"},
+	})
+	bindings.RegisterVersions(Protocol, bindings.Channel, bindings.Versions{
+		Supported: []string{"0.3.0", "0.4.0", "0.5.0"},
+		Fields: []bindings.Field{
+			{Path: "topicConfiguration", Added: "0.4.0"},
+			{Path: "topicConfiguration/confluent.key.schema.validation", Added: "0.5.0"},
+			{Path: "topicConfiguration/confluent.key.subject.name.strategy", Added: "0.5.0"},
+			{Path: "topicConfiguration/confluent.value.schema.validation", Added: "0.5.0"},
+			{Path: "topicConfiguration/confluent.value.subject.name.strategy", Added: "0.5.0"},
+		},
+	})
+	bindings.RegisterVersions(Protocol, bindings.Operation, bindings.Versions{
+		Supported: []string{"0.1.0", "0.3.0", "0.4.0", "0.5.0"},
+	})
+	bindings.RegisterVersions(Protocol, bindings.Message, bindings.Versions{
+		Supported: []string{"0.1.0", "0.3.0", "0.4.0", "0.5.0"},
+		Fields: []bindings.Field{
+			{Path: "schemaIdLocation", Added: "0.3.0"},
+			{Path: "schemaIdPayloadEncoding", Added: "0.3.0"},
+			{Path: "schemaLookupStrategy", Added: "0.3.0"},
+		},
+	})
+}
@@ -2,6 +2,8 @@ package kafka
 
 import (
 	"encoding/json"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
 	"sigs.k8s.io/yaml"
 )
 
@@ -10,8 +12,10 @@ import (
 // +binding
 // +binding:marshal:no-gen
 type ChannelBinding struct {
-	Topic              string              `json:"topic,omitempty"`
-	Partitions         int                 `json:"partitions,omitempty"`
+	Topic string `json:"topic,omitempty"`
+	// +binding:min=1
+	Partitions int `json:"partitions,omitempty"`
+	// +binding:min=1
 	Replicas           int                 `json:"replicas,omitempty"`
 	TopicConfiguration *TopicConfiguration `json:"topicConfiguration,omitempty"`
 	BindingVersion     string              `json:"bindingVersion,omitempty"`
@@ -19,10 +23,15 @@ type ChannelBinding struct {
 
 // TopicConfiguration represents Kafka topic configuration properties.
 type TopicConfiguration struct {
-	CleanupPolicy                     []string               `json:"cleanup.policy,omitempty"`
-	RetentionMs                       int64                  `json:"retention.ms,omitempty"`
-	RetentionBytes                    int64                  `json:"retention.bytes,omitempty"`
-	DeleteRetentionMs                 int64                  `json:"delete.retention.ms,omitempty"`
+	// +binding:enum=compact;delete
+	CleanupPolicy []string `json:"cleanup.policy,omitempty"`
+	// +binding:min=-1
+	RetentionMs int64 `json:"retention.ms,omitempty"`
+	// +binding:min=-1
+	RetentionBytes int64 `json:"retention.bytes,omitempty"`
+	// +binding:min=0
+	DeleteRetentionMs int64 `json:"delete.retention.ms,omitempty"`
+	// +binding:min=0
 	MaxMessageBytes                   int                    `json:"max.message.bytes,omitempty"`
 	ConfluentKeySchemaValidation      bool                   `json:"confluent.key.schema.validation,omitempty"`
 	ConfluentKeySubjectNameStrategy   string                 `json:"confluent.key.subject.name.strategy,omitempty"`
@@ -46,7 +55,7 @@ func (c ChannelBinding) MarshalYAML() (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := json.Unmarshal(bytes, &m); err != nil {
+	if err := jsonnumber.Unmarshal(bytes, &m); err != nil {
 		return nil, err
 	}
 
@@ -56,7 +65,7 @@ func (c ChannelBinding) MarshalYAML() (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := json.Unmarshal(bytes, &topicConfig); err != nil {
+	if err := jsonnumber.Unmarshal(bytes, &topicConfig); err != nil {
 		return nil, err
 	}
 
@@ -82,14 +91,14 @@ func (c *ChannelBinding) UnmarshalYAML(unmarshal func(interface{}) error) error
 
 	type Alias ChannelBinding
 	aux := struct{ *Alias }{Alias: (*Alias)(c)}
-	if err := json.Unmarshal(bytes, &aux); err != nil {
+	if err := jsonnumber.Unmarshal(bytes, &aux); err != nil {
 		return err
 	}
 
 	// Handle additional properties in TopicConfiguration
 	if c.TopicConfiguration != nil {
 		var m map[string]interface{}
-		if err := yaml.Unmarshal(bytes, &m); err != nil {
+		if err := yaml.Unmarshal(bytes, &m, jsonnumber.UseNumber); err != nil {
 			return err
 		}
 		if topicConfig, ok := m["topicConfiguration"].(map[string]interface{}); ok {
@@ -127,7 +136,7 @@ func (c ChannelBinding) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := json.Unmarshal(bytes, &m); err != nil {
+	if err := jsonnumber.Unmarshal(bytes, &m); err != nil {
 		return nil, err
 	}
 
@@ -137,7 +146,7 @@ func (c ChannelBinding) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := json.Unmarshal(bytes, &topicConfig); err != nil {
+	if err := jsonnumber.Unmarshal(bytes, &topicConfig); err != nil {
 		return nil, err
 	}
 
@@ -154,14 +163,14 @@ func (c ChannelBinding) MarshalJSON() ([]byte, error) {
 func (c *ChannelBinding) UnmarshalJSON(data []byte) error {
 	type Alias ChannelBinding
 	aux := struct{ *Alias }{Alias: (*Alias)(c)}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	if err := jsonnumber.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 
 	// Handle additional properties in TopicConfiguration
 	if c.TopicConfiguration != nil {
 		var m map[string]interface{}
-		if err := json.Unmarshal(data, &m); err != nil {
+		if err := jsonnumber.Unmarshal(data, &m); err != nil {
 			return err
 		}
 		if topicConfig, ok := m["topicConfiguration"].(map[string]interface{}); ok {
@@ -23,7 +23,7 @@ func TestChannelBinding_TopicConfigurationAdditionalProperties(t *testing.T) {
 	err := cb.UnmarshalJSON([]byte(jsonString))
 	assert.NoError(t, err)
 	assert.Equal(t, "custom-value", cb.TopicConfiguration.AdditionalProperties["custom.property"])
-	assert.Equal(t, float64(123), cb.TopicConfiguration.AdditionalProperties["another.property"])
+	assert.Equal(t, json.Number("123"), cb.TopicConfiguration.AdditionalProperties["another.property"])
 	assert.Equal(t, map[string]interface{}{"key": "value"}, cb.TopicConfiguration.AdditionalProperties["nested.property"])
 
 	marshaledJSON, err := cb.MarshalJSON()
@@ -34,7 +34,7 @@ func TestChannelBinding_TopicConfigurationAdditionalProperties(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, "custom-value", unmarshaled.TopicConfiguration.AdditionalProperties["custom.property"])
-	assert.Equal(t, float64(123), unmarshaled.TopicConfiguration.AdditionalProperties["another.property"])
+	assert.Equal(t, json.Number("123"), unmarshaled.TopicConfiguration.AdditionalProperties["another.property"])
 	assert.Equal(t, map[string]interface{}{"key": "value"}, unmarshaled.TopicConfiguration.AdditionalProperties["nested.property"])
 }
 
@@ -54,7 +54,7 @@ topicConfiguration:
 	err := yaml.Unmarshal(yamlString, &cb)
 	assert.NoError(t, err)
 	assert.Equal(t, "custom-value", cb.TopicConfiguration.AdditionalProperties["custom.property"])
-	assert.Equal(t, float64(123), cb.TopicConfiguration.AdditionalProperties["another.property"])
+	assert.Equal(t, json.Number("123"), cb.TopicConfiguration.AdditionalProperties["another.property"])
 	assert.Equal(t, map[string]interface{}{"key": "value"}, cb.TopicConfiguration.AdditionalProperties["nested.property"])
 
 	marshaledYAML, err := yaml.Marshal(cb)
@@ -65,7 +65,7 @@ topicConfiguration:
 	assert.NoError(t, err)
 
 	assert.Equal(t, "custom-value", unmarshaled.TopicConfiguration.AdditionalProperties["custom.property"])
-	assert.Equal(t, float64(123), unmarshaled.TopicConfiguration.AdditionalProperties["another.property"])
+	assert.Equal(t, json.Number("123"), unmarshaled.TopicConfiguration.AdditionalProperties["another.property"])
 	assert.Equal(t, map[string]interface{}{"key": "value"}, unmarshaled.TopicConfiguration.AdditionalProperties["nested.property"])
 }
 
@@ -191,3 +191,18 @@ func TestChannelBinding_UnmarshalJSON(t *testing.T) {
 	assert.True(t, cb.TopicConfiguration.ConfluentValueSchemaValidation)
 	assert.Equal(t, "TopicNameStrategy", cb.TopicConfiguration.ConfluentValueSubjectNameStrategy)
 }
+
+func TestChannelBinding_LargeIntegers(t *testing.T) {
+	jsonString := `{"topicConfiguration":{"retention.bytes":9007199254740993,"local.retention.bytes":9007199254740993,"segment.bytes":1000000000000}}`
+
+	cb := NewChannelBinding()
+	assert.NoError(t, cb.UnmarshalJSON([]byte(jsonString)))
+	assert.Equal(t, int64(9007199254740993), cb.TopicConfiguration.RetentionBytes)
+	assert.Equal(t, json.Number("9007199254740993"), cb.TopicConfiguration.AdditionalProperties["local.retention.bytes"])
+
+	marshaledJSON, err := cb.MarshalJSON()
+	assert.NoError(t, err)
+	assert.JSONEq(t, jsonString, string(marshaledJSON))
+	assert.Contains(t, string(marshaledJSON), `"segment.bytes":1000000000000`)
+	assert.Contains(t, string(marshaledJSON), `"retention.bytes":9007199254740993`)
+}
@@ -96,5 +96,5 @@ func TestMessageBinding_NonStringKey(t *testing.T) {
 	var mb MessageBinding
 	err := json.Unmarshal([]byte(jsonString), &mb)
 	assert.NoError(t, err)
-	assert.Equal(t, float64(123), mb.Key)
+	assert.Equal(t, json.Number("123"), mb.Key)
 }
@@ -83,6 +83,6 @@ func TestOperationBinding_NonStringFields(t *testing.T) {
 	var ob OperationBinding
 	err := json.Unmarshal([]byte(jsonString), &ob)
 	assert.NoError(t, err)
-	assert.Equal(t, float64(123), ob.GroupID)
+	assert.Equal(t, json.Number("123"), ob.GroupID)
 	assert.Equal(t, []interface{}{"client1", "client2"}, ob.ClientID)
 }
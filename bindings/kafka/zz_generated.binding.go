@@ -10,98 +10,21 @@
 package kafka
 
 import (
-
 	"encoding/json"
 	"sigs.k8s.io/yaml"
 
-)
-
-
-// NewMessageBinding creates a new MessageBinding object
-func NewMessageBinding() *MessageBinding {
-	return &MessageBinding{
-	}
-}
-
-
-// WithKey sets the 'key' field of MessageBinding
-func (obj *MessageBinding) WithKey(key interface{}) *MessageBinding {
-	obj.Key = key
-	return obj
-}
-
-// WithSchemaIDLocation sets the 'schemaIdLocation' field of MessageBinding
-func (obj *MessageBinding) WithSchemaIDLocation(schemaIdLocation string) *MessageBinding {
-	obj.SchemaIDLocation = schemaIdLocation
-	return obj
-}
-
-// WithSchemaIDPayloadEncoding sets the 'schemaIdPayloadEncoding' field of MessageBinding
-func (obj *MessageBinding) WithSchemaIDPayloadEncoding(schemaIdPayloadEncoding string) *MessageBinding {
-	obj.SchemaIDPayloadEncoding = schemaIdPayloadEncoding
-	return obj
-}
-
-// WithSchemaLookupStrategy sets the 'schemaLookupStrategy' field of MessageBinding
-func (obj *MessageBinding) WithSchemaLookupStrategy(schemaLookupStrategy string) *MessageBinding {
-	obj.SchemaLookupStrategy = schemaLookupStrategy
-	return obj
-}
-
-// WithBindingVersion sets the 'bindingVersion' field of MessageBinding
-func (obj *MessageBinding) WithBindingVersion(bindingVersion string) *MessageBinding {
-	obj.BindingVersion = bindingVersion
-	return obj
-}
-
-
-
-// MarshalYAML is a custom marshaller that converts MessageBinding to YAML
-func (t MessageBinding) MarshalYAML() (interface{}, error) {
-    bytes, err := json.Marshal(t)
-    if err != nil {
-        return nil, err
-    }
-    var out interface{}
-    err = yaml.Unmarshal(bytes, &out) 
-    return out, err
-}
-
-// UnmarshalYAML is a custom unmarshaler that converts YAML to MessageBinding
-func (t *MessageBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
-    var temp interface{}
-    if err := unmarshal(&temp); err != nil {
-        return err
-    }
-    bytes, err := yaml.Marshal(temp)
-    if err != nil {
-        return err
-    }
-    return json.Unmarshal(bytes, t)
-}
-
-// MarshalJSON is a custom marshaller that converts MessageBinding to JSON
-func (t MessageBinding) MarshalJSON() ([]byte, error) {
-	type Alias MessageBinding
-	return json.Marshal(struct{ Alias }{Alias(t)})
-}
-
-// UnmarshalJSON is a custom unmarshaler that converts JSON to MessageBinding
-func (t *MessageBinding) UnmarshalJSON(data []byte) error {
-	type Alias MessageBinding
-	aux := struct{ *Alias }{Alias: (*Alias)(t)}
-	return json.Unmarshal(data, &aux)
-}
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
 
+	"errors"
 
+	"github.com/charlie-haley/asyncapi-go/bindings"
+)
 
 // NewChannelBinding creates a new ChannelBinding object
 func NewChannelBinding() *ChannelBinding {
-	return &ChannelBinding{
-	}
+	return &ChannelBinding{}
 }
 
-
 // WithTopic sets the 'topic' field of ChannelBinding
 func (obj *ChannelBinding) WithTopic(topic string) *ChannelBinding {
 	obj.Topic = topic
@@ -132,17 +55,27 @@ func (obj *ChannelBinding) WithBindingVersion(bindingVersion string) *ChannelBin
 	return obj
 }
 
-
-
-
+// Validate checks the fields of ChannelBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ChannelBinding) Validate() error {
+	var errs []error
+	if t.Partitions != 0 {
+		errs = append(errs, bindings.Min("partitions", t.Partitions, 1))
+	}
+	if t.Replicas != 0 {
+		errs = append(errs, bindings.Min("replicas", t.Replicas, 1))
+	}
+	if t.TopicConfiguration != nil {
+		errs = append(errs, bindings.Nest("topicConfiguration", t.TopicConfiguration.Validate()))
+	}
+	return errors.Join(errs...)
+}
 
 // NewTopicConfiguration creates a new TopicConfiguration object
 func NewTopicConfiguration() *TopicConfiguration {
-	return &TopicConfiguration{
-	}
+	return &TopicConfiguration{}
 }
 
-
 // WithCleanupPolicy sets the 'cleanup.policy' field of TopicConfiguration
 func (obj *TopicConfiguration) WithCleanupPolicy(cleanuppolicy []string) *TopicConfiguration {
 	obj.CleanupPolicy = cleanuppolicy
@@ -203,16 +136,112 @@ func (obj *TopicConfiguration) WithAdditionalProperties(additionalProperties map
 	return obj
 }
 
+// Validate checks the fields of TopicConfiguration, returning a
+// *bindings.FieldError for each invalid field
+func (t TopicConfiguration) Validate() error {
+	var errs []error
+	for i, item := range t.CleanupPolicy {
+		errs = append(errs, bindings.Enum(bindings.Index("cleanup.policy", i), item, "compact", "delete"))
+	}
+	if t.RetentionMs != 0 {
+		errs = append(errs, bindings.Min("retention.ms", t.RetentionMs, -1))
+	}
+	if t.RetentionBytes != 0 {
+		errs = append(errs, bindings.Min("retention.bytes", t.RetentionBytes, -1))
+	}
+	if t.DeleteRetentionMs != 0 {
+		errs = append(errs, bindings.Min("delete.retention.ms", t.DeleteRetentionMs, 0))
+	}
+	if t.MaxMessageBytes != 0 {
+		errs = append(errs, bindings.Min("max.message.bytes", t.MaxMessageBytes, 0))
+	}
+	return errors.Join(errs...)
+}
 
+// NewMessageBinding creates a new MessageBinding object
+func NewMessageBinding() *MessageBinding {
+	return &MessageBinding{}
+}
 
+// WithKey sets the 'key' field of MessageBinding
+func (obj *MessageBinding) WithKey(key interface{}) *MessageBinding {
+	obj.Key = key
+	return obj
+}
 
+// WithSchemaIDLocation sets the 'schemaIdLocation' field of MessageBinding
+func (obj *MessageBinding) WithSchemaIDLocation(schemaIdLocation string) *MessageBinding {
+	obj.SchemaIDLocation = schemaIdLocation
+	return obj
+}
 
-// NewOperationBinding creates a new OperationBinding object
-func NewOperationBinding() *OperationBinding {
-	return &OperationBinding{
+// WithSchemaIDPayloadEncoding sets the 'schemaIdPayloadEncoding' field of MessageBinding
+func (obj *MessageBinding) WithSchemaIDPayloadEncoding(schemaIdPayloadEncoding string) *MessageBinding {
+	obj.SchemaIDPayloadEncoding = schemaIdPayloadEncoding
+	return obj
+}
+
+// WithSchemaLookupStrategy sets the 'schemaLookupStrategy' field of MessageBinding
+func (obj *MessageBinding) WithSchemaLookupStrategy(schemaLookupStrategy string) *MessageBinding {
+	obj.SchemaLookupStrategy = schemaLookupStrategy
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of MessageBinding
+func (obj *MessageBinding) WithBindingVersion(bindingVersion string) *MessageBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts MessageBinding to YAML
+func (t MessageBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to MessageBinding
+func (t *MessageBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
 	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts MessageBinding to JSON
+func (t MessageBinding) MarshalJSON() ([]byte, error) {
+	type Alias MessageBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to MessageBinding
+func (t *MessageBinding) UnmarshalJSON(data []byte) error {
+	type Alias MessageBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of MessageBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t MessageBinding) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Enum("schemaIdLocation", t.SchemaIDLocation, "header", "payload"))
+	return errors.Join(errs...)
 }
 
+// NewOperationBinding creates a new OperationBinding object
+func NewOperationBinding() *OperationBinding {
+	return &OperationBinding{}
+}
 
 // WithGroupID sets the 'groupId' field of OperationBinding
 func (obj *OperationBinding) WithGroupID(groupId interface{}) *OperationBinding {
@@ -232,30 +261,28 @@ func (obj *OperationBinding) WithBindingVersion(bindingVersion string) *Operatio
 	return obj
 }
 
-
-
 // MarshalYAML is a custom marshaller that converts OperationBinding to YAML
 func (t OperationBinding) MarshalYAML() (interface{}, error) {
-    bytes, err := json.Marshal(t)
-    if err != nil {
-        return nil, err
-    }
-    var out interface{}
-    err = yaml.Unmarshal(bytes, &out) 
-    return out, err
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
 }
 
 // UnmarshalYAML is a custom unmarshaler that converts YAML to OperationBinding
 func (t *OperationBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
-    var temp interface{}
-    if err := unmarshal(&temp); err != nil {
-        return err
-    }
-    bytes, err := yaml.Marshal(temp)
-    if err != nil {
-        return err
-    }
-    return json.Unmarshal(bytes, t)
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
 }
 
 // MarshalJSON is a custom marshaller that converts OperationBinding to JSON
@@ -268,18 +295,20 @@ func (t OperationBinding) MarshalJSON() ([]byte, error) {
 func (t *OperationBinding) UnmarshalJSON(data []byte) error {
 	type Alias OperationBinding
 	aux := struct{ *Alias }{Alias: (*Alias)(t)}
-	return json.Unmarshal(data, &aux)
+	return jsonnumber.Unmarshal(data, &aux)
 }
 
-
+// Validate checks the fields of OperationBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t OperationBinding) Validate() error {
+	return nil
+}
 
 // NewServerBinding creates a new ServerBinding object
 func NewServerBinding() *ServerBinding {
-	return &ServerBinding{
-	}
+	return &ServerBinding{}
 }
 
-
 // WithSchemaRegistryURL sets the 'schemaRegistryUrl' field of ServerBinding
 func (obj *ServerBinding) WithSchemaRegistryURL(schemaRegistryUrl string) *ServerBinding {
 	obj.SchemaRegistryURL = schemaRegistryUrl
@@ -298,30 +327,28 @@ func (obj *ServerBinding) WithBindingVersion(bindingVersion string) *ServerBindi
 	return obj
 }
 
-
-
 // MarshalYAML is a custom marshaller that converts ServerBinding to YAML
 func (t ServerBinding) MarshalYAML() (interface{}, error) {
-    bytes, err := json.Marshal(t)
-    if err != nil {
-        return nil, err
-    }
-    var out interface{}
-    err = yaml.Unmarshal(bytes, &out) 
-    return out, err
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
 }
 
 // UnmarshalYAML is a custom unmarshaler that converts YAML to ServerBinding
 func (t *ServerBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
-    var temp interface{}
-    if err := unmarshal(&temp); err != nil {
-        return err
-    }
-    bytes, err := yaml.Marshal(temp)
-    if err != nil {
-        return err
-    }
-    return json.Unmarshal(bytes, t)
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
 }
 
 // MarshalJSON is a custom marshaller that converts ServerBinding to JSON
@@ -334,7 +361,11 @@ func (t ServerBinding) MarshalJSON() ([]byte, error) {
 func (t *ServerBinding) UnmarshalJSON(data []byte) error {
 	type Alias ServerBinding
 	aux := struct{ *Alias }{Alias: (*Alias)(t)}
-	return json.Unmarshal(data, &aux)
+	return jsonnumber.Unmarshal(data, &aux)
 }
 
-
+// Validate checks the fields of ServerBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ServerBinding) Validate() error {
+	return nil
+}
@@ -0,0 +1,39 @@
+package mqtt
+
+import "github.com/charlie-haley/asyncapi-go/bindings"
+
+//go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen
+
+// Protocol is the key mqtt bindings appear under in a bindings object
+const Protocol = "mqtt"
+
+const BindingVersion = "0.2.0"
+
+func init() {
+	bindings.Register(Protocol, bindings.Server, func() any { return &ServerBinding{} })
+	bindings.Register(Protocol, bindings.Operation, func() any { return &OperationBinding{} })
+	bindings.Register(Protocol, bindings.Message, func() any { return &MessageBinding{} })
+
+	bindings.RegisterVersions(Protocol, bindings.Server, bindings.Versions{
+		Supported: []string{"0.1.0", "0.2.0"},
+		Fields: []bindings.Field{
+			{Path: "sessionExpiryInterval", Added: "0.2.0"},
+			{Path: "maximumPacketSize", Added: "0.2.0"},
+		},
+	})
+	bindings.RegisterVersions(Protocol, bindings.Operation, bindings.Versions{
+		Supported: []string{"0.1.0", "0.2.0"},
+		Fields: []bindings.Field{
+			{Path: "messageExpiryInterval", Added: "0.2.0"},
+		},
+	})
+	bindings.RegisterVersions(Protocol, bindings.Message, bindings.Versions{
+		Supported: []string{"0.1.0", "0.2.0"},
+		Fields: []bindings.Field{
+			{Path: "payloadFormatIndicator", Added: "0.2.0"},
+			{Path: "correlationData", Added: "0.2.0"},
+			{Path: "contentType", Added: "0.2.0"},
+			{Path: "responseTopic", Added: "0.2.0"},
+		},
+	})
+}
@@ -0,0 +1,23 @@
+package mqtt
+
+// MessageBinding represents the MQTT Message Binding object.
+//
+// This object contains information about the message representation in MQTT.
+// Its fields are only supported by MQTT 5. CorrelationData holds a Schema
+// Object, and ResponseTopic either a topic or a Schema Object.
+// +binding
+type MessageBinding struct {
+	// +binding:min=0
+	// +binding:max=1
+	PayloadFormatIndicator int         `json:"payloadFormatIndicator,omitempty"`
+	CorrelationData        interface{} `json:"correlationData,omitempty"`
+	ContentType            string      `json:"contentType,omitempty"`
+	ResponseTopic          interface{} `json:"responseTopic,omitempty"`
+	BindingVersion         string      `json:"bindingVersion,omitempty"`
+}
+
+// PayloadFormatIndicator represents whether a payload is UTF-8 encoded character data.
+const (
+	PayloadFormatUnspecified = 0
+	PayloadFormatUTF8        = 1
+)
@@ -0,0 +1,86 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestMessageBinding_BuildObject(t *testing.T) {
+	mb := NewMessageBinding().
+		WithPayloadFormatIndicator(PayloadFormatUTF8).
+		WithCorrelationData(map[string]interface{}{"type": "string", "format": "uuid"}).
+		WithContentType("application/json").
+		WithResponseTopic("application/responses")
+
+	assert.Equal(t, PayloadFormatUTF8, mb.PayloadFormatIndicator)
+	assert.Equal(t, map[string]interface{}{"type": "string", "format": "uuid"}, mb.CorrelationData)
+	assert.Equal(t, "application/json", mb.ContentType)
+	assert.Equal(t, "application/responses", mb.ResponseTopic)
+}
+
+func TestMessageBinding_MarshalYAML(t *testing.T) {
+	mb := NewMessageBinding().
+		WithCorrelationData(map[string]interface{}{"type": "string", "format": "uuid"}).
+		WithContentType("application/json").
+		WithResponseTopic("application/responses")
+
+	expectedYAML := `contentType: application/json
+correlationData:
+  format: uuid
+  type: string
+responseTopic: application/responses
+`
+	marshaledYAML, err := yaml.Marshal(mb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestMessageBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+payloadFormatIndicator: 1
+contentType: application/json
+responseTopic:
+  type: string
+  pattern: ^responses/
+`
+	var mb MessageBinding
+	err := yaml.Unmarshal([]byte(yamlString), &mb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, PayloadFormatUTF8, mb.PayloadFormatIndicator)
+	assert.Equal(t, "application/json", mb.ContentType)
+	assert.Equal(t, map[string]interface{}{"type": "string", "pattern": "^responses/"}, mb.ResponseTopic)
+}
+
+func TestMessageBinding_MarshalJSON(t *testing.T) {
+	mb := NewMessageBinding().
+		WithPayloadFormatIndicator(PayloadFormatUTF8).
+		WithContentType("text/plain").
+		WithBindingVersion(BindingVersion)
+
+	expectedJSON := `{"payloadFormatIndicator":1,"contentType":"text/plain","bindingVersion":"0.2.0"}`
+
+	marshaledJSON, err := json.Marshal(mb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestMessageBinding_UnmarshalJSON(t *testing.T) {
+	jsonString := `{
+		"contentType": "application/json",
+		"correlationData": {"$ref": "#/components/schemas/CorrelationId"},
+		"responseTopic": "application/responses"
+	}`
+
+	var mb MessageBinding
+	err := json.Unmarshal([]byte(jsonString), &mb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, PayloadFormatUnspecified, mb.PayloadFormatIndicator)
+	assert.Equal(t, "application/json", mb.ContentType)
+	assert.Equal(t, map[string]interface{}{"$ref": "#/components/schemas/CorrelationId"}, mb.CorrelationData)
+	assert.Equal(t, "application/responses", mb.ResponseTopic)
+}
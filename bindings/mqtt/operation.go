@@ -0,0 +1,18 @@
+package mqtt
+
+// OperationBinding represents the MQTT Operation Binding object.
+//
+// This object contains information about the operation representation in MQTT.
+// MessageExpiryInterval is only supported by MQTT 5, and holds either an
+// integer or a Schema Object.
+// +binding
+type OperationBinding struct {
+	// QoS is a pointer as 0, at most once delivery, isn't the same as
+	// leaving it unset
+	// +binding:min=0
+	// +binding:max=2
+	QoS                   *int        `json:"qos,omitempty"`
+	Retain                bool        `json:"retain,omitempty"`
+	MessageExpiryInterval interface{} `json:"messageExpiryInterval,omitempty"`
+	BindingVersion        string      `json:"bindingVersion,omitempty"`
+}
@@ -0,0 +1,100 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestOperationBinding_BuildObject(t *testing.T) {
+	qos := QoSExactlyOnce
+	ob := NewOperationBinding().
+		WithQoS(&qos).
+		WithRetain(true).
+		WithMessageExpiryInterval(60)
+
+	assert.Equal(t, QoSExactlyOnce, *ob.QoS)
+	assert.True(t, ob.Retain)
+	assert.Equal(t, 60, ob.MessageExpiryInterval)
+}
+
+func TestOperationBinding_MarshalYAML(t *testing.T) {
+	qos := QoSExactlyOnce
+	ob := NewOperationBinding().
+		WithQoS(&qos).
+		WithRetain(true).
+		WithMessageExpiryInterval(60).
+		WithBindingVersion(BindingVersion)
+
+	expectedYAML := `bindingVersion: 0.2.0
+messageExpiryInterval: 60
+qos: 2
+retain: true
+`
+	marshaledYAML, err := yaml.Marshal(ob)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestOperationBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+qos: 1
+retain: false
+messageExpiryInterval:
+  type: integer
+  maximum: 3600
+`
+	var ob OperationBinding
+	err := yaml.Unmarshal([]byte(yamlString), &ob)
+	assert.NoError(t, err)
+
+	assert.Equal(t, QoSAtLeastOnce, *ob.QoS)
+	assert.False(t, ob.Retain)
+	assert.Equal(t, map[string]interface{}{"type": "integer", "maximum": json.Number("3600")}, ob.MessageExpiryInterval)
+}
+
+func TestOperationBinding_MarshalJSON(t *testing.T) {
+	qos := QoSAtLeastOnce
+	ob := NewOperationBinding().
+		WithQoS(&qos).
+		WithMessageExpiryInterval(4294967295)
+
+	expectedJSON := `{"qos":1,"messageExpiryInterval":4294967295}`
+
+	marshaledJSON, err := json.Marshal(ob)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestOperationBinding_UnmarshalJSON(t *testing.T) {
+	jsonString := `{
+		"qos": 2,
+		"retain": true,
+		"messageExpiryInterval": 4294967295
+	}`
+
+	var ob OperationBinding
+	err := json.Unmarshal([]byte(jsonString), &ob)
+	assert.NoError(t, err)
+
+	assert.Equal(t, QoSExactlyOnce, *ob.QoS)
+	assert.True(t, ob.Retain)
+	assert.Equal(t, json.Number("4294967295"), ob.MessageExpiryInterval)
+}
+
+func TestOperationBinding_QoSAtMostOnce(t *testing.T) {
+	var ob OperationBinding
+	assert.NoError(t, json.Unmarshal([]byte(`{"qos":0,"retain":true}`), &ob))
+	assert.Equal(t, QoSAtMostOnce, *ob.QoS)
+	assert.NoError(t, ob.Validate())
+
+	marshaledJSON, err := json.Marshal(ob)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"qos":0,"retain":true}`, string(marshaledJSON))
+
+	var unset OperationBinding
+	assert.NoError(t, json.Unmarshal([]byte(`{"retain":true}`), &unset))
+	assert.Nil(t, unset.QoS)
+}
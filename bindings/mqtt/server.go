@@ -0,0 +1,38 @@
+package mqtt
+
+// ServerBinding represents the MQTT Server Binding object.
+//
+// This object contains information about the server representation in MQTT.
+// SessionExpiryInterval and MaximumPacketSize are only supported by MQTT 5,
+// and hold either an integer or a Schema Object.
+// +binding
+type ServerBinding struct {
+	ClientID string `json:"clientId,omitempty"`
+	// CleanSession is a pointer as false, a persistent session, isn't the
+	// same as leaving it unset
+	CleanSession          *bool       `json:"cleanSession,omitempty"`
+	LastWill              *LastWill   `json:"lastWill,omitempty"`
+	KeepAlive             int         `json:"keepAlive,omitempty"`
+	SessionExpiryInterval interface{} `json:"sessionExpiryInterval,omitempty"`
+	MaximumPacketSize     interface{} `json:"maximumPacketSize,omitempty"`
+	BindingVersion        string      `json:"bindingVersion,omitempty"`
+}
+
+// LastWill is the Last Will and Testament the broker publishes when the client disconnects unexpectedly.
+type LastWill struct {
+	Topic string `json:"topic,omitempty"`
+	// QoS is a pointer as 0, at most once delivery, isn't the same as
+	// leaving it unset
+	// +binding:min=0
+	// +binding:max=2
+	QoS     *int   `json:"qos,omitempty"`
+	Message string `json:"message,omitempty"`
+	Retain  bool   `json:"retain,omitempty"`
+}
+
+// QoS represents the MQTT quality of service levels.
+const (
+	QoSAtMostOnce  = 0
+	QoSAtLeastOnce = 1
+	QoSExactlyOnce = 2
+)
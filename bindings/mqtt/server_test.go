@@ -0,0 +1,112 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func newTestServerBinding() *ServerBinding {
+	cleanSession := false
+	qos := QoSAtLeastOnce
+	return NewServerBinding().
+		WithClientID("sensor-gateway").
+		WithCleanSession(&cleanSession).
+		WithLastWill(NewLastWill().
+			WithTopic("sensor/disconnect").
+			WithQoS(&qos).
+			WithMessage("Sensor disconnected").
+			WithRetain(true)).
+		WithKeepAlive(60)
+}
+
+func TestServerBinding_BuildObject(t *testing.T) {
+	sb := newTestServerBinding()
+
+	assert.Equal(t, "sensor-gateway", sb.ClientID)
+	assert.False(t, *sb.CleanSession)
+	assert.Equal(t, "sensor/disconnect", sb.LastWill.Topic)
+	assert.Equal(t, QoSAtLeastOnce, *sb.LastWill.QoS)
+	assert.Equal(t, "Sensor disconnected", sb.LastWill.Message)
+	assert.True(t, sb.LastWill.Retain)
+	assert.Equal(t, 60, sb.KeepAlive)
+}
+
+func TestServerBinding_MarshalYAML(t *testing.T) {
+	expectedYAML := `cleanSession: false
+clientId: sensor-gateway
+keepAlive: 60
+lastWill:
+  message: Sensor disconnected
+  qos: 1
+  retain: true
+  topic: sensor/disconnect
+`
+	marshaledYAML, err := yaml.Marshal(newTestServerBinding())
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestServerBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+clientId: sensor-gateway
+cleanSession: false
+lastWill:
+  topic: sensor/disconnect
+  qos: 1
+  message: Sensor disconnected
+  retain: true
+keepAlive: 60
+`
+	var sb ServerBinding
+	err := yaml.Unmarshal([]byte(yamlString), &sb)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestServerBinding(), &sb)
+}
+
+func TestServerBinding_MarshalJSON(t *testing.T) {
+	expectedJSON := `{"clientId":"sensor-gateway","cleanSession":false,"lastWill":{"topic":"sensor/disconnect","qos":1,"message":"Sensor disconnected","retain":true},"keepAlive":60}`
+
+	marshaledJSON, err := json.Marshal(newTestServerBinding())
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+
+	marshaledJSON, err = json.Marshal(NewServerBinding().WithClientID("guest"))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"clientId":"guest"}`, string(marshaledJSON))
+}
+
+func TestLastWill_QoSAtMostOnce(t *testing.T) {
+	var lw LastWill
+	assert.NoError(t, json.Unmarshal([]byte(`{"topic":"sensor/disconnect","qos":0}`), &lw))
+	assert.Equal(t, QoSAtMostOnce, *lw.QoS)
+
+	marshaledJSON, err := json.Marshal(lw)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"topic":"sensor/disconnect","qos":0}`, string(marshaledJSON))
+
+	var unset LastWill
+	assert.NoError(t, json.Unmarshal([]byte(`{"topic":"sensor/disconnect"}`), &unset))
+	assert.Nil(t, unset.QoS)
+}
+
+func TestServerBinding_UnmarshalJSON(t *testing.T) {
+	jsonString := `{
+		"clientId": "guest",
+		"sessionExpiryInterval": 120,
+		"maximumPacketSize": {"type": "integer", "minimum": 100},
+		"bindingVersion": "0.2.0"
+	}`
+
+	var sb ServerBinding
+	err := json.Unmarshal([]byte(jsonString), &sb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "guest", sb.ClientID)
+	assert.Nil(t, sb.CleanSession)
+	assert.Equal(t, json.Number("120"), sb.SessionExpiryInterval)
+	assert.Equal(t, map[string]interface{}{"type": "integer", "minimum": json.Number("100")}, sb.MaximumPacketSize)
+	assert.Equal(t, BindingVersion, sb.BindingVersion)
+}
@@ -0,0 +1,315 @@
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// THIS FILE IS GENERATED. DO NOT EDIT
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// If you would like to update properties for a binding,
+// edit the struct for the binding you'd like to update.
+// e.g mqtt/channel.go and run `make generate` to re-gen
+// this file.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+package mqtt
+
+import (
+	"encoding/json"
+	"sigs.k8s.io/yaml"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+
+	"errors"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+)
+
+// NewMessageBinding creates a new MessageBinding object
+func NewMessageBinding() *MessageBinding {
+	return &MessageBinding{}
+}
+
+// WithPayloadFormatIndicator sets the 'payloadFormatIndicator' field of MessageBinding
+func (obj *MessageBinding) WithPayloadFormatIndicator(payloadFormatIndicator int) *MessageBinding {
+	obj.PayloadFormatIndicator = payloadFormatIndicator
+	return obj
+}
+
+// WithCorrelationData sets the 'correlationData' field of MessageBinding
+func (obj *MessageBinding) WithCorrelationData(correlationData interface{}) *MessageBinding {
+	obj.CorrelationData = correlationData
+	return obj
+}
+
+// WithContentType sets the 'contentType' field of MessageBinding
+func (obj *MessageBinding) WithContentType(contentType string) *MessageBinding {
+	obj.ContentType = contentType
+	return obj
+}
+
+// WithResponseTopic sets the 'responseTopic' field of MessageBinding
+func (obj *MessageBinding) WithResponseTopic(responseTopic interface{}) *MessageBinding {
+	obj.ResponseTopic = responseTopic
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of MessageBinding
+func (obj *MessageBinding) WithBindingVersion(bindingVersion string) *MessageBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts MessageBinding to YAML
+func (t MessageBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to MessageBinding
+func (t *MessageBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts MessageBinding to JSON
+func (t MessageBinding) MarshalJSON() ([]byte, error) {
+	type Alias MessageBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to MessageBinding
+func (t *MessageBinding) UnmarshalJSON(data []byte) error {
+	type Alias MessageBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of MessageBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t MessageBinding) Validate() error {
+	var errs []error
+	if t.PayloadFormatIndicator != 0 {
+		errs = append(errs, bindings.Min("payloadFormatIndicator", t.PayloadFormatIndicator, 0))
+		errs = append(errs, bindings.Max("payloadFormatIndicator", t.PayloadFormatIndicator, 1))
+	}
+	return errors.Join(errs...)
+}
+
+// NewOperationBinding creates a new OperationBinding object
+func NewOperationBinding() *OperationBinding {
+	return &OperationBinding{}
+}
+
+// WithQoS sets the 'qos' field of OperationBinding
+func (obj *OperationBinding) WithQoS(qos *int) *OperationBinding {
+	obj.QoS = qos
+	return obj
+}
+
+// WithRetain sets the 'retain' field of OperationBinding
+func (obj *OperationBinding) WithRetain(retain bool) *OperationBinding {
+	obj.Retain = retain
+	return obj
+}
+
+// WithMessageExpiryInterval sets the 'messageExpiryInterval' field of OperationBinding
+func (obj *OperationBinding) WithMessageExpiryInterval(messageExpiryInterval interface{}) *OperationBinding {
+	obj.MessageExpiryInterval = messageExpiryInterval
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of OperationBinding
+func (obj *OperationBinding) WithBindingVersion(bindingVersion string) *OperationBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts OperationBinding to YAML
+func (t OperationBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to OperationBinding
+func (t *OperationBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts OperationBinding to JSON
+func (t OperationBinding) MarshalJSON() ([]byte, error) {
+	type Alias OperationBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to OperationBinding
+func (t *OperationBinding) UnmarshalJSON(data []byte) error {
+	type Alias OperationBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of OperationBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t OperationBinding) Validate() error {
+	var errs []error
+	if t.QoS != nil {
+		errs = append(errs, bindings.Min("qos", *t.QoS, 0))
+		errs = append(errs, bindings.Max("qos", *t.QoS, 2))
+	}
+	return errors.Join(errs...)
+}
+
+// NewServerBinding creates a new ServerBinding object
+func NewServerBinding() *ServerBinding {
+	return &ServerBinding{}
+}
+
+// WithClientID sets the 'clientId' field of ServerBinding
+func (obj *ServerBinding) WithClientID(clientId string) *ServerBinding {
+	obj.ClientID = clientId
+	return obj
+}
+
+// WithCleanSession sets the 'cleanSession' field of ServerBinding
+func (obj *ServerBinding) WithCleanSession(cleanSession *bool) *ServerBinding {
+	obj.CleanSession = cleanSession
+	return obj
+}
+
+// WithLastWill sets the 'lastWill' field of ServerBinding
+func (obj *ServerBinding) WithLastWill(lastWill *LastWill) *ServerBinding {
+	obj.LastWill = lastWill
+	return obj
+}
+
+// WithKeepAlive sets the 'keepAlive' field of ServerBinding
+func (obj *ServerBinding) WithKeepAlive(keepAlive int) *ServerBinding {
+	obj.KeepAlive = keepAlive
+	return obj
+}
+
+// WithSessionExpiryInterval sets the 'sessionExpiryInterval' field of ServerBinding
+func (obj *ServerBinding) WithSessionExpiryInterval(sessionExpiryInterval interface{}) *ServerBinding {
+	obj.SessionExpiryInterval = sessionExpiryInterval
+	return obj
+}
+
+// WithMaximumPacketSize sets the 'maximumPacketSize' field of ServerBinding
+func (obj *ServerBinding) WithMaximumPacketSize(maximumPacketSize interface{}) *ServerBinding {
+	obj.MaximumPacketSize = maximumPacketSize
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of ServerBinding
+func (obj *ServerBinding) WithBindingVersion(bindingVersion string) *ServerBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts ServerBinding to YAML
+func (t ServerBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to ServerBinding
+func (t *ServerBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts ServerBinding to JSON
+func (t ServerBinding) MarshalJSON() ([]byte, error) {
+	type Alias ServerBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to ServerBinding
+func (t *ServerBinding) UnmarshalJSON(data []byte) error {
+	type Alias ServerBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of ServerBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ServerBinding) Validate() error {
+	var errs []error
+	if t.LastWill != nil {
+		errs = append(errs, bindings.Nest("lastWill", t.LastWill.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// NewLastWill creates a new LastWill object
+func NewLastWill() *LastWill {
+	return &LastWill{}
+}
+
+// WithTopic sets the 'topic' field of LastWill
+func (obj *LastWill) WithTopic(topic string) *LastWill {
+	obj.Topic = topic
+	return obj
+}
+
+// WithQoS sets the 'qos' field of LastWill
+func (obj *LastWill) WithQoS(qos *int) *LastWill {
+	obj.QoS = qos
+	return obj
+}
+
+// WithMessage sets the 'message' field of LastWill
+func (obj *LastWill) WithMessage(message string) *LastWill {
+	obj.Message = message
+	return obj
+}
+
+// WithRetain sets the 'retain' field of LastWill
+func (obj *LastWill) WithRetain(retain bool) *LastWill {
+	obj.Retain = retain
+	return obj
+}
+
+// Validate checks the fields of LastWill, returning a
+// *bindings.FieldError for each invalid field
+func (t LastWill) Validate() error {
+	var errs []error
+	if t.QoS != nil {
+		errs = append(errs, bindings.Min("qos", *t.QoS, 0))
+		errs = append(errs, bindings.Max("qos", *t.QoS, 2))
+	}
+	return errors.Join(errs...)
+}
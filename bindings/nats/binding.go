@@ -0,0 +1,16 @@
+package nats
+
+import "github.com/charlie-haley/asyncapi-go/bindings"
+
+//go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen
+
+// Protocol is the key nats bindings appear under in a bindings object
+const Protocol = "nats"
+
+const BindingVersion = "0.1.0"
+
+func init() {
+	bindings.Register(Protocol, bindings.Operation, func() any { return &OperationBinding{} })
+
+	bindings.RegisterVersions(Protocol, bindings.Operation, bindings.Versions{Supported: []string{BindingVersion}})
+}
@@ -0,0 +1,10 @@
+package nats
+
+// OperationBinding represents the NATS Operation Binding object.
+//
+// This object contains information about the operation representation in NATS.
+// +binding
+type OperationBinding struct {
+	Queue          string `json:"queue,omitempty"`
+	BindingVersion string `json:"bindingVersion,omitempty"`
+}
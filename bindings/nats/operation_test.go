@@ -0,0 +1,68 @@
+package nats
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestOperationBinding_BuildObject(t *testing.T) {
+	ob := NewOperationBinding().
+		WithQueue("order-workers").
+		WithBindingVersion(BindingVersion)
+
+	assert.Equal(t, "order-workers", ob.Queue)
+	assert.Equal(t, "0.1.0", ob.BindingVersion)
+}
+
+func TestOperationBinding_MarshalYAML(t *testing.T) {
+	ob := NewOperationBinding().
+		WithQueue("order-workers").
+		WithBindingVersion(BindingVersion)
+
+	expectedYAML := `bindingVersion: 0.1.0
+queue: order-workers
+`
+	marshaledYAML, err := yaml.Marshal(ob)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestOperationBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+queue: order-workers
+bindingVersion: 0.1.0
+`
+	var ob OperationBinding
+	err := yaml.Unmarshal([]byte(yamlString), &ob)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "order-workers", ob.Queue)
+	assert.Equal(t, "0.1.0", ob.BindingVersion)
+}
+
+func TestOperationBinding_MarshalJSON(t *testing.T) {
+	ob := NewOperationBinding().WithQueue("order-workers")
+
+	expectedJSON := `{"queue":"order-workers"}`
+
+	marshaledJSON, err := json.Marshal(ob)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestOperationBinding_UnmarshalJSON(t *testing.T) {
+	jsonString := `{
+		"queue": "order-workers",
+		"bindingVersion": "0.1.0"
+	}`
+
+	var ob OperationBinding
+	err := json.Unmarshal([]byte(jsonString), &ob)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "order-workers", ob.Queue)
+	assert.Equal(t, "0.1.0", ob.BindingVersion)
+}
@@ -0,0 +1,77 @@
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// THIS FILE IS GENERATED. DO NOT EDIT
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// If you would like to update properties for a binding,
+// edit the struct for the binding you'd like to update.
+// e.g nats/channel.go and run `make generate` to re-gen
+// this file.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+package nats
+
+import (
+	"encoding/json"
+	"sigs.k8s.io/yaml"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+)
+
+// NewOperationBinding creates a new OperationBinding object
+func NewOperationBinding() *OperationBinding {
+	return &OperationBinding{}
+}
+
+// WithQueue sets the 'queue' field of OperationBinding
+func (obj *OperationBinding) WithQueue(queue string) *OperationBinding {
+	obj.Queue = queue
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of OperationBinding
+func (obj *OperationBinding) WithBindingVersion(bindingVersion string) *OperationBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts OperationBinding to YAML
+func (t OperationBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to OperationBinding
+func (t *OperationBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts OperationBinding to JSON
+func (t OperationBinding) MarshalJSON() ([]byte, error) {
+	type Alias OperationBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to OperationBinding
+func (t *OperationBinding) UnmarshalJSON(data []byte) error {
+	type Alias OperationBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of OperationBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t OperationBinding) Validate() error {
+	return nil
+}
@@ -0,0 +1,18 @@
+package pulsar
+
+import "github.com/charlie-haley/asyncapi-go/bindings"
+
+//go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen
+
+// Protocol is the key pulsar bindings appear under in a bindings object
+const Protocol = "pulsar"
+
+const BindingVersion = "0.1.0"
+
+func init() {
+	bindings.Register(Protocol, bindings.Server, func() any { return &ServerBinding{} })
+	bindings.Register(Protocol, bindings.Channel, func() any { return &ChannelBinding{} })
+
+	bindings.RegisterVersions(Protocol, bindings.Server, bindings.Versions{Supported: []string{BindingVersion}})
+	bindings.RegisterVersions(Protocol, bindings.Channel, bindings.Versions{Supported: []string{BindingVersion}})
+}
@@ -0,0 +1,38 @@
+package pulsar
+
+// ChannelBinding represents the Pulsar Channel Binding object.
+//
+// This object contains information about the channel representation in Pulsar.
+// +binding
+type ChannelBinding struct {
+	// +binding:required
+	Namespace string `json:"namespace"`
+	// +binding:required
+	// +binding:enum=persistent;non-persistent
+	Persistence string `json:"persistence"`
+	// +binding:min=0
+	Compaction     int        `json:"compaction,omitempty"`
+	GeoReplication []string   `json:"geo-replication,omitempty"`
+	Retention      *Retention `json:"retention,omitempty"`
+	TTL            int        `json:"ttl,omitempty"`
+	Deduplication  bool       `json:"deduplication,omitempty"`
+	BindingVersion string     `json:"bindingVersion,omitempty"`
+}
+
+// Retention is the message retention policy of a topic. Time and Size are
+// pointers as 0 disables retention, which isn't the same as leaving them
+// unset.
+type Retention struct {
+	// Time is given in minutes
+	// +binding:min=0
+	Time *int `json:"time,omitempty"`
+	// Size is given in megabytes
+	// +binding:min=0
+	Size *int `json:"size,omitempty"`
+}
+
+// ChannelPersistence represents the persistence of a topic.
+const (
+	ChannelPersistencePersistent    = "persistent"
+	ChannelPersistenceNonPersistent = "non-persistent"
+)
@@ -0,0 +1,109 @@
+package pulsar
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func newTestChannelBinding() *ChannelBinding {
+	return NewChannelBinding().
+		WithNamespace("staging").
+		WithPersistence(ChannelPersistencePersistent).
+		WithCompaction(100).
+		WithGeoReplication([]string{"us-east1", "us-west1"}).
+		WithRetention(NewRetention().WithTime(intPtr(7)).WithSize(intPtr(0))).
+		WithTTL(360).
+		WithDeduplication(true).
+		WithBindingVersion(BindingVersion)
+}
+
+func TestChannelBinding_BuildObject(t *testing.T) {
+	cb := newTestChannelBinding()
+
+	assert.Equal(t, "staging", cb.Namespace)
+	assert.Equal(t, ChannelPersistencePersistent, cb.Persistence)
+	assert.Equal(t, 100, cb.Compaction)
+	assert.Equal(t, []string{"us-east1", "us-west1"}, cb.GeoReplication)
+	assert.Equal(t, 7, *cb.Retention.Time)
+	assert.Equal(t, 0, *cb.Retention.Size)
+	assert.Equal(t, 360, cb.TTL)
+	assert.True(t, cb.Deduplication)
+}
+
+func TestChannelBinding_MarshalYAML(t *testing.T) {
+	expectedYAML := `bindingVersion: 0.1.0
+compaction: 100
+deduplication: true
+geo-replication:
+- us-east1
+- us-west1
+namespace: staging
+persistence: persistent
+retention:
+  size: 0
+  time: 7
+ttl: 360
+`
+	marshaledYAML, err := yaml.Marshal(newTestChannelBinding())
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestChannelBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+namespace: staging
+persistence: persistent
+compaction: 100
+geo-replication:
+  - us-east1
+  - us-west1
+retention:
+  time: 7
+  size: 0
+ttl: 360
+deduplication: true
+bindingVersion: 0.1.0
+`
+	var cb ChannelBinding
+	err := yaml.Unmarshal([]byte(yamlString), &cb)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestChannelBinding(), &cb)
+}
+
+func TestChannelBinding_MarshalJSON(t *testing.T) {
+	expectedJSON := `{"namespace":"staging","persistence":"persistent","compaction":100,"geo-replication":["us-east1","us-west1"],"retention":{"time":7,"size":0},"ttl":360,"deduplication":true,"bindingVersion":"0.1.0"}`
+
+	marshaledJSON, err := json.Marshal(newTestChannelBinding())
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+
+	// Required fields are always written
+	marshaledJSON, err = json.Marshal(NewChannelBinding())
+	assert.NoError(t, err)
+	assert.Equal(t, `{"namespace":"","persistence":""}`, string(marshaledJSON))
+}
+
+func TestChannelBinding_UnmarshalJSON(t *testing.T) {
+	jsonString := `{
+		"namespace": "staging",
+		"persistence": "non-persistent",
+		"retention": {"time": 0}
+	}`
+
+	var cb ChannelBinding
+	err := json.Unmarshal([]byte(jsonString), &cb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "staging", cb.Namespace)
+	assert.Equal(t, ChannelPersistenceNonPersistent, cb.Persistence)
+	assert.Equal(t, &Retention{Time: intPtr(0)}, cb.Retention)
+	assert.Nil(t, cb.GeoReplication)
+	assert.False(t, cb.Deduplication)
+}
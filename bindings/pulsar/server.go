@@ -0,0 +1,10 @@
+package pulsar
+
+// ServerBinding represents the Pulsar Server Binding object.
+//
+// This object contains information about the server representation in Pulsar.
+// +binding
+type ServerBinding struct {
+	Tenant         string `json:"tenant,omitempty" default:"\"public\""`
+	BindingVersion string `json:"bindingVersion,omitempty"`
+}
@@ -0,0 +1,61 @@
+package pulsar
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestServerBinding_BuildObject(t *testing.T) {
+	assert.Equal(t, "public", NewServerBinding().Tenant)
+
+	sb := NewServerBinding().WithTenant("payments")
+	assert.Equal(t, "payments", sb.Tenant)
+}
+
+func TestServerBinding_MarshalYAML(t *testing.T) {
+	sb := NewServerBinding().
+		WithTenant("payments").
+		WithBindingVersion(BindingVersion)
+
+	expectedYAML := `bindingVersion: 0.1.0
+tenant: payments
+`
+	marshaledYAML, err := yaml.Marshal(sb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestServerBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+tenant: payments
+`
+	var sb ServerBinding
+	err := yaml.Unmarshal([]byte(yamlString), &sb)
+	assert.NoError(t, err)
+	assert.Equal(t, "payments", sb.Tenant)
+}
+
+func TestServerBinding_MarshalJSON(t *testing.T) {
+	expectedJSON := `{"tenant":"public"}`
+
+	marshaledJSON, err := json.Marshal(NewServerBinding())
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestServerBinding_UnmarshalJSON(t *testing.T) {
+	jsonString := `{
+		"tenant": "payments",
+		"bindingVersion": "0.1.0"
+	}`
+
+	var sb ServerBinding
+	err := json.Unmarshal([]byte(jsonString), &sb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "payments", sb.Tenant)
+	assert.Equal(t, "0.1.0", sb.BindingVersion)
+}
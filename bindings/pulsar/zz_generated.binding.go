@@ -0,0 +1,219 @@
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// THIS FILE IS GENERATED. DO NOT EDIT
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// If you would like to update properties for a binding,
+// edit the struct for the binding you'd like to update.
+// e.g pulsar/channel.go and run `make generate` to re-gen
+// this file.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+package pulsar
+
+import (
+	"encoding/json"
+	"sigs.k8s.io/yaml"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+
+	"errors"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+)
+
+// NewChannelBinding creates a new ChannelBinding object
+func NewChannelBinding() *ChannelBinding {
+	return &ChannelBinding{}
+}
+
+// WithNamespace sets the 'namespace' field of ChannelBinding
+func (obj *ChannelBinding) WithNamespace(namespace string) *ChannelBinding {
+	obj.Namespace = namespace
+	return obj
+}
+
+// WithPersistence sets the 'persistence' field of ChannelBinding
+func (obj *ChannelBinding) WithPersistence(persistence string) *ChannelBinding {
+	obj.Persistence = persistence
+	return obj
+}
+
+// WithCompaction sets the 'compaction' field of ChannelBinding
+func (obj *ChannelBinding) WithCompaction(compaction int) *ChannelBinding {
+	obj.Compaction = compaction
+	return obj
+}
+
+// WithGeoReplication sets the 'geo-replication' field of ChannelBinding
+func (obj *ChannelBinding) WithGeoReplication(georeplication []string) *ChannelBinding {
+	obj.GeoReplication = georeplication
+	return obj
+}
+
+// WithRetention sets the 'retention' field of ChannelBinding
+func (obj *ChannelBinding) WithRetention(retention *Retention) *ChannelBinding {
+	obj.Retention = retention
+	return obj
+}
+
+// WithTTL sets the 'ttl' field of ChannelBinding
+func (obj *ChannelBinding) WithTTL(ttl int) *ChannelBinding {
+	obj.TTL = ttl
+	return obj
+}
+
+// WithDeduplication sets the 'deduplication' field of ChannelBinding
+func (obj *ChannelBinding) WithDeduplication(deduplication bool) *ChannelBinding {
+	obj.Deduplication = deduplication
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of ChannelBinding
+func (obj *ChannelBinding) WithBindingVersion(bindingVersion string) *ChannelBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts ChannelBinding to YAML
+func (t ChannelBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to ChannelBinding
+func (t *ChannelBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts ChannelBinding to JSON
+func (t ChannelBinding) MarshalJSON() ([]byte, error) {
+	type Alias ChannelBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to ChannelBinding
+func (t *ChannelBinding) UnmarshalJSON(data []byte) error {
+	type Alias ChannelBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of ChannelBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ChannelBinding) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("namespace", t.Namespace != ""))
+	errs = append(errs, bindings.Required("persistence", t.Persistence != ""))
+	errs = append(errs, bindings.Enum("persistence", t.Persistence, "persistent", "non-persistent"))
+	if t.Compaction != 0 {
+		errs = append(errs, bindings.Min("compaction", t.Compaction, 0))
+	}
+	if t.Retention != nil {
+		errs = append(errs, bindings.Nest("retention", t.Retention.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// NewRetention creates a new Retention object
+func NewRetention() *Retention {
+	return &Retention{}
+}
+
+// WithTime sets the 'time' field of Retention
+func (obj *Retention) WithTime(time *int) *Retention {
+	obj.Time = time
+	return obj
+}
+
+// WithSize sets the 'size' field of Retention
+func (obj *Retention) WithSize(size *int) *Retention {
+	obj.Size = size
+	return obj
+}
+
+// Validate checks the fields of Retention, returning a
+// *bindings.FieldError for each invalid field
+func (t Retention) Validate() error {
+	var errs []error
+	if t.Time != nil {
+		errs = append(errs, bindings.Min("time", *t.Time, 0))
+	}
+	if t.Size != nil {
+		errs = append(errs, bindings.Min("size", *t.Size, 0))
+	}
+	return errors.Join(errs...)
+}
+
+// NewServerBinding creates a new ServerBinding object
+func NewServerBinding() *ServerBinding {
+	return &ServerBinding{
+		Tenant: "public",
+	}
+}
+
+// WithTenant sets the 'tenant' field of ServerBinding
+func (obj *ServerBinding) WithTenant(tenant string) *ServerBinding {
+	obj.Tenant = tenant
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of ServerBinding
+func (obj *ServerBinding) WithBindingVersion(bindingVersion string) *ServerBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts ServerBinding to YAML
+func (t ServerBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to ServerBinding
+func (t *ServerBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts ServerBinding to JSON
+func (t ServerBinding) MarshalJSON() ([]byte, error) {
+	type Alias ServerBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to ServerBinding
+func (t *ServerBinding) UnmarshalJSON(data []byte) error {
+	type Alias ServerBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of ServerBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ServerBinding) Validate() error {
+	return nil
+}
@@ -0,0 +1,129 @@
+// Package bindings maps protocols to the Go types of their bindings, so that
+// the bindings of a document can be decoded without knowing their types.
+// Each binding package registers its types with Default when it's imported.
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+)
+
+// Kind is the type of object a binding is attached to
+type Kind string
+
+const (
+	Server    Kind = "server"
+	Channel   Kind = "channel"
+	Operation Kind = "operation"
+	Message   Kind = "message"
+)
+
+// Factory returns a pointer to a new, empty binding, such as
+// &kafka.ChannelBinding{}
+type Factory func() any
+
+type registryKey struct {
+	protocol string
+	kind     Kind
+}
+
+// Registry maps a protocol and object kind to the type of its binding. A
+// Registry is safe for concurrent use.
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[registryKey]Factory
+	versions  map[registryKey]Versions
+}
+
+// Default is the registry the built-in binding packages register with, and
+// the one documents decode their bindings with
+var Default = NewRegistry()
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[registryKey]Factory),
+		versions:  make(map[registryKey]Versions),
+	}
+}
+
+// Register sets the type of a protocol's bindings for a kind of object,
+// replacing any existing type. The protocol is the key the binding appears
+// under in a bindings object, such as kafka or ws.
+func (r *Registry) Register(protocol string, kind Kind, factory Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factories[registryKey{protocol, kind}] = factory
+}
+
+// Lookup returns the factory registered for a protocol and kind
+func (r *Registry) Lookup(protocol string, kind Kind) (Factory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	factory, ok := r.factories[registryKey{protocol, kind}]
+	return factory, ok
+}
+
+// Protocols returns the protocols with a registered type for a kind, sorted
+func (r *Registry) Protocols(kind Kind) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var protocols []string
+	for key := range r.factories {
+		if key.kind == kind {
+			protocols = append(protocols, key.protocol)
+		}
+	}
+	sort.Strings(protocols)
+	return protocols
+}
+
+// Decode decodes a raw binding, as parsed from a document, into the type
+// registered for its protocol and kind. Bindings without a registered type
+// are returned unchanged.
+//
+// When versions are registered for the protocol and kind, the binding is
+// decoded according to its declared bindingVersion. Fields that version
+// doesn't define are left out and reported by returning a *VersionError
+// along with the binding, and unsupported versions are an error.
+func (r *Registry) Decode(protocol string, kind Kind, raw any) (any, error) {
+	factory, ok := r.Lookup(protocol, kind)
+	if !ok {
+		return raw, nil
+	}
+
+	var versionErr *VersionError
+	if versions, ok := r.LookupVersions(protocol, kind); ok {
+		var err error
+		if raw, _, versionErr, err = prepare(protocol, kind, versions, raw); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s %s binding: %w", protocol, kind, err)
+	}
+	binding := factory()
+	if err := jsonnumber.Unmarshal(data, binding); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s %s binding: %w", protocol, kind, err)
+	}
+	if versionErr != nil {
+		return binding, versionErr
+	}
+	return binding, nil
+}
+
+// Register sets the type of a protocol's bindings in the default registry
+func Register(protocol string, kind Kind, factory Factory) {
+	Default.Register(protocol, kind, factory)
+}
+
+// Decode decodes a raw binding with the default registry
+func Decode(protocol string, kind Kind, raw any) (any, error) {
+	return Default.Decode(protocol, kind, raw)
+}
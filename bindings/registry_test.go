@@ -0,0 +1,59 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+	"github.com/charlie-haley/asyncapi-go/bindings/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ipoacChannelBinding struct {
+	Carrier        string   `json:"carrier"`
+	AllowedSpecies []string `json:"allowedSpecies"`
+}
+
+func TestRegistry_Decode(t *testing.T) {
+	r := bindings.NewRegistry()
+	r.Register("ipoac", bindings.Channel, func() any { return &ipoacChannelBinding{} })
+
+	decoded, err := r.Decode("ipoac", bindings.Channel, map[string]any{
+		"carrier":        "pigeon",
+		"allowedSpecies": []any{"Rock Dove"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &ipoacChannelBinding{Carrier: "pigeon", AllowedSpecies: []string{"Rock Dove"}}, decoded)
+
+	// Only the registered kind is decoded
+	raw := map[string]any{"carrier": "pigeon"}
+	decoded, err = r.Decode("ipoac", bindings.Message, raw)
+	require.NoError(t, err)
+	assert.Equal(t, raw, decoded)
+
+	_, err = r.Decode("ipoac", bindings.Channel, map[string]any{"carrier": 1})
+	assert.ErrorContains(t, err, "failed to unmarshal ipoac channel binding")
+}
+
+func TestRegistry_Lookup(t *testing.T) {
+	r := bindings.NewRegistry()
+	_, ok := r.Lookup("ipoac", bindings.Channel)
+	assert.False(t, ok)
+
+	r.Register("ipoac", bindings.Channel, func() any { return &ipoacChannelBinding{} })
+	factory, ok := r.Lookup("ipoac", bindings.Channel)
+	require.True(t, ok)
+	assert.IsType(t, &ipoacChannelBinding{}, factory())
+}
+
+func TestDefault_BuiltIns(t *testing.T) {
+	assert.Contains(t, bindings.Default.Protocols(bindings.Channel), kafka.Protocol)
+
+	decoded, err := bindings.Decode(kafka.Protocol, bindings.Channel, map[string]any{
+		"topic":      "user-signedup",
+		"partitions": json.Number("10"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &kafka.ChannelBinding{Topic: "user-signedup", Partitions: 10}, decoded)
+}
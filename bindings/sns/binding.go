@@ -1,5 +1,22 @@
 package sns
 
-//go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen
+import "github.com/charlie-haley/asyncapi-go/bindings"
 
-const BindingVersion = "1.0.0"
+//go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen -schemas ../../internal/validation/bindings/sns/0.2.0
+
+// Protocol is the key sns bindings appear under in a bindings object
+const Protocol = "sns"
+
+const BindingVersion = "0.2.0"
+
+func init() {
+	bindings.Register(Protocol, bindings.Channel, func() any { return &ChannelBinding{} })
+	bindings.Register(Protocol, bindings.Operation, func() any { return &OperationBinding{} })
+
+	bindings.RegisterVersions(Protocol, bindings.Channel, bindings.Versions{
+		Supported: []string{"0.1.0", "0.2.0"},
+	})
+	bindings.RegisterVersions(Protocol, bindings.Operation, bindings.Versions{
+		Supported: []string{"0.1.0", "0.2.0"},
+	})
+}
@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/charlie-haley/asyncapi-go/bindings"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"sigs.k8s.io/yaml"
 )
 
@@ -42,7 +44,7 @@ func TestChannelBinding_JSON(t *testing.T) {
 				Name: "MyTopic",
 				Ordering: &Ordering{
 					Type: "FIFO",
-					ContentBasedDeduplication: true,
+					ContentBasedDeduplication: boolPtr(true),
 				},
 				Policy: &Policy{
 					Statements: []Statement{
@@ -54,7 +56,7 @@ func TestChannelBinding_JSON(t *testing.T) {
 						},
 					},
 				},
-				Tags: map[string]string{
+				Tags: map[string]interface{}{
 					"environment": "production",
 					"team":       "platform",
 				},
@@ -70,6 +72,25 @@ func TestChannelBinding_JSON(t *testing.T) {
 				Name: "MyTopic",
 			},
 		},
+		{
+			name: "with specification extensions",
+			input: `{
+				"name": "MyTopic",
+				"ordering": {
+					"type": "standard",
+					"x-owner": "platform"
+				}
+			}`,
+			expected: ChannelBinding{
+				Name: "MyTopic",
+				Ordering: &Ordering{
+					Type: OrderingTypeStandard,
+					AdditionalProperties: map[string]interface{}{
+						"x-owner": "platform",
+					},
+				},
+			},
+		},
 		{
 			name: "with array actions",
 			input: `{
@@ -146,7 +167,7 @@ bindingVersion: "1.0.0"
 				Name: "MyTopic",
 				Ordering: &Ordering{
 					Type: "FIFO",
-					ContentBasedDeduplication: true,
+					ContentBasedDeduplication: boolPtr(true),
 				},
 				Policy: &Policy{
 					Statements: []Statement{
@@ -158,7 +179,7 @@ bindingVersion: "1.0.0"
 						},
 					},
 				},
-				Tags: map[string]string{
+				Tags: map[string]interface{}{
 					"environment": "production",
 					"team":       "platform",
 				},
@@ -215,4 +236,24 @@ policy:
 			assert.Equal(t, tt.expected, unmarshaled)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestChannelBinding_DecodeVersion(t *testing.T) {
+	decoded, err := bindings.Decode(Protocol, bindings.Channel, map[string]any{
+		"name":           "MyTopic",
+		"bindingVersion": BindingVersion,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &ChannelBinding{Name: "MyTopic", BindingVersion: BindingVersion}, decoded)
+
+	_, err = bindings.Decode(Protocol, bindings.Channel, map[string]any{
+		"name":           "MyTopic",
+		"bindingVersion": "1.0.0",
+	})
+	assert.EqualError(t, err, `unsupported sns channel binding version "1.0.0", expected one of [0.1.0 0.2.0]`)
+}
+
+// Helper function for creating bool pointers
+func boolPtr(b bool) *bool {
+	return &b
+}
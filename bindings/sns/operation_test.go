@@ -54,7 +54,7 @@ bindingVersion: "1.0.0"
 				Consumers: []Consumer{
 					{
 						Protocol: "sqs",
-						Endpoint: Identifier{
+						Endpoint: &Identifier{
 							ARN: "arn:aws:sqs:us-west-2:123456789012:MyQueue",
 						},
 						FilterPolicy: map[string]interface{}{
@@ -63,7 +63,7 @@ bindingVersion: "1.0.0"
 						FilterPolicyScope:  "MessageBody",
 						RawMessageDelivery: true,
 						RedrivePolicy: &RedrivePolicy{
-							DeadLetterQueue: Identifier{
+							DeadLetterQueue: &Identifier{
 								ARN: "arn:aws:sqs:us-west-2:123456789012:MyDLQ",
 							},
 							MaxReceiveCount: intPtr(5),
@@ -107,21 +107,21 @@ bindingVersion: "1.0.0"
 				Consumers: []Consumer{
 					{
 						Protocol: "http",
-						Endpoint: Identifier{
+						Endpoint: &Identifier{
 							URL: "https://example.com/webhook",
 						},
 						RawMessageDelivery: true,
 					},
 					{
 						Protocol: "email",
-						Endpoint: Identifier{
+						Endpoint: &Identifier{
 							Email: "test@example.com",
 						},
 						RawMessageDelivery: false,
 					},
 					{
 						Protocol: "sms",
-						Endpoint: Identifier{
+						Endpoint: &Identifier{
 							Phone: "+1234567890",
 						},
 						RawMessageDelivery: true,
@@ -205,7 +205,7 @@ func TestOperationBinding_JSON(t *testing.T) {
 				Consumers: []Consumer{
 					{
 						Protocol: "sqs",
-						Endpoint: Identifier{
+						Endpoint: &Identifier{
 							ARN: "arn:aws:sqs:us-west-2:123456789012:MyQueue",
 						},
 						FilterPolicy: map[string]interface{}{
@@ -214,7 +214,7 @@ func TestOperationBinding_JSON(t *testing.T) {
 						FilterPolicyScope:  "MessageBody",
 						RawMessageDelivery: true,
 						RedrivePolicy: &RedrivePolicy{
-							DeadLetterQueue: Identifier{
+							DeadLetterQueue: &Identifier{
 								ARN: "arn:aws:sqs:us-west-2:123456789012:MyDLQ",
 							},
 							MaxReceiveCount: intPtr(5),
@@ -270,21 +270,21 @@ func TestOperationBinding_JSON(t *testing.T) {
 				Consumers: []Consumer{
 					{
 						Protocol: "http",
-						Endpoint: Identifier{
+						Endpoint: &Identifier{
 							URL: "https://example.com/webhook",
 						},
 						RawMessageDelivery: true,
 					},
 					{
 						Protocol: "email",
-						Endpoint: Identifier{
+						Endpoint: &Identifier{
 							Email: "test@example.com",
 						},
 						RawMessageDelivery: false,
 					},
 					{
 						Protocol: "sms",
-						Endpoint: Identifier{
+						Endpoint: &Identifier{
 							Phone: "+1234567890",
 						},
 						RawMessageDelivery: true,
@@ -10,20 +10,23 @@
 package sns
 
 import (
-
 	"encoding/json"
 	"sigs.k8s.io/yaml"
 
-)
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
 
+	"errors"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+)
 
 // NewChannelBinding creates a new ChannelBinding object
 func NewChannelBinding() *ChannelBinding {
 	return &ChannelBinding{
+		BindingVersion: "latest",
 	}
 }
 
-
 // WithName sets the 'name' field of ChannelBinding
 func (obj *ChannelBinding) WithName(name string) *ChannelBinding {
 	obj.Name = name
@@ -43,7 +46,7 @@ func (obj *ChannelBinding) WithPolicy(policy *Policy) *ChannelBinding {
 }
 
 // WithTags sets the 'tags' field of ChannelBinding
-func (obj *ChannelBinding) WithTags(tags map[string]string) *ChannelBinding {
+func (obj *ChannelBinding) WithTags(tags map[string]interface{}) *ChannelBinding {
 	obj.Tags = tags
 	return obj
 }
@@ -54,30 +57,28 @@ func (obj *ChannelBinding) WithBindingVersion(bindingVersion string) *ChannelBin
 	return obj
 }
 
-
-
 // MarshalYAML is a custom marshaller that converts ChannelBinding to YAML
 func (t ChannelBinding) MarshalYAML() (interface{}, error) {
-    bytes, err := json.Marshal(t)
-    if err != nil {
-        return nil, err
-    }
-    var out interface{}
-    err = yaml.Unmarshal(bytes, &out) 
-    return out, err
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
 }
 
 // UnmarshalYAML is a custom unmarshaler that converts YAML to ChannelBinding
 func (t *ChannelBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
-    var temp interface{}
-    if err := unmarshal(&temp); err != nil {
-        return err
-    }
-    bytes, err := yaml.Marshal(temp)
-    if err != nil {
-        return err
-    }
-    return json.Unmarshal(bytes, t)
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
 }
 
 // MarshalJSON is a custom marshaller that converts ChannelBinding to JSON
@@ -90,18 +91,28 @@ func (t ChannelBinding) MarshalJSON() ([]byte, error) {
 func (t *ChannelBinding) UnmarshalJSON(data []byte) error {
 	type Alias ChannelBinding
 	aux := struct{ *Alias }{Alias: (*Alias)(t)}
-	return json.Unmarshal(data, &aux)
+	return jsonnumber.Unmarshal(data, &aux)
 }
 
-
+// Validate checks the fields of ChannelBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ChannelBinding) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("name", t.Name != ""))
+	if t.Ordering != nil {
+		errs = append(errs, bindings.Nest("ordering", t.Ordering.Validate()))
+	}
+	if t.Policy != nil {
+		errs = append(errs, bindings.Nest("policy", t.Policy.Validate()))
+	}
+	return errors.Join(errs...)
+}
 
 // NewOrdering creates a new Ordering object
 func NewOrdering() *Ordering {
-	return &Ordering{
-	}
+	return &Ordering{}
 }
 
-
 // WithType sets the 'type' field of Ordering
 func (obj *Ordering) WithType(typeValue string) *Ordering {
 	obj.Type = typeValue
@@ -109,39 +120,72 @@ func (obj *Ordering) WithType(typeValue string) *Ordering {
 }
 
 // WithContentBasedDeduplication sets the 'contentBasedDeduplication' field of Ordering
-func (obj *Ordering) WithContentBasedDeduplication(contentBasedDeduplication bool) *Ordering {
+func (obj *Ordering) WithContentBasedDeduplication(contentBasedDeduplication *bool) *Ordering {
 	obj.ContentBasedDeduplication = contentBasedDeduplication
 	return obj
 }
 
+// WithAdditionalProperties sets the '-' field of Ordering
+func (obj *Ordering) WithAdditionalProperties(additionalProperties map[string]interface{}) *Ordering {
+	obj.AdditionalProperties = additionalProperties
+	return obj
+}
 
-
-
+// Validate checks the fields of Ordering, returning a
+// *bindings.FieldError for each invalid field
+func (t Ordering) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("type", t.Type != ""))
+	errs = append(errs, bindings.Enum("type", t.Type, "standard", "FIFO"))
+	return errors.Join(errs...)
+}
 
 // NewPolicy creates a new Policy object
 func NewPolicy() *Policy {
-	return &Policy{
-	}
+	return &Policy{}
 }
 
-
 // WithStatements sets the 'statements' field of Policy
 func (obj *Policy) WithStatements(statements []Statement) *Policy {
 	obj.Statements = statements
 	return obj
 }
 
+// WithAdditionalProperties sets the '-' field of Policy
+func (obj *Policy) WithAdditionalProperties(additionalProperties map[string]interface{}) *Policy {
+	obj.AdditionalProperties = additionalProperties
+	return obj
+}
 
+// Validate checks the fields of Policy, returning a
+// *bindings.FieldError for each invalid field
+func (t Policy) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("statements", len(t.Statements) > 0))
+	for i, item := range t.Statements {
+		errs = append(errs, bindings.Nest(bindings.Index("statements", i), item.Validate()))
+	}
+	return errors.Join(errs...)
+}
 
-
+// Validate checks the fields of Statement, returning a
+// *bindings.FieldError for each invalid field
+func (t Statement) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("effect", t.Effect != ""))
+	errs = append(errs, bindings.Enum("effect", t.Effect, "Allow", "Deny"))
+	errs = append(errs, bindings.Required("principal", t.Principal != nil))
+	errs = append(errs, bindings.Required("action", t.Action != nil))
+	return errors.Join(errs...)
+}
 
 // NewOperationBinding creates a new OperationBinding object
 func NewOperationBinding() *OperationBinding {
 	return &OperationBinding{
+		BindingVersion: "latest",
 	}
 }
 
-
 // WithTopic sets the 'topic' field of OperationBinding
 func (obj *OperationBinding) WithTopic(topic *Identifier) *OperationBinding {
 	obj.Topic = topic
@@ -166,30 +210,28 @@ func (obj *OperationBinding) WithBindingVersion(bindingVersion string) *Operatio
 	return obj
 }
 
-
-
 // MarshalYAML is a custom marshaller that converts OperationBinding to YAML
 func (t OperationBinding) MarshalYAML() (interface{}, error) {
-    bytes, err := json.Marshal(t)
-    if err != nil {
-        return nil, err
-    }
-    var out interface{}
-    err = yaml.Unmarshal(bytes, &out) 
-    return out, err
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
 }
 
 // UnmarshalYAML is a custom unmarshaler that converts YAML to OperationBinding
 func (t *OperationBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
-    var temp interface{}
-    if err := unmarshal(&temp); err != nil {
-        return err
-    }
-    bytes, err := yaml.Marshal(temp)
-    if err != nil {
-        return err
-    }
-    return json.Unmarshal(bytes, t)
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
 }
 
 // MarshalJSON is a custom marshaller that converts OperationBinding to JSON
@@ -202,18 +244,31 @@ func (t OperationBinding) MarshalJSON() ([]byte, error) {
 func (t *OperationBinding) UnmarshalJSON(data []byte) error {
 	type Alias OperationBinding
 	aux := struct{ *Alias }{Alias: (*Alias)(t)}
-	return json.Unmarshal(data, &aux)
+	return jsonnumber.Unmarshal(data, &aux)
 }
 
-
+// Validate checks the fields of OperationBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t OperationBinding) Validate() error {
+	var errs []error
+	if t.Topic != nil {
+		errs = append(errs, bindings.Nest("topic", t.Topic.Validate()))
+	}
+	errs = append(errs, bindings.Required("consumers", len(t.Consumers) > 0))
+	for i, item := range t.Consumers {
+		errs = append(errs, bindings.Nest(bindings.Index("consumers", i), item.Validate()))
+	}
+	if t.DeliveryPolicy != nil {
+		errs = append(errs, bindings.Nest("deliveryPolicy", t.DeliveryPolicy.Validate()))
+	}
+	return errors.Join(errs...)
+}
 
 // NewIdentifier creates a new Identifier object
 func NewIdentifier() *Identifier {
-	return &Identifier{
-	}
+	return &Identifier{}
 }
 
-
 // WithURL sets the 'url' field of Identifier
 func (obj *Identifier) WithURL(url string) *Identifier {
 	obj.URL = url
@@ -244,17 +299,54 @@ func (obj *Identifier) WithName(name string) *Identifier {
 	return obj
 }
 
+// WithAdditionalProperties sets the '-' field of Identifier
+func (obj *Identifier) WithAdditionalProperties(additionalProperties map[string]interface{}) *Identifier {
+	obj.AdditionalProperties = additionalProperties
+	return obj
+}
 
+// Validate checks the fields of Identifier, returning a
+// *bindings.FieldError for each invalid field
+func (t Identifier) Validate() error {
+	return nil
+}
 
+// Validate checks the fields of Consumer, returning a
+// *bindings.FieldError for each invalid field
+func (t Consumer) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("protocol", t.Protocol != ""))
+	errs = append(errs, bindings.Enum("protocol", t.Protocol, "http", "https", "email", "email-json", "sms", "sqs", "application", "lambda", "firehose"))
+	errs = append(errs, bindings.Required("endpoint", t.Endpoint != nil))
+	if t.Endpoint != nil {
+		errs = append(errs, bindings.Nest("endpoint", t.Endpoint.Validate()))
+	}
+	errs = append(errs, bindings.Enum("filterPolicyScope", t.FilterPolicyScope, "MessageAttributes", "MessageBody"))
+	if t.RedrivePolicy != nil {
+		errs = append(errs, bindings.Nest("redrivePolicy", t.RedrivePolicy.Validate()))
+	}
+	if t.DeliveryPolicy != nil {
+		errs = append(errs, bindings.Nest("deliveryPolicy", t.DeliveryPolicy.Validate()))
+	}
+	return errors.Join(errs...)
+}
 
+// Validate checks the fields of RedrivePolicy, returning a
+// *bindings.FieldError for each invalid field
+func (t RedrivePolicy) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("deadLetterQueue", t.DeadLetterQueue != nil))
+	if t.DeadLetterQueue != nil {
+		errs = append(errs, bindings.Nest("deadLetterQueue", t.DeadLetterQueue.Validate()))
+	}
+	return errors.Join(errs...)
+}
 
 // NewDeliveryPolicy creates a new DeliveryPolicy object
 func NewDeliveryPolicy() *DeliveryPolicy {
-	return &DeliveryPolicy{
-	}
+	return &DeliveryPolicy{}
 }
 
-
 // WithMinDelayTarget sets the 'minDelayTarget' field of DeliveryPolicy
 func (obj *DeliveryPolicy) WithMinDelayTarget(minDelayTarget *int) *DeliveryPolicy {
 	obj.MinDelayTarget = minDelayTarget
@@ -303,6 +395,16 @@ func (obj *DeliveryPolicy) WithMaxReceivesPerSecond(maxReceivesPerSecond *int) *
 	return obj
 }
 
+// WithAdditionalProperties sets the '-' field of DeliveryPolicy
+func (obj *DeliveryPolicy) WithAdditionalProperties(additionalProperties map[string]interface{}) *DeliveryPolicy {
+	obj.AdditionalProperties = additionalProperties
+	return obj
+}
 
-
-
+// Validate checks the fields of DeliveryPolicy, returning a
+// *bindings.FieldError for each invalid field
+func (t DeliveryPolicy) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Enum("backoffFunction", t.BackoffFunction, "arithmetic", "exponential", "geometric", "linear"))
+	return errors.Join(errs...)
+}
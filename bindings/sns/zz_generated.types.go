@@ -0,0 +1,526 @@
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// THIS FILE IS GENERATED. DO NOT EDIT
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// The structs in this file are generated from the binding JSON
+// Schemas in ../../internal/validation/bindings/sns/0.2.0.
+// To update them, update the schemas and run `make generate` to
+// re-gen this file.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+package sns
+
+import (
+	"encoding/json"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+)
+
+// ChannelBinding represents the SNS Channel Binding object.
+// This object contains information about the channel representation in SNS.
+// +binding
+type ChannelBinding struct {
+	// The name of the topic. Can be different from the channel name to allow
+	// flexibility around AWS resource naming limitations.
+	// +binding:required
+	Name string `json:"name"`
+	// By default, we assume an unordered SNS topic. This field allows
+	// configuration of a FIFO SNS Topic.
+	Ordering *Ordering `json:"ordering,omitempty"`
+	// The security policy for the SNS Topic.
+	Policy *Policy `json:"policy,omitempty"`
+	// Key-value pairs that represent AWS tags on the topic.
+	Tags map[string]interface{} `json:"tags,omitempty"`
+	// The version of this binding.
+	BindingVersion string `json:"bindingVersion,omitempty" default:"\"latest\""`
+}
+
+// Ordering represents the SNS ordering object.
+// By default, we assume an unordered SNS topic. This field allows
+// configuration of a FIFO SNS Topic.
+type Ordering struct {
+	// Defines the type of SNS Topic.
+	// +binding:required
+	// +binding:enum=standard;FIFO
+	Type string `json:"type"`
+	// True to turn on de-duplication of messages for a channel.
+	ContentBasedDeduplication *bool `json:"contentBasedDeduplication,omitempty"`
+	// AdditionalProperties holds the fields the schema doesn't define, such
+	// as specification extensions
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Policy represents the SNS policy object.
+// The security policy for the SNS Topic.
+type Policy struct {
+	// An array of statement objects, each of which controls a permission for
+	// this topic
+	// +binding:required
+	Statements []Statement `json:"statements"`
+	// AdditionalProperties holds the fields the schema doesn't define, such
+	// as specification extensions
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Statement represents the SNS statement object.
+type Statement struct {
+	// +binding:required
+	// +binding:enum=Allow;Deny
+	Effect string `json:"effect"`
+	// The AWS account(s) or resource ARN(s) that this statement applies to.
+	// +binding:required
+	Principal interface{} `json:"principal"`
+	// The SNS permission(s) being allowed or denied e.g. sns:Publish
+	// +binding:required
+	Action interface{} `json:"action"`
+	// The resource(s) that this policy applies to.
+	Resource interface{} `json:"resource,omitempty"`
+	// Specific circumstances under which the policy grants permission
+	Condition map[string]interface{} `json:"condition,omitempty"`
+	// AdditionalProperties holds the fields the schema doesn't define, such
+	// as specification extensions
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// OperationBinding represents the SNS Operation Binding object.
+// This object contains information about the operation representation in SNS.
+// +binding
+type OperationBinding struct {
+	// Often we can assume that the SNS Topic is the channel name-we provide this
+	// field in case the you need to supply the ARN, or the Topic name is not the
+	// channel name in the AsyncAPI document.
+	Topic *Identifier `json:"topic,omitempty"`
+	// The protocols that listen to this topic and their endpoints.
+	// +binding:required
+	Consumers []Consumer `json:"consumers"`
+	// Policy for retries to HTTP. The field is the default for HTTP receivers of
+	// the SNS Topic which may be overridden by a specific consumer.
+	DeliveryPolicy *DeliveryPolicy `json:"deliveryPolicy,omitempty"`
+	// The version of this binding.
+	BindingVersion string `json:"bindingVersion,omitempty" default:"\"latest\""`
+}
+
+// Identifier represents the SNS identifier object.
+type Identifier struct {
+	// The endpoint is a URL.
+	URL string `json:"url,omitempty"`
+	// The endpoint is an email address.
+	Email string `json:"email,omitempty"`
+	// The endpoint is a phone number.
+	Phone string `json:"phone,omitempty"`
+	// The target is an ARN. For example, for SQS, the identifier may be an ARN,
+	// which will be of the form: arn:aws:sqs:{region}:{account-id}:{queueName}
+	ARN string `json:"arn,omitempty"`
+	// The endpoint is identified by a name, which corresponds to an identifying
+	// field called 'name' of a binding for that protocol on this publish
+	// Operation Object. For example, if the protocol is 'sqs' then the name
+	// refers to the name field sqs binding. We don't use $ref because we are
+	// referring, not including.
+	Name string `json:"name,omitempty"`
+	// AdditionalProperties holds the fields the schema doesn't define, such
+	// as specification extensions
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Consumer represents the SNS consumer object.
+type Consumer struct {
+	// The protocol that this endpoint receives messages by.
+	// +binding:required
+	// +binding:enum=http;https;email;email-json;sms;sqs;application;lambda;firehose
+	Protocol string `json:"protocol"`
+	// The endpoint messages are delivered to.
+	// +binding:required
+	Endpoint *Identifier `json:"endpoint"`
+	// Only receive a subset of messages from the channel, determined by this
+	// policy. Depending on the FilterPolicyScope, a map of either a message
+	// attribute or message body to an array of possible matches. The match may
+	// be a simple string for an exact match, but it may also be an object that
+	// represents a constraint and values for that constraint.
+	FilterPolicy map[string]interface{} `json:"filterPolicy,omitempty"`
+	// Determines whether the FilterPolicy applies to MessageAttributes or
+	// MessageBody.
+	// +binding:enum=MessageAttributes;MessageBody
+	FilterPolicyScope string `json:"filterPolicyScope,omitempty"`
+	// If true AWS SNS attributes are removed from the body, and for SQS, SNS
+	// message attributes are copied to SQS message attributes. If false the SNS
+	// attributes are included in the body.
+	// +binding:required
+	RawMessageDelivery bool `json:"rawMessageDelivery"`
+	// Prevent poison pill messages by moving un-processable messages to an SQS
+	// dead letter queue.
+	RedrivePolicy *RedrivePolicy `json:"redrivePolicy,omitempty"`
+	// Policy for retries to HTTP. The parameter is for that SNS Subscription and
+	// overrides any policy on the SNS Topic.
+	DeliveryPolicy *DeliveryPolicy `json:"deliveryPolicy,omitempty"`
+	// The display name to use with an SNS subscription
+	DisplayName string `json:"displayName,omitempty"`
+	// AdditionalProperties holds the fields the schema doesn't define, such
+	// as specification extensions
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// RedrivePolicy represents the SNS redrivePolicy object.
+// Prevent poison pill messages by moving un-processable messages to an SQS
+// dead letter queue.
+type RedrivePolicy struct {
+	// The SQS queue to use as a dead letter queue (DLQ).
+	// +binding:required
+	DeadLetterQueue *Identifier `json:"deadLetterQueue"`
+	// The number of times a message is delivered to the source queue before
+	// being moved to the dead-letter queue.
+	MaxReceiveCount *int `json:"maxReceiveCount,omitempty"`
+	// AdditionalProperties holds the fields the schema doesn't define, such
+	// as specification extensions
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// DeliveryPolicy represents the SNS deliveryPolicy object.
+type DeliveryPolicy struct {
+	// The minimum delay for a retry in seconds.
+	MinDelayTarget *int `json:"minDelayTarget,omitempty"`
+	// The maximum delay for a retry in seconds.
+	MaxDelayTarget *int `json:"maxDelayTarget,omitempty"`
+	// The total number of retries, including immediate, pre-backoff, backoff,
+	// and post-backoff retries.
+	NumRetries *int `json:"numRetries,omitempty"`
+	// The number of immediate retries (with no delay).
+	NumNoDelayRetries *int `json:"numNoDelayRetries,omitempty"`
+	// The number of immediate retries (with delay).
+	NumMinDelayRetries *int `json:"numMinDelayRetries,omitempty"`
+	// The number of post-backoff phase retries, with the maximum delay between
+	// retries.
+	NumMaxDelayRetries *int `json:"numMaxDelayRetries,omitempty"`
+	// The algorithm for backoff between retries.
+	// +binding:enum=arithmetic;exponential;geometric;linear
+	BackoffFunction string `json:"backoffFunction,omitempty"`
+	// The maximum number of deliveries per second, per subscription.
+	MaxReceivesPerSecond *int `json:"maxReceivesPerSecond,omitempty"`
+	// AdditionalProperties holds the fields the schema doesn't define, such
+	// as specification extensions
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// OrderingType represents the values of Ordering.Type
+const (
+	OrderingTypeStandard = "standard"
+	OrderingTypeFIFO     = "FIFO"
+)
+
+// StatementEffect represents the values of Statement.Effect
+const (
+	StatementEffectAllow = "Allow"
+	StatementEffectDeny  = "Deny"
+)
+
+// ConsumerProtocol represents the values of Consumer.Protocol
+const (
+	ConsumerProtocolHTTP        = "http"
+	ConsumerProtocolHTTPS       = "https"
+	ConsumerProtocolEmail       = "email"
+	ConsumerProtocolEmailJSON   = "email-json"
+	ConsumerProtocolSMS         = "sms"
+	ConsumerProtocolSQS         = "sqs"
+	ConsumerProtocolApplication = "application"
+	ConsumerProtocolLambda      = "lambda"
+	ConsumerProtocolFirehose    = "firehose"
+)
+
+// ConsumerFilterPolicyScope represents the values of Consumer.FilterPolicyScope
+const (
+	ConsumerFilterPolicyScopeMessageAttributes = "MessageAttributes"
+	ConsumerFilterPolicyScopeMessageBody       = "MessageBody"
+)
+
+// DeliveryPolicyBackoffFunction represents the values of DeliveryPolicy.BackoffFunction
+const (
+	DeliveryPolicyBackoffFunctionArithmetic  = "arithmetic"
+	DeliveryPolicyBackoffFunctionExponential = "exponential"
+	DeliveryPolicyBackoffFunctionGeometric   = "geometric"
+	DeliveryPolicyBackoffFunctionLinear      = "linear"
+)
+
+// MarshalJSON is a custom marshaller that converts Ordering to JSON,
+// including its AdditionalProperties
+func (t Ordering) MarshalJSON() ([]byte, error) {
+	type Alias Ordering
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.AdditionalProperties) == 0 {
+		return data, err
+	}
+	m := make(map[string]interface{})
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range t.AdditionalProperties {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to Ordering,
+// keeping the fields it doesn't define in AdditionalProperties
+func (t *Ordering) UnmarshalJSON(data []byte) error {
+	type Alias Ordering
+	if err := jsonnumber.Unmarshal(data, (*Alias)(t)); err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for _, k := range []string{"type", "contentBasedDeduplication"} {
+		delete(m, k)
+	}
+	t.AdditionalProperties = nil
+	if len(m) > 0 {
+		t.AdditionalProperties = m
+	}
+	return nil
+}
+
+// MarshalJSON is a custom marshaller that converts Policy to JSON,
+// including its AdditionalProperties
+func (t Policy) MarshalJSON() ([]byte, error) {
+	type Alias Policy
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.AdditionalProperties) == 0 {
+		return data, err
+	}
+	m := make(map[string]interface{})
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range t.AdditionalProperties {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to Policy,
+// keeping the fields it doesn't define in AdditionalProperties
+func (t *Policy) UnmarshalJSON(data []byte) error {
+	type Alias Policy
+	if err := jsonnumber.Unmarshal(data, (*Alias)(t)); err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for _, k := range []string{"statements"} {
+		delete(m, k)
+	}
+	t.AdditionalProperties = nil
+	if len(m) > 0 {
+		t.AdditionalProperties = m
+	}
+	return nil
+}
+
+// MarshalJSON is a custom marshaller that converts Statement to JSON,
+// including its AdditionalProperties
+func (t Statement) MarshalJSON() ([]byte, error) {
+	type Alias Statement
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.AdditionalProperties) == 0 {
+		return data, err
+	}
+	m := make(map[string]interface{})
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range t.AdditionalProperties {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to Statement,
+// keeping the fields it doesn't define in AdditionalProperties
+func (t *Statement) UnmarshalJSON(data []byte) error {
+	type Alias Statement
+	if err := jsonnumber.Unmarshal(data, (*Alias)(t)); err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for _, k := range []string{"effect", "principal", "action", "resource", "condition"} {
+		delete(m, k)
+	}
+	t.AdditionalProperties = nil
+	if len(m) > 0 {
+		t.AdditionalProperties = m
+	}
+	return nil
+}
+
+// MarshalJSON is a custom marshaller that converts Identifier to JSON,
+// including its AdditionalProperties
+func (t Identifier) MarshalJSON() ([]byte, error) {
+	type Alias Identifier
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.AdditionalProperties) == 0 {
+		return data, err
+	}
+	m := make(map[string]interface{})
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range t.AdditionalProperties {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to Identifier,
+// keeping the fields it doesn't define in AdditionalProperties
+func (t *Identifier) UnmarshalJSON(data []byte) error {
+	type Alias Identifier
+	if err := jsonnumber.Unmarshal(data, (*Alias)(t)); err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for _, k := range []string{"url", "email", "phone", "arn", "name"} {
+		delete(m, k)
+	}
+	t.AdditionalProperties = nil
+	if len(m) > 0 {
+		t.AdditionalProperties = m
+	}
+	return nil
+}
+
+// MarshalJSON is a custom marshaller that converts Consumer to JSON,
+// including its AdditionalProperties
+func (t Consumer) MarshalJSON() ([]byte, error) {
+	type Alias Consumer
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.AdditionalProperties) == 0 {
+		return data, err
+	}
+	m := make(map[string]interface{})
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range t.AdditionalProperties {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to Consumer,
+// keeping the fields it doesn't define in AdditionalProperties
+func (t *Consumer) UnmarshalJSON(data []byte) error {
+	type Alias Consumer
+	if err := jsonnumber.Unmarshal(data, (*Alias)(t)); err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for _, k := range []string{"protocol", "endpoint", "filterPolicy", "filterPolicyScope", "rawMessageDelivery", "redrivePolicy", "deliveryPolicy", "displayName"} {
+		delete(m, k)
+	}
+	t.AdditionalProperties = nil
+	if len(m) > 0 {
+		t.AdditionalProperties = m
+	}
+	return nil
+}
+
+// MarshalJSON is a custom marshaller that converts RedrivePolicy to JSON,
+// including its AdditionalProperties
+func (t RedrivePolicy) MarshalJSON() ([]byte, error) {
+	type Alias RedrivePolicy
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.AdditionalProperties) == 0 {
+		return data, err
+	}
+	m := make(map[string]interface{})
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range t.AdditionalProperties {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to RedrivePolicy,
+// keeping the fields it doesn't define in AdditionalProperties
+func (t *RedrivePolicy) UnmarshalJSON(data []byte) error {
+	type Alias RedrivePolicy
+	if err := jsonnumber.Unmarshal(data, (*Alias)(t)); err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for _, k := range []string{"deadLetterQueue", "maxReceiveCount"} {
+		delete(m, k)
+	}
+	t.AdditionalProperties = nil
+	if len(m) > 0 {
+		t.AdditionalProperties = m
+	}
+	return nil
+}
+
+// MarshalJSON is a custom marshaller that converts DeliveryPolicy to JSON,
+// including its AdditionalProperties
+func (t DeliveryPolicy) MarshalJSON() ([]byte, error) {
+	type Alias DeliveryPolicy
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.AdditionalProperties) == 0 {
+		return data, err
+	}
+	m := make(map[string]interface{})
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range t.AdditionalProperties {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to DeliveryPolicy,
+// keeping the fields it doesn't define in AdditionalProperties
+func (t *DeliveryPolicy) UnmarshalJSON(data []byte) error {
+	type Alias DeliveryPolicy
+	if err := jsonnumber.Unmarshal(data, (*Alias)(t)); err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for _, k := range []string{"minDelayTarget", "maxDelayTarget", "numRetries", "numNoDelayRetries", "numMinDelayRetries", "numMaxDelayRetries", "backoffFunction", "maxReceivesPerSecond"} {
+		delete(m, k)
+	}
+	t.AdditionalProperties = nil
+	if len(m) > 0 {
+		t.AdditionalProperties = m
+	}
+	return nil
+}
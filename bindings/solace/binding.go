@@ -0,0 +1,43 @@
+package solace
+
+import "github.com/charlie-haley/asyncapi-go/bindings"
+
+//go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen
+
+// Protocol is the key solace bindings appear under in a bindings object
+const Protocol = "solace"
+
+const BindingVersion = "0.4.0"
+
+func init() {
+	bindings.Register(Protocol, bindings.Server, func() any { return &ServerBinding{} })
+	bindings.Register(Protocol, bindings.Operation, func() any { return &OperationBinding{} })
+
+	bindings.RegisterVersions(Protocol, bindings.Server, bindings.Versions{
+		Supported: []string{"0.2.0", "0.3.0", "0.4.0"},
+		Fields: []bindings.Field{
+			{Path: "msvVpn", Removed: "0.3.0"},
+			{Path: "msgVpn", Added: "0.3.0"},
+			{Path: "clientName", Added: "0.4.0"},
+		},
+		Migrations: []bindings.Migration{
+			// Version 0.2.0 misspelled msgVpn
+			{From: "0.2.0", Migrate: func(binding map[string]any) error {
+				if vpn, ok := binding["msvVpn"]; ok {
+					binding["msgVpn"] = vpn
+				}
+				return nil
+			}},
+		},
+	})
+	bindings.RegisterVersions(Protocol, bindings.Operation, bindings.Versions{
+		Supported: []string{"0.2.0", "0.3.0", "0.4.0"},
+		Fields: []bindings.Field{
+			{Path: "destinations/*/queue/maxTtl", Added: "0.3.0"},
+			{Path: "destinations/*/queue/maxMsgSpoolUsage", Added: "0.3.0"},
+			{Path: "timeToLive", Added: "0.4.0"},
+			{Path: "priority", Added: "0.4.0"},
+			{Path: "dmqEligible", Added: "0.4.0"},
+		},
+	})
+}
@@ -0,0 +1,59 @@
+package solace
+
+// OperationBinding represents the Solace Operation Binding object.
+//
+// This object contains information about the operation representation in Solace.
+// +binding
+type OperationBinding struct {
+	Destinations []Destination `json:"destinations,omitempty"`
+	// TimeToLive is an interval in milliseconds, or a Schema object
+	// describing it.
+	TimeToLive interface{} `json:"timeToLive,omitempty"`
+	// Priority is a value from 0 to 255, or a Schema object describing it.
+	Priority       interface{} `json:"priority,omitempty"`
+	DMQEligible    bool        `json:"dmqEligible,omitempty"`
+	BindingVersion string      `json:"bindingVersion,omitempty"`
+}
+
+// Destination is a Solace queue or topic subscription referenced by an operation.
+type Destination struct {
+	// +binding:enum=queue;topic
+	DestinationType string `json:"destinationType"`
+	// +binding:enum=direct;persistent
+	DeliveryMode string `json:"deliveryMode,omitempty"`
+	// Queue is only set when DestinationType is queue.
+	Queue *Queue `json:"queue,omitempty"`
+	// TopicSubscriptions is only set when DestinationType is topic. When
+	// omitted, the client subscribes to the channel's address.
+	TopicSubscriptions []string `json:"topicSubscriptions,omitempty"`
+}
+
+// DestinationType represents the different types of destinations.
+const (
+	DestinationTypeQueue = "queue"
+	DestinationTypeTopic = "topic"
+)
+
+// DeliveryMode represents the delivery modes of a destination.
+const (
+	DeliveryModeDirect     = "direct"
+	DeliveryModePersistent = "persistent"
+)
+
+// Queue defines the queue a subscriber binds to.
+type Queue struct {
+	Name string `json:"name,omitempty"`
+	// TopicSubscriptions are the topics the queue subscribes to. When
+	// omitted, the queue subscribes to the channel's address.
+	TopicSubscriptions []string `json:"topicSubscriptions,omitempty"`
+	// +binding:enum=exclusive;nonexclusive
+	AccessType       string `json:"accessType,omitempty"`
+	MaxTTL           string `json:"maxTtl,omitempty"`
+	MaxMsgSpoolUsage string `json:"maxMsgSpoolUsage,omitempty"`
+}
+
+// AccessType represents the access types of a queue.
+const (
+	AccessTypeExclusive    = "exclusive"
+	AccessTypeNonExclusive = "nonexclusive"
+)
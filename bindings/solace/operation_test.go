@@ -0,0 +1,143 @@
+package solace
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestOperationBindingConstants(t *testing.T) {
+	assert.Equal(t, "queue", DestinationTypeQueue)
+	assert.Equal(t, "topic", DestinationTypeTopic)
+	assert.Equal(t, "direct", DeliveryModeDirect)
+	assert.Equal(t, "persistent", DeliveryModePersistent)
+	assert.Equal(t, "exclusive", AccessTypeExclusive)
+	assert.Equal(t, "nonexclusive", AccessTypeNonExclusive)
+}
+
+var destinations = []Destination{
+	{
+		DestinationType: DestinationTypeQueue,
+		DeliveryMode:    DeliveryModePersistent,
+		Queue: &Queue{
+			Name:               "CreatedHREvents",
+			TopicSubscriptions: []string{"person/*/created"},
+			AccessType:         AccessTypeExclusive,
+			MaxMsgSpoolUsage:   "1500",
+			MaxTTL:             "60",
+		},
+	},
+	{
+		DestinationType:    DestinationTypeTopic,
+		TopicSubscriptions: []string{"person/*/updated"},
+	},
+}
+
+func TestOperationBinding_BuildObject(t *testing.T) {
+	ob := NewOperationBinding().
+		WithDestinations(destinations).
+		WithTimeToLive(5000).
+		WithPriority(120).
+		WithDMQEligible(true)
+
+	assert.Equal(t, destinations, ob.Destinations)
+	assert.Equal(t, 5000, ob.TimeToLive)
+	assert.Equal(t, 120, ob.Priority)
+	assert.True(t, ob.DMQEligible)
+}
+
+func TestOperationBinding_MarshalYAML(t *testing.T) {
+	ob := NewOperationBinding().
+		WithDestinations(destinations).
+		WithDMQEligible(true)
+
+	expectedYAML := `destinations:
+- deliveryMode: persistent
+  destinationType: queue
+  queue:
+    accessType: exclusive
+    maxMsgSpoolUsage: "1500"
+    maxTtl: "60"
+    name: CreatedHREvents
+    topicSubscriptions:
+    - person/*/created
+- destinationType: topic
+  topicSubscriptions:
+  - person/*/updated
+dmqEligible: true
+`
+	marshaledYAML, err := yaml.Marshal(ob)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestOperationBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+destinations:
+  - destinationType: queue
+    deliveryMode: persistent
+    queue:
+      name: CreatedHREvents
+      topicSubscriptions:
+        - person/*/created
+      accessType: exclusive
+      maxMsgSpoolUsage: "1500"
+      maxTtl: "60"
+  - destinationType: topic
+    topicSubscriptions:
+      - person/*/updated
+timeToLive: 5000
+priority:
+  type: integer
+  minimum: 0
+  maximum: 255
+bindingVersion: 0.4.0
+`
+	var ob OperationBinding
+	err := yaml.Unmarshal([]byte(yamlString), &ob)
+	assert.NoError(t, err)
+
+	assert.Equal(t, destinations, ob.Destinations)
+	assert.Equal(t, json.Number("5000"), ob.TimeToLive)
+	assert.Equal(t, map[string]interface{}{
+		"type":    "integer",
+		"minimum": json.Number("0"),
+		"maximum": json.Number("255"),
+	}, ob.Priority)
+	assert.False(t, ob.DMQEligible)
+	assert.Equal(t, "0.4.0", ob.BindingVersion)
+}
+
+func TestOperationBinding_MarshalJSON(t *testing.T) {
+	ob := NewOperationBinding().
+		WithDestinations([]Destination{{DestinationType: DestinationTypeTopic, DeliveryMode: DeliveryModeDirect}}).
+		WithTimeToLive(5000).
+		WithBindingVersion(BindingVersion)
+
+	expectedJSON := `{"destinations":[{"destinationType":"topic","deliveryMode":"direct"}],"timeToLive":5000,"bindingVersion":"0.4.0"}`
+
+	marshaledJSON, err := json.Marshal(ob)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestOperationBinding_UnmarshalJSON(t *testing.T) {
+	jsonString := `{
+		"destinations": [
+			{"destinationType": "queue", "queue": {"name": "Orders", "accessType": "nonexclusive"}}
+		],
+		"dmqEligible": true
+	}`
+
+	var ob OperationBinding
+	err := json.Unmarshal([]byte(jsonString), &ob)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []Destination{
+		{DestinationType: DestinationTypeQueue, Queue: &Queue{Name: "Orders", AccessType: AccessTypeNonExclusive}},
+	}, ob.Destinations)
+	assert.Nil(t, ob.TimeToLive)
+	assert.True(t, ob.DMQEligible)
+}
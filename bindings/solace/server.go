@@ -0,0 +1,11 @@
+package solace
+
+// ServerBinding represents the Solace Server Binding object.
+//
+// This object contains server connection information about the Solace broker.
+// +binding
+type ServerBinding struct {
+	MsgVPN         string `json:"msgVpn,omitempty"`
+	ClientName     string `json:"clientName,omitempty"`
+	BindingVersion string `json:"bindingVersion,omitempty"`
+}
@@ -0,0 +1,66 @@
+package solace
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestServerBinding_BuildObject(t *testing.T) {
+	sb := NewServerBinding().
+		WithMsgVPN("ProdVPN").
+		WithClientName("transactions-broker")
+
+	assert.Equal(t, "ProdVPN", sb.MsgVPN)
+	assert.Equal(t, "transactions-broker", sb.ClientName)
+}
+
+func TestServerBinding_MarshalYAML(t *testing.T) {
+	sb := NewServerBinding().
+		WithMsgVPN("ProdVPN").
+		WithClientName("transactions-broker")
+
+	expectedYAML := `clientName: transactions-broker
+msgVpn: ProdVPN
+`
+	marshaledYAML, err := yaml.Marshal(sb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestServerBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+msgVpn: ProdVPN
+bindingVersion: 0.4.0
+`
+	var sb ServerBinding
+	err := yaml.Unmarshal([]byte(yamlString), &sb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "ProdVPN", sb.MsgVPN)
+	assert.Empty(t, sb.ClientName)
+	assert.Equal(t, "0.4.0", sb.BindingVersion)
+}
+
+func TestServerBinding_MarshalJSON(t *testing.T) {
+	sb := NewServerBinding().
+		WithMsgVPN("ProdVPN").
+		WithBindingVersion(BindingVersion)
+
+	expectedJSON := `{"msgVpn":"ProdVPN","bindingVersion":"0.4.0"}`
+
+	marshaledJSON, err := json.Marshal(sb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestServerBinding_UnmarshalJSON(t *testing.T) {
+	var sb ServerBinding
+	err := json.Unmarshal([]byte(`{"msgVpn": "ProdVPN", "clientName": "transactions-broker"}`), &sb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "ProdVPN", sb.MsgVPN)
+	assert.Equal(t, "transactions-broker", sb.ClientName)
+}
@@ -0,0 +1,189 @@
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// THIS FILE IS GENERATED. DO NOT EDIT
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// If you would like to update properties for a binding,
+// edit the struct for the binding you'd like to update.
+// e.g solace/channel.go and run `make generate` to re-gen
+// this file.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+package solace
+
+import (
+	"encoding/json"
+	"sigs.k8s.io/yaml"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+
+	"errors"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+)
+
+// NewOperationBinding creates a new OperationBinding object
+func NewOperationBinding() *OperationBinding {
+	return &OperationBinding{}
+}
+
+// WithDestinations sets the 'destinations' field of OperationBinding
+func (obj *OperationBinding) WithDestinations(destinations []Destination) *OperationBinding {
+	obj.Destinations = destinations
+	return obj
+}
+
+// WithTimeToLive sets the 'timeToLive' field of OperationBinding
+func (obj *OperationBinding) WithTimeToLive(timeToLive interface{}) *OperationBinding {
+	obj.TimeToLive = timeToLive
+	return obj
+}
+
+// WithPriority sets the 'priority' field of OperationBinding
+func (obj *OperationBinding) WithPriority(priority interface{}) *OperationBinding {
+	obj.Priority = priority
+	return obj
+}
+
+// WithDMQEligible sets the 'dmqEligible' field of OperationBinding
+func (obj *OperationBinding) WithDMQEligible(dmqEligible bool) *OperationBinding {
+	obj.DMQEligible = dmqEligible
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of OperationBinding
+func (obj *OperationBinding) WithBindingVersion(bindingVersion string) *OperationBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts OperationBinding to YAML
+func (t OperationBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to OperationBinding
+func (t *OperationBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts OperationBinding to JSON
+func (t OperationBinding) MarshalJSON() ([]byte, error) {
+	type Alias OperationBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to OperationBinding
+func (t *OperationBinding) UnmarshalJSON(data []byte) error {
+	type Alias OperationBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of OperationBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t OperationBinding) Validate() error {
+	var errs []error
+	for i, item := range t.Destinations {
+		errs = append(errs, bindings.Nest(bindings.Index("destinations", i), item.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the fields of Destination, returning a
+// *bindings.FieldError for each invalid field
+func (t Destination) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Enum("destinationType", t.DestinationType, "queue", "topic"))
+	errs = append(errs, bindings.Enum("deliveryMode", t.DeliveryMode, "direct", "persistent"))
+	if t.Queue != nil {
+		errs = append(errs, bindings.Nest("queue", t.Queue.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the fields of Queue, returning a
+// *bindings.FieldError for each invalid field
+func (t Queue) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Enum("accessType", t.AccessType, "exclusive", "nonexclusive"))
+	return errors.Join(errs...)
+}
+
+// NewServerBinding creates a new ServerBinding object
+func NewServerBinding() *ServerBinding {
+	return &ServerBinding{}
+}
+
+// WithMsgVPN sets the 'msgVpn' field of ServerBinding
+func (obj *ServerBinding) WithMsgVPN(msgVpn string) *ServerBinding {
+	obj.MsgVPN = msgVpn
+	return obj
+}
+
+// WithClientName sets the 'clientName' field of ServerBinding
+func (obj *ServerBinding) WithClientName(clientName string) *ServerBinding {
+	obj.ClientName = clientName
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of ServerBinding
+func (obj *ServerBinding) WithBindingVersion(bindingVersion string) *ServerBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts ServerBinding to YAML
+func (t ServerBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to ServerBinding
+func (t *ServerBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts ServerBinding to JSON
+func (t ServerBinding) MarshalJSON() ([]byte, error) {
+	type Alias ServerBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to ServerBinding
+func (t *ServerBinding) UnmarshalJSON(data []byte) error {
+	type Alias ServerBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of ServerBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ServerBinding) Validate() error {
+	return nil
+}
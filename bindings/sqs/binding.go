@@ -1,5 +1,22 @@
 package sqs
 
-//go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen
+import "github.com/charlie-haley/asyncapi-go/bindings"
+
+//go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen -schemas ../../internal/validation/bindings/sqs/0.3.0
+
+// Protocol is the key sqs bindings appear under in a bindings object
+const Protocol = "sqs"
 
 const BindingVersion = "0.3.0"
+
+func init() {
+	bindings.Register(Protocol, bindings.Channel, func() any { return &ChannelBinding{} })
+	bindings.Register(Protocol, bindings.Operation, func() any { return &OperationBinding{} })
+
+	bindings.RegisterVersions(Protocol, bindings.Channel, bindings.Versions{
+		Supported: []string{"0.2.0", "0.3.0"},
+	})
+	bindings.RegisterVersions(Protocol, bindings.Operation, bindings.Versions{
+		Supported: []string{"0.2.0", "0.3.0"},
+	})
+}
@@ -9,6 +9,8 @@ import (
 )
 
 func TestChannelBinding_BuildObject(t *testing.T) {
+	visibilityTimeout := 30
+	messageRetentionPeriod := 345600
 	maxReceiveCount := 3
 	cb := NewChannelBinding().
 		WithQueue(NewQueue().
@@ -17,11 +19,11 @@ func TestChannelBinding_BuildObject(t *testing.T) {
 			WithDeduplicationScope("messageGroup").
 			WithFifoThroughputLimit("perMessageGroupId").
 			WithDeliveryDelay(60).
-			WithVisibilityTimeout(30).
+			WithVisibilityTimeout(&visibilityTimeout).
 			WithReceiveMessageWaitTime(20).
-			WithMessageRetentionPeriod(345600).
+			WithMessageRetentionPeriod(&messageRetentionPeriod).
 			WithRedrivePolicy(&RedrivePolicy{
-				DeadLetterQueue: Identifier{
+				DeadLetterQueue: &Identifier{
 					ARN:  "arn:aws:sqs:us-east-1:123456789012:MyDeadLetterQueue",
 					Name: "MyDeadLetterQueue",
 				},
@@ -37,7 +39,7 @@ func TestChannelBinding_BuildObject(t *testing.T) {
 					},
 				},
 			}).
-			WithTags(map[string]string{
+			WithTags(map[string]interface{}{
 				"environment": "production",
 				"team":        "platform",
 			}),
@@ -52,9 +54,9 @@ func TestChannelBinding_BuildObject(t *testing.T) {
 	assert.Equal(t, "messageGroup", cb.Queue.DeduplicationScope)
 	assert.Equal(t, "perMessageGroupId", cb.Queue.FifoThroughputLimit)
 	assert.Equal(t, 60, cb.Queue.DeliveryDelay)
-	assert.Equal(t, 30, cb.Queue.VisibilityTimeout)
+	assert.Equal(t, 30, *cb.Queue.VisibilityTimeout)
 	assert.Equal(t, 20, cb.Queue.ReceiveMessageWaitTime)
-	assert.Equal(t, 345600, cb.Queue.MessageRetentionPeriod)
+	assert.Equal(t, 345600, *cb.Queue.MessageRetentionPeriod)
 	assert.NotNil(t, cb.Queue.RedrivePolicy)
 	assert.Equal(t, "arn:aws:sqs:us-east-1:123456789012:MyDeadLetterQueue", cb.Queue.RedrivePolicy.DeadLetterQueue.ARN)
 	assert.Equal(t, "MyDeadLetterQueue", cb.Queue.RedrivePolicy.DeadLetterQueue.Name)
@@ -79,7 +81,7 @@ func TestChannelBinding_MarshalYAML(t *testing.T) {
 			WithName("MyQueue").
 			WithFifoQueue(true).
 			WithRedrivePolicy(&RedrivePolicy{
-				DeadLetterQueue: Identifier{
+				DeadLetterQueue: &Identifier{
 					Name: "MyDeadLetterQueue",
 				},
 				MaxReceiveCount: &maxReceiveCount,
@@ -119,7 +121,7 @@ queue:
 deadLetterQueue:
   name: MyDeadLetterQueue
   fifoQueue: true
-bindingVersion: "0.5.0"
+bindingVersion: "0.3.0"
 `
 	var cb ChannelBinding
 	err := yaml.Unmarshal([]byte(yamlString), &cb)
@@ -131,9 +133,9 @@ bindingVersion: "0.5.0"
 	assert.Equal(t, "messageGroup", cb.Queue.DeduplicationScope)
 	assert.Equal(t, "perMessageGroupId", cb.Queue.FifoThroughputLimit)
 	assert.Equal(t, 60, cb.Queue.DeliveryDelay)
-	assert.Equal(t, 30, cb.Queue.VisibilityTimeout)
+	assert.Equal(t, 30, *cb.Queue.VisibilityTimeout)
 	assert.Equal(t, 20, cb.Queue.ReceiveMessageWaitTime)
-	assert.Equal(t, 345600, cb.Queue.MessageRetentionPeriod)
+	assert.Equal(t, 345600, *cb.Queue.MessageRetentionPeriod)
 	assert.NotNil(t, cb.Queue.RedrivePolicy)
 	assert.Equal(t, "MyDeadLetterQueue", cb.Queue.RedrivePolicy.DeadLetterQueue.Name)
 	assert.Equal(t, 3, *cb.Queue.RedrivePolicy.MaxReceiveCount)
@@ -150,7 +152,7 @@ func TestChannelBinding_MarshalJSON(t *testing.T) {
 			WithName("MyQueue").
 			WithFifoQueue(true).
 			WithRedrivePolicy(&RedrivePolicy{
-				DeadLetterQueue: Identifier{
+				DeadLetterQueue: &Identifier{
 					Name: "MyDeadLetterQueue",
 				},
 				MaxReceiveCount: &maxReceiveCount,
@@ -205,9 +207,9 @@ func TestChannelBinding_UnmarshalJSON(t *testing.T) {
 	assert.Equal(t, "messageGroup", cb.Queue.DeduplicationScope)
 	assert.Equal(t, "perMessageGroupId", cb.Queue.FifoThroughputLimit)
 	assert.Equal(t, 60, cb.Queue.DeliveryDelay)
-	assert.Equal(t, 30, cb.Queue.VisibilityTimeout)
+	assert.Equal(t, 30, *cb.Queue.VisibilityTimeout)
 	assert.Equal(t, 20, cb.Queue.ReceiveMessageWaitTime)
-	assert.Equal(t, 345600, cb.Queue.MessageRetentionPeriod)
+	assert.Equal(t, 345600, *cb.Queue.MessageRetentionPeriod)
 	assert.NotNil(t, cb.Queue.RedrivePolicy)
 	assert.Equal(t, "MyDeadLetterQueue", cb.Queue.RedrivePolicy.DeadLetterQueue.Name)
 	assert.Equal(t, 3, *cb.Queue.RedrivePolicy.MaxReceiveCount)
@@ -217,4 +219,16 @@ func TestChannelBinding_UnmarshalJSON(t *testing.T) {
 	assert.Equal(t, "*", cb.Queue.Policy.Statements[0].Principal)
 	assert.Equal(t, "sqs:SendMessage", cb.Queue.Policy.Statements[0].Action)
 	assert.Equal(t, "production", cb.Queue.Tags["environment"])
-}
\ No newline at end of file
+}
+
+func TestChannelBinding_Validate(t *testing.T) {
+	cb := NewChannelBinding().
+		WithQueue(NewQueue().WithName("myQueue"))
+	assert.NoError(t, cb.Validate())
+
+	cb.Queue.Name = ""
+	cb.Queue.RedrivePolicy = &RedrivePolicy{}
+	assert.EqualError(t, cb.Validate(), "queue.name is required\nqueue.redrivePolicy.deadLetterQueue is required")
+
+	assert.EqualError(t, NewChannelBinding().Validate(), "queue is required")
+}
@@ -34,7 +34,8 @@ func TestOperationBinding_MarshalYAML(t *testing.T) {
 				WithFifoQueue(true),
 		})
 
-	expectedYAML := `queues:
+	expectedYAML := `bindingVersion: latest
+queues:
 - fifoQueue: true
   name: Queue1
 `
@@ -70,7 +71,7 @@ func TestOperationBinding_MarshalJSON(t *testing.T) {
 				WithFifoQueue(true),
 		})
 
-	expectedJSON := `{"queues":[{"name":"Queue1","fifoQueue":true}]}`
+	expectedJSON := `{"queues":[{"name":"Queue1","fifoQueue":true}],"bindingVersion":"latest"}`
 
 	marshaledJSON, err := json.Marshal(ob)
 	assert.NoError(t, err)
@@ -100,4 +101,11 @@ func TestOperationBinding_UnmarshalJSON(t *testing.T) {
 	assert.True(t, ob.Queues[0].FifoQueue)
 	assert.Equal(t, "Queue2", ob.Queues[1].Name)
 	assert.False(t, ob.Queues[1].FifoQueue)
-}
\ No newline at end of file
+}
+
+func TestOperationBinding_Validate(t *testing.T) {
+	ob := NewOperationBinding().
+		WithQueues([]Queue{*NewQueue().WithName("Queue1"), *NewQueue()})
+
+	assert.EqualError(t, ob.Validate(), "queues[1].name is required")
+}
@@ -10,72 +10,15 @@
 package sqs
 
 import (
-
 	"encoding/json"
 	"sigs.k8s.io/yaml"
 
-)
-
-
-// NewOperationBinding creates a new OperationBinding object
-func NewOperationBinding() *OperationBinding {
-	return &OperationBinding{
-	}
-}
-
-
-// WithQueues sets the 'queues' field of OperationBinding
-func (obj *OperationBinding) WithQueues(queues []Queue) *OperationBinding {
-	obj.Queues = queues
-	return obj
-}
-
-// WithBindingVersion sets the 'bindingVersion' field of OperationBinding
-func (obj *OperationBinding) WithBindingVersion(bindingVersion string) *OperationBinding {
-	obj.BindingVersion = bindingVersion
-	return obj
-}
-
-
-
-// MarshalYAML is a custom marshaller that converts OperationBinding to YAML
-func (t OperationBinding) MarshalYAML() (interface{}, error) {
-    bytes, err := json.Marshal(t)
-    if err != nil {
-        return nil, err
-    }
-    var out interface{}
-    err = yaml.Unmarshal(bytes, &out) 
-    return out, err
-}
-
-// UnmarshalYAML is a custom unmarshaler that converts YAML to OperationBinding
-func (t *OperationBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
-    var temp interface{}
-    if err := unmarshal(&temp); err != nil {
-        return err
-    }
-    bytes, err := yaml.Marshal(temp)
-    if err != nil {
-        return err
-    }
-    return json.Unmarshal(bytes, t)
-}
-
-// MarshalJSON is a custom marshaller that converts OperationBinding to JSON
-func (t OperationBinding) MarshalJSON() ([]byte, error) {
-	type Alias OperationBinding
-	return json.Marshal(struct{ Alias }{Alias(t)})
-}
-
-// UnmarshalJSON is a custom unmarshaler that converts JSON to OperationBinding
-func (t *OperationBinding) UnmarshalJSON(data []byte) error {
-	type Alias OperationBinding
-	aux := struct{ *Alias }{Alias: (*Alias)(t)}
-	return json.Unmarshal(data, &aux)
-}
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
 
+	"errors"
 
+	"github.com/charlie-haley/asyncapi-go/bindings"
+)
 
 // NewChannelBinding creates a new ChannelBinding object
 func NewChannelBinding() *ChannelBinding {
@@ -84,7 +27,6 @@ func NewChannelBinding() *ChannelBinding {
 	}
 }
 
-
 // WithQueue sets the 'queue' field of ChannelBinding
 func (obj *ChannelBinding) WithQueue(queue *Queue) *ChannelBinding {
 	obj.Queue = queue
@@ -103,30 +45,28 @@ func (obj *ChannelBinding) WithBindingVersion(bindingVersion string) *ChannelBin
 	return obj
 }
 
-
-
 // MarshalYAML is a custom marshaller that converts ChannelBinding to YAML
 func (t ChannelBinding) MarshalYAML() (interface{}, error) {
-    bytes, err := json.Marshal(t)
-    if err != nil {
-        return nil, err
-    }
-    var out interface{}
-    err = yaml.Unmarshal(bytes, &out) 
-    return out, err
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
 }
 
 // UnmarshalYAML is a custom unmarshaler that converts YAML to ChannelBinding
 func (t *ChannelBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
-    var temp interface{}
-    if err := unmarshal(&temp); err != nil {
-        return err
-    }
-    bytes, err := yaml.Marshal(temp)
-    if err != nil {
-        return err
-    }
-    return json.Unmarshal(bytes, t)
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
 }
 
 // MarshalJSON is a custom marshaller that converts ChannelBinding to JSON
@@ -139,18 +79,28 @@ func (t ChannelBinding) MarshalJSON() ([]byte, error) {
 func (t *ChannelBinding) UnmarshalJSON(data []byte) error {
 	type Alias ChannelBinding
 	aux := struct{ *Alias }{Alias: (*Alias)(t)}
-	return json.Unmarshal(data, &aux)
+	return jsonnumber.Unmarshal(data, &aux)
 }
 
-
+// Validate checks the fields of ChannelBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t ChannelBinding) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("queue", t.Queue != nil))
+	if t.Queue != nil {
+		errs = append(errs, bindings.Nest("queue", t.Queue.Validate()))
+	}
+	if t.DeadLetterQueue != nil {
+		errs = append(errs, bindings.Nest("deadLetterQueue", t.DeadLetterQueue.Validate()))
+	}
+	return errors.Join(errs...)
+}
 
 // NewQueue creates a new Queue object
 func NewQueue() *Queue {
-	return &Queue{
-	}
+	return &Queue{}
 }
 
-
 // WithName sets the 'name' field of Queue
 func (obj *Queue) WithName(name string) *Queue {
 	obj.Name = name
@@ -182,7 +132,7 @@ func (obj *Queue) WithDeliveryDelay(deliveryDelay int) *Queue {
 }
 
 // WithVisibilityTimeout sets the 'visibilityTimeout' field of Queue
-func (obj *Queue) WithVisibilityTimeout(visibilityTimeout int) *Queue {
+func (obj *Queue) WithVisibilityTimeout(visibilityTimeout *int) *Queue {
 	obj.VisibilityTimeout = visibilityTimeout
 	return obj
 }
@@ -194,7 +144,7 @@ func (obj *Queue) WithReceiveMessageWaitTime(receiveMessageWaitTime int) *Queue
 }
 
 // WithMessageRetentionPeriod sets the 'messageRetentionPeriod' field of Queue
-func (obj *Queue) WithMessageRetentionPeriod(messageRetentionPeriod int) *Queue {
+func (obj *Queue) WithMessageRetentionPeriod(messageRetentionPeriod *int) *Queue {
 	obj.MessageRetentionPeriod = messageRetentionPeriod
 	return obj
 }
@@ -212,11 +162,153 @@ func (obj *Queue) WithPolicy(policy *Policy) *Queue {
 }
 
 // WithTags sets the 'tags' field of Queue
-func (obj *Queue) WithTags(tags map[string]string) *Queue {
+func (obj *Queue) WithTags(tags map[string]interface{}) *Queue {
 	obj.Tags = tags
 	return obj
 }
 
+// WithRef sets the '$ref' field of Queue
+func (obj *Queue) WithRef(ref string) *Queue {
+	obj.Ref = ref
+	return obj
+}
 
+// WithAdditionalProperties sets the '-' field of Queue
+func (obj *Queue) WithAdditionalProperties(additionalProperties map[string]interface{}) *Queue {
+	obj.AdditionalProperties = additionalProperties
+	return obj
+}
 
+// Validate checks the fields of Queue, returning a
+// *bindings.FieldError for each invalid field
+func (t Queue) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("name", t.Name != ""))
+	errs = append(errs, bindings.Enum("deduplicationScope", t.DeduplicationScope, "queue", "messageGroup"))
+	errs = append(errs, bindings.Enum("fifoThroughputLimit", t.FifoThroughputLimit, "perQueue", "perMessageGroupId"))
+	if t.DeliveryDelay != 0 {
+		errs = append(errs, bindings.Min("deliveryDelay", t.DeliveryDelay, 0))
+		errs = append(errs, bindings.Max("deliveryDelay", t.DeliveryDelay, 15))
+	}
+	if t.VisibilityTimeout != nil {
+		errs = append(errs, bindings.Min("visibilityTimeout", *t.VisibilityTimeout, 0))
+		errs = append(errs, bindings.Max("visibilityTimeout", *t.VisibilityTimeout, 43200))
+	}
+	if t.MessageRetentionPeriod != nil {
+		errs = append(errs, bindings.Min("messageRetentionPeriod", *t.MessageRetentionPeriod, 60))
+		errs = append(errs, bindings.Max("messageRetentionPeriod", *t.MessageRetentionPeriod, 1209600))
+	}
+	if t.RedrivePolicy != nil {
+		errs = append(errs, bindings.Nest("redrivePolicy", t.RedrivePolicy.Validate()))
+	}
+	if t.Policy != nil {
+		errs = append(errs, bindings.Nest("policy", t.Policy.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the fields of RedrivePolicy, returning a
+// *bindings.FieldError for each invalid field
+func (t RedrivePolicy) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("deadLetterQueue", t.DeadLetterQueue != nil))
+	if t.DeadLetterQueue != nil {
+		errs = append(errs, bindings.Nest("deadLetterQueue", t.DeadLetterQueue.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the fields of Identifier, returning a
+// *bindings.FieldError for each invalid field
+func (t Identifier) Validate() error {
+	return nil
+}
+
+// Validate checks the fields of Policy, returning a
+// *bindings.FieldError for each invalid field
+func (t Policy) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("statements", len(t.Statements) > 0))
+	for i, item := range t.Statements {
+		errs = append(errs, bindings.Nest(bindings.Index("statements", i), item.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the fields of Statement, returning a
+// *bindings.FieldError for each invalid field
+func (t Statement) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("effect", t.Effect != ""))
+	errs = append(errs, bindings.Enum("effect", t.Effect, "Allow", "Deny"))
+	errs = append(errs, bindings.Required("principal", t.Principal != nil))
+	errs = append(errs, bindings.Required("action", t.Action != nil))
+	return errors.Join(errs...)
+}
+
+// NewOperationBinding creates a new OperationBinding object
+func NewOperationBinding() *OperationBinding {
+	return &OperationBinding{
+		BindingVersion: "latest",
+	}
+}
+
+// WithQueues sets the 'queues' field of OperationBinding
+func (obj *OperationBinding) WithQueues(queues []Queue) *OperationBinding {
+	obj.Queues = queues
+	return obj
+}
+
+// WithBindingVersion sets the 'bindingVersion' field of OperationBinding
+func (obj *OperationBinding) WithBindingVersion(bindingVersion string) *OperationBinding {
+	obj.BindingVersion = bindingVersion
+	return obj
+}
+
+// MarshalYAML is a custom marshaller that converts OperationBinding to YAML
+func (t OperationBinding) MarshalYAML() (interface{}, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = yaml.Unmarshal(bytes, &out)
+	return out, err
+}
+
+// UnmarshalYAML is a custom unmarshaler that converts YAML to OperationBinding
+func (t *OperationBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var temp interface{}
+	if err := unmarshal(&temp); err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(temp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, t)
+}
+
+// MarshalJSON is a custom marshaller that converts OperationBinding to JSON
+func (t OperationBinding) MarshalJSON() ([]byte, error) {
+	type Alias OperationBinding
+	return json.Marshal(struct{ Alias }{Alias(t)})
+}
 
+// UnmarshalJSON is a custom unmarshaler that converts JSON to OperationBinding
+func (t *OperationBinding) UnmarshalJSON(data []byte) error {
+	type Alias OperationBinding
+	aux := struct{ *Alias }{Alias: (*Alias)(t)}
+	return jsonnumber.Unmarshal(data, &aux)
+}
+
+// Validate checks the fields of OperationBinding, returning a
+// *bindings.FieldError for each invalid field
+func (t OperationBinding) Validate() error {
+	var errs []error
+	errs = append(errs, bindings.Required("queues", len(t.Queues) > 0))
+	for i, item := range t.Queues {
+		errs = append(errs, bindings.Nest(bindings.Index("queues", i), item.Validate()))
+	}
+	return errors.Join(errs...)
+}
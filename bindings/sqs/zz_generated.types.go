@@ -0,0 +1,381 @@
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// THIS FILE IS GENERATED. DO NOT EDIT
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// The structs in this file are generated from the binding JSON
+// Schemas in ../../internal/validation/bindings/sqs/0.3.0.
+// To update them, update the schemas and run `make generate` to
+// re-gen this file.
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+package sqs
+
+import (
+	"encoding/json"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+)
+
+// ChannelBinding represents the SQS Channel Binding object.
+// This object contains information about the channel representation in SQS.
+// +binding
+type ChannelBinding struct {
+	// A definition of the queue that will be used as the channel.
+	// +binding:required
+	Queue *Queue `json:"queue"`
+	// A definition of the queue that will be used for un-processable messages.
+	DeadLetterQueue *Queue `json:"deadLetterQueue,omitempty"`
+	// The version of this binding. If omitted, 'latest' MUST be assumed.
+	BindingVersion string `json:"bindingVersion,omitempty" default:"\"latest\""`
+}
+
+// Queue represents the SQS queue object.
+// A definition of a queue.
+type Queue struct {
+	// The name of the queue. When an SNS Operation Binding Object references an
+	// SQS queue by name, the identifier should be the one in this field.
+	// +binding:required
+	Name string `json:"name"`
+	// Is this a FIFO queue?
+	FifoQueue bool `json:"fifoQueue,omitempty"`
+	// Specifies whether message deduplication occurs at the message group or
+	// queue level. Valid values are messageGroup and queue (default).
+	// +binding:enum=queue;messageGroup
+	DeduplicationScope string `json:"deduplicationScope,omitempty"`
+	// Specifies whether the FIFO queue throughput quota applies to the entire
+	// queue or per message group. Valid values are perQueue (default) and
+	// perMessageGroupId.
+	// +binding:enum=perQueue;perMessageGroupId
+	FifoThroughputLimit string `json:"fifoThroughputLimit,omitempty"`
+	// The number of seconds to delay before a message sent to the queue can be
+	// received. used to create a delay queue.
+	// +binding:min=0
+	// +binding:max=15
+	DeliveryDelay int `json:"deliveryDelay,omitempty"`
+	// The length of time, in seconds, that a consumer locks a message - hiding
+	// it from reads - before it is unlocked and can be read again.
+	// +binding:min=0
+	// +binding:max=43200
+	VisibilityTimeout *int `json:"visibilityTimeout,omitempty"`
+	// Determines if the queue uses short polling or long polling. Set to zero
+	// the queue reads available messages and returns immediately. Set to a
+	// non-zero integer, long polling waits the specified number of seconds for
+	// messages to arrive before returning.
+	ReceiveMessageWaitTime int `json:"receiveMessageWaitTime,omitempty"`
+	// How long to retain a message on the queue in seconds, unless deleted.
+	// +binding:min=60
+	// +binding:max=1209600
+	MessageRetentionPeriod *int `json:"messageRetentionPeriod,omitempty"`
+	// Prevent poison pill messages by moving un-processable messages to an SQS
+	// dead letter queue.
+	RedrivePolicy *RedrivePolicy `json:"redrivePolicy,omitempty"`
+	// The security policy for the SQS Queue
+	Policy *Policy `json:"policy,omitempty"`
+	// Key-value pairs that represent AWS tags on the queue.
+	Tags map[string]interface{} `json:"tags,omitempty"`
+	// Allows for an external definition of a queue. The referenced structure
+	// MUST be in the format of a Queue. If there are conflicts between the
+	// referenced definition and this Queue's definition, the behavior is
+	// undefined.
+	Ref string `json:"$ref,omitempty"`
+	// AdditionalProperties holds the fields the schema doesn't define, such
+	// as specification extensions
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// RedrivePolicy represents the SQS redrivePolicy object.
+// Prevent poison pill messages by moving un-processable messages to an SQS
+// dead letter queue.
+type RedrivePolicy struct {
+	// The SQS queue to use as a dead letter queue (DLQ).
+	// +binding:required
+	DeadLetterQueue *Identifier `json:"deadLetterQueue"`
+	// The number of times a message is delivered to the source queue before
+	// being moved to the dead-letter queue.
+	MaxReceiveCount *int `json:"maxReceiveCount,omitempty"`
+	// AdditionalProperties holds the fields the schema doesn't define, such
+	// as specification extensions
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Identifier represents the SQS identifier object.
+// The SQS queue to use as a dead letter queue (DLQ).
+type Identifier struct {
+	// The target is an ARN. For example, for SQS, the identifier may be an ARN,
+	// which will be of the form: arn:aws:sqs:{region}:{account-id}:{queueName}
+	ARN string `json:"arn,omitempty"`
+	// The endpoint is identified by a name, which corresponds to an identifying
+	// field called 'name' of a binding for that protocol on this publish
+	// Operation Object. For example, if the protocol is 'sqs' then the name
+	// refers to the name field sqs binding.
+	Name string `json:"name,omitempty"`
+	// AdditionalProperties holds the fields the schema doesn't define, such
+	// as specification extensions
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Policy represents the SQS policy object.
+// The security policy for the SQS Queue
+type Policy struct {
+	// An array of statement objects, each of which controls a permission for
+	// this queue.
+	// +binding:required
+	Statements []Statement `json:"statements"`
+	// AdditionalProperties holds the fields the schema doesn't define, such
+	// as specification extensions
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Statement represents the SQS statement object.
+type Statement struct {
+	// +binding:required
+	// +binding:enum=Allow;Deny
+	Effect string `json:"effect"`
+	// The AWS account(s) or resource ARN(s) that this statement applies to.
+	// +binding:required
+	Principal interface{} `json:"principal"`
+	// The SQS permission(s) being allowed or denied e.g. sqs:ReceiveMessage
+	// +binding:required
+	Action interface{} `json:"action"`
+	// The resource(s) that this policy applies to.
+	Resource interface{} `json:"resource,omitempty"`
+	// Specific circumstances under which the policy grants permission
+	Condition map[string]interface{} `json:"condition,omitempty"`
+	// AdditionalProperties holds the fields the schema doesn't define, such
+	// as specification extensions
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// OperationBinding represents the SQS Operation Binding object.
+// This object contains information about the operation representation in SQS.
+// +binding
+type OperationBinding struct {
+	// Queue objects that are either the endpoint for an SNS Operation Binding
+	// Object, or the deadLetterQueue of the SQS Operation Binding Object.
+	// +binding:required
+	Queues []Queue `json:"queues"`
+	// The version of this binding. If omitted, 'latest' MUST be assumed.
+	BindingVersion string `json:"bindingVersion,omitempty" default:"\"latest\""`
+}
+
+// QueueDeduplicationScope represents the values of Queue.DeduplicationScope
+const (
+	QueueDeduplicationScopeQueue        = "queue"
+	QueueDeduplicationScopeMessageGroup = "messageGroup"
+)
+
+// QueueFifoThroughputLimit represents the values of Queue.FifoThroughputLimit
+const (
+	QueueFifoThroughputLimitPerQueue          = "perQueue"
+	QueueFifoThroughputLimitPerMessageGroupID = "perMessageGroupId"
+)
+
+// StatementEffect represents the values of Statement.Effect
+const (
+	StatementEffectAllow = "Allow"
+	StatementEffectDeny  = "Deny"
+)
+
+// MarshalJSON is a custom marshaller that converts Queue to JSON,
+// including its AdditionalProperties
+func (t Queue) MarshalJSON() ([]byte, error) {
+	type Alias Queue
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.AdditionalProperties) == 0 {
+		return data, err
+	}
+	m := make(map[string]interface{})
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range t.AdditionalProperties {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to Queue,
+// keeping the fields it doesn't define in AdditionalProperties
+func (t *Queue) UnmarshalJSON(data []byte) error {
+	type Alias Queue
+	if err := jsonnumber.Unmarshal(data, (*Alias)(t)); err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for _, k := range []string{"name", "fifoQueue", "deduplicationScope", "fifoThroughputLimit", "deliveryDelay", "visibilityTimeout", "receiveMessageWaitTime", "messageRetentionPeriod", "redrivePolicy", "policy", "tags", "$ref"} {
+		delete(m, k)
+	}
+	t.AdditionalProperties = nil
+	if len(m) > 0 {
+		t.AdditionalProperties = m
+	}
+	return nil
+}
+
+// MarshalJSON is a custom marshaller that converts RedrivePolicy to JSON,
+// including its AdditionalProperties
+func (t RedrivePolicy) MarshalJSON() ([]byte, error) {
+	type Alias RedrivePolicy
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.AdditionalProperties) == 0 {
+		return data, err
+	}
+	m := make(map[string]interface{})
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range t.AdditionalProperties {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to RedrivePolicy,
+// keeping the fields it doesn't define in AdditionalProperties
+func (t *RedrivePolicy) UnmarshalJSON(data []byte) error {
+	type Alias RedrivePolicy
+	if err := jsonnumber.Unmarshal(data, (*Alias)(t)); err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for _, k := range []string{"deadLetterQueue", "maxReceiveCount"} {
+		delete(m, k)
+	}
+	t.AdditionalProperties = nil
+	if len(m) > 0 {
+		t.AdditionalProperties = m
+	}
+	return nil
+}
+
+// MarshalJSON is a custom marshaller that converts Identifier to JSON,
+// including its AdditionalProperties
+func (t Identifier) MarshalJSON() ([]byte, error) {
+	type Alias Identifier
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.AdditionalProperties) == 0 {
+		return data, err
+	}
+	m := make(map[string]interface{})
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range t.AdditionalProperties {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to Identifier,
+// keeping the fields it doesn't define in AdditionalProperties
+func (t *Identifier) UnmarshalJSON(data []byte) error {
+	type Alias Identifier
+	if err := jsonnumber.Unmarshal(data, (*Alias)(t)); err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for _, k := range []string{"arn", "name"} {
+		delete(m, k)
+	}
+	t.AdditionalProperties = nil
+	if len(m) > 0 {
+		t.AdditionalProperties = m
+	}
+	return nil
+}
+
+// MarshalJSON is a custom marshaller that converts Policy to JSON,
+// including its AdditionalProperties
+func (t Policy) MarshalJSON() ([]byte, error) {
+	type Alias Policy
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.AdditionalProperties) == 0 {
+		return data, err
+	}
+	m := make(map[string]interface{})
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range t.AdditionalProperties {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to Policy,
+// keeping the fields it doesn't define in AdditionalProperties
+func (t *Policy) UnmarshalJSON(data []byte) error {
+	type Alias Policy
+	if err := jsonnumber.Unmarshal(data, (*Alias)(t)); err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for _, k := range []string{"statements"} {
+		delete(m, k)
+	}
+	t.AdditionalProperties = nil
+	if len(m) > 0 {
+		t.AdditionalProperties = m
+	}
+	return nil
+}
+
+// MarshalJSON is a custom marshaller that converts Statement to JSON,
+// including its AdditionalProperties
+func (t Statement) MarshalJSON() ([]byte, error) {
+	type Alias Statement
+	data, err := json.Marshal(Alias(t))
+	if err != nil || len(t.AdditionalProperties) == 0 {
+		return data, err
+	}
+	m := make(map[string]interface{})
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range t.AdditionalProperties {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom unmarshaler that converts JSON to Statement,
+// keeping the fields it doesn't define in AdditionalProperties
+func (t *Statement) UnmarshalJSON(data []byte) error {
+	type Alias Statement
+	if err := jsonnumber.Unmarshal(data, (*Alias)(t)); err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := jsonnumber.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for _, k := range []string{"effect", "principal", "action", "resource", "condition"} {
+		delete(m, k)
+	}
+	t.AdditionalProperties = nil
+	if len(m) > 0 {
+		t.AdditionalProperties = m
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package bindings
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Validator is implemented by bindings and the structs nested in them. The
+// generated Validate methods return the joined *FieldError of every invalid
+// field, or nil if the binding is valid.
+type Validator interface {
+	Validate() error
+}
+
+// FieldError is an invalid field of a binding
+type FieldError struct {
+	// Path is the path of the field within the binding, made of JSON field
+	// names and array indexes such as queues[0].name
+	Path    string
+	Message string
+}
+
+// Error implements error.
+func (e *FieldError) Error() string {
+	return e.Path + " " + e.Message
+}
+
+// Required returns a *FieldError for a required field that isn't set
+func Required(path string, set bool) error {
+	if set {
+		return nil
+	}
+	return &FieldError{Path: path, Message: "is required"}
+}
+
+// Enum returns a *FieldError if a field's value isn't one of values. An
+// empty value is left to Required.
+func Enum(path, value string, values ...string) error {
+	if value == "" || slices.Contains(values, value) {
+		return nil
+	}
+	return &FieldError{Path: path, Message: fmt.Sprintf("must be one of %s, got %q", strings.Join(values, ", "), value)}
+}
+
+// Min returns a *FieldError if a field's value is less than limit
+func Min[T cmp.Ordered](path string, value, limit T) error {
+	if value >= limit {
+		return nil
+	}
+	return &FieldError{Path: path, Message: fmt.Sprintf("must be at least %v, got %v", limit, value)}
+}
+
+// Max returns a *FieldError if a field's value is greater than limit
+func Max[T cmp.Ordered](path string, value, limit T) error {
+	if value <= limit {
+		return nil
+	}
+	return &FieldError{Path: path, Message: fmt.Sprintf("must be at most %v, got %v", limit, value)}
+}
+
+// Index returns the path of an item of an array field
+func Index(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// SortedKeys returns the keys of a map field in order, so its values are
+// validated in the same order every time
+func SortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Nest prefixes the paths of the errors returned by a nested struct's
+// Validate with the path of the field holding the struct
+func Nest(path string, err error) error {
+	var nested []error
+	for _, err := range flatten(err) {
+		fieldErr, ok := err.(*FieldError)
+		if !ok {
+			nested = append(nested, &FieldError{Path: path, Message: err.Error()})
+			continue
+		}
+		fieldPath := path + "." + fieldErr.Path
+		if strings.HasPrefix(fieldErr.Path, "[") {
+			fieldPath = path + fieldErr.Path
+		}
+		nested = append(nested, &FieldError{Path: fieldPath, Message: fieldErr.Message})
+	}
+	return errors.Join(nested...)
+}
+
+// flatten returns the errors joined in err, including those joined in them
+func flatten(err error) []error {
+	if err == nil {
+		return nil
+	}
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{err}
+	}
+	var errs []error
+	for _, err := range joined.Unwrap() {
+		errs = append(errs, flatten(err)...)
+	}
+	return errs
+}
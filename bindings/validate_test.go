@@ -0,0 +1,55 @@
+package bindings_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequired(t *testing.T) {
+	assert.NoError(t, bindings.Required("name", true))
+	assert.EqualError(t, bindings.Required("name", false), "name is required")
+}
+
+func TestEnum(t *testing.T) {
+	assert.NoError(t, bindings.Enum("is", "queue", "routingKey", "queue"))
+	assert.NoError(t, bindings.Enum("is", "", "routingKey", "queue"))
+	assert.EqualError(t, bindings.Enum("is", "topic", "routingKey", "queue"), `is must be one of routingKey, queue, got "topic"`)
+}
+
+func TestMinMax(t *testing.T) {
+	assert.NoError(t, bindings.Min("deliveryMode", 1, 1))
+	assert.EqualError(t, bindings.Min("deliveryMode", 0, 1), "deliveryMode must be at least 1, got 0")
+	assert.NoError(t, bindings.Max("deliveryMode", 2, 2))
+	assert.EqualError(t, bindings.Max("deliveryMode", 3, 2), "deliveryMode must be at most 2, got 3")
+	assert.EqualError(t, bindings.Min("ratio", 0.25, 0.5), "ratio must be at least 0.5, got 0.25")
+}
+
+func TestNest(t *testing.T) {
+	assert.NoError(t, bindings.Nest("queue", nil))
+
+	err := bindings.Nest("queues", errors.Join(
+		bindings.Nest(bindings.Index("", 1), bindings.Required("name", false)),
+		errors.New("broken"),
+	))
+	require.Error(t, err)
+
+	var fieldErrs []*bindings.FieldError
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var fieldErr *bindings.FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		fieldErrs = append(fieldErrs, fieldErr)
+	}
+	assert.Equal(t, []*bindings.FieldError{
+		{Path: "queues[1].name", Message: "is required"},
+		{Path: "queues", Message: "broken"},
+	}, fieldErrs)
+}
+
+func TestSortedKeys(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, bindings.SortedKeys(map[string]int{"c": 3, "a": 1, "b": 2}))
+	assert.Empty(t, bindings.SortedKeys(map[string]int(nil)))
+}
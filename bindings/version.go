@@ -0,0 +1,231 @@
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+	"github.com/charlie-haley/asyncapi-go/spec"
+)
+
+// LatestVersion is the bindingVersion value that explicitly requests the
+// latest version
+const LatestVersion = "latest"
+
+// Versions describes the binding versions a protocol's binding can be
+// decoded from, and how its fields changed between them
+type Versions struct {
+	// Supported lists the binding versions from oldest to newest. The last is
+	// the latest, and the version of bindings that omit bindingVersion.
+	Supported []string
+	// Fields lists the fields that aren't part of every supported version
+	Fields []Field
+	// Migrations change a binding from one version to the next, for changes
+	// that aren't just added or removed fields such as renames
+	Migrations []Migration
+}
+
+// Field is a field that was added or removed in a binding version
+type Field struct {
+	// Path is the slash separated path of the field, such as exchange/vhost.
+	// A * segment matches every item of an array.
+	Path string
+	// Added is the first version defining the field, or empty if every
+	// version up to Removed does
+	Added string
+	// Removed is the first version that no longer defines the field, or
+	// empty if it's still defined by the latest
+	Removed string
+}
+
+// Migration upgrades a binding from a version to the one after it
+type Migration struct {
+	// From is the version the migration upgrades from
+	From string
+	// Migrate changes the binding in place. Fields removed by the next
+	// version are deleted after it's called, and bindingVersion is set.
+	Migrate func(binding map[string]any) error
+}
+
+// VersionError is returned with a decoded binding when the binding has
+// fields its version doesn't define. Those fields are left out of the
+// decoded binding.
+type VersionError struct {
+	Protocol string
+	Kind     Kind
+	Version  string
+	// Fields holds a JSON pointer to each field within the binding
+	Fields []string
+}
+
+// Error implements error.
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("%s %s binding version %s doesn't define %s", e.Protocol, e.Kind, e.Version, strings.Join(e.Fields, ", "))
+}
+
+// RegisterVersions sets the binding versions of a protocol's bindings for a
+// kind of object. It panics if a field or migration refers to a version
+// that isn't supported.
+func (r *Registry) RegisterVersions(protocol string, kind Kind, versions Versions) {
+	if len(versions.Supported) == 0 {
+		panic(fmt.Sprintf("bindings: no supported versions for %s %s binding", protocol, kind))
+	}
+	check := func(version string) {
+		if version != "" && !slices.Contains(versions.Supported, version) {
+			panic(fmt.Sprintf("bindings: %s %s binding version %s isn't supported", protocol, kind, version))
+		}
+	}
+	for _, field := range versions.Fields {
+		check(field.Added)
+		check(field.Removed)
+	}
+	for _, migration := range versions.Migrations {
+		check(migration.From)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.versions[registryKey{protocol, kind}] = versions
+}
+
+// LookupVersions returns the binding versions registered for a protocol and
+// kind
+func (r *Registry) LookupVersions(protocol string, kind Kind) (Versions, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	versions, ok := r.versions[registryKey{protocol, kind}]
+	return versions, ok
+}
+
+// Migrate upgrades a raw binding to the latest version registered for its
+// protocol and kind, returning a copy with bindingVersion set to the latest.
+// Fields its declared version doesn't define are left out, and reported by
+// returning a *VersionError along with the migrated binding.
+func (r *Registry) Migrate(protocol string, kind Kind, raw any) (map[string]any, error) {
+	versions, ok := r.LookupVersions(protocol, kind)
+	if !ok {
+		return nil, fmt.Errorf("no versions registered for %s %s binding", protocol, kind)
+	}
+	binding, version, versionErr, err := prepare(protocol, kind, versions, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := slices.Index(versions.Supported, version); i < len(versions.Supported)-1; i++ {
+		from, to := versions.Supported[i], versions.Supported[i+1]
+		for _, migration := range versions.Migrations {
+			if migration.From != from {
+				continue
+			}
+			if err := migration.Migrate(binding); err != nil {
+				return nil, fmt.Errorf("failed to migrate %s %s binding from version %s to %s: %w", protocol, kind, from, to, err)
+			}
+		}
+		for _, field := range versions.Fields {
+			if field.Removed == to {
+				walkField(binding, strings.Split(field.Path, "/"), nil, func(parent map[string]any, key string, _ []string) {
+					delete(parent, key)
+				})
+			}
+		}
+	}
+	binding["bindingVersion"] = versions.Supported[len(versions.Supported)-1]
+
+	if versionErr != nil {
+		return binding, versionErr
+	}
+	return binding, nil
+}
+
+// prepare copies a raw binding, checking its bindingVersion is supported and
+// removing the fields that version doesn't define. It returns the copy along
+// with the effective version and a *VersionError for the removed fields.
+func prepare(protocol string, kind Kind, versions Versions, raw any) (map[string]any, string, *VersionError, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("failed to marshal %s %s binding: %w", protocol, kind, err)
+	}
+	var binding map[string]any
+	if err := jsonnumber.Unmarshal(data, &binding); err != nil {
+		return nil, "", nil, fmt.Errorf("failed to unmarshal %s %s binding: %w", protocol, kind, err)
+	}
+	if binding == nil {
+		binding = map[string]any{}
+	}
+
+	version, _ := binding["bindingVersion"].(string)
+	if version == "" || version == LatestVersion {
+		version = versions.Supported[len(versions.Supported)-1]
+	}
+	index := slices.Index(versions.Supported, version)
+	if index < 0 {
+		return nil, "", nil, fmt.Errorf("unsupported %s %s binding version %q, expected one of %v", protocol, kind, version, versions.Supported)
+	}
+
+	var invalid []string
+	for _, field := range versions.Fields {
+		if field.defines(versions.Supported, index) {
+			continue
+		}
+		walkField(binding, strings.Split(field.Path, "/"), nil, func(parent map[string]any, key string, path []string) {
+			invalid = append(invalid, spec.JSONPointer(path...))
+			delete(parent, key)
+		})
+	}
+	if len(invalid) == 0 {
+		return binding, version, nil, nil
+	}
+	slices.Sort(invalid)
+	return binding, version, &VersionError{Protocol: protocol, Kind: kind, Version: version, Fields: invalid}, nil
+}
+
+// defines reports whether the supported version at index defines the field
+func (f Field) defines(supported []string, index int) bool {
+	if f.Added != "" && index < slices.Index(supported, f.Added) {
+		return false
+	}
+	return f.Removed == "" || index < slices.Index(supported, f.Removed)
+}
+
+// walkField calls fn with the object holding every value at a path, the
+// value's key and its full path
+func walkField(value any, path []string, at []string, fn func(parent map[string]any, key string, path []string)) {
+	switch v := value.(type) {
+	case map[string]any:
+		if path[0] == "*" {
+			return
+		}
+		child, ok := v[path[0]]
+		if !ok {
+			return
+		}
+		at = append(slices.Clip(at), path[0])
+		if len(path) == 1 {
+			fn(v, path[0], at)
+			return
+		}
+		walkField(child, path[1:], at, fn)
+	case []any:
+		if path[0] != "*" || len(path) == 1 {
+			return
+		}
+		for i, item := range v {
+			walkField(item, path[1:], append(slices.Clip(at), strconv.Itoa(i)), fn)
+		}
+	}
+}
+
+// RegisterVersions sets the binding versions of a protocol's bindings in
+// the default registry
+func RegisterVersions(protocol string, kind Kind, versions Versions) {
+	Default.RegisterVersions(protocol, kind, versions)
+}
+
+// Migrate upgrades a raw binding to the latest version with the default
+// registry
+func Migrate(protocol string, kind Kind, raw any) (map[string]any, error) {
+	return Default.Migrate(protocol, kind, raw)
+}
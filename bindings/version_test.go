@@ -0,0 +1,132 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/bindings"
+	"github.com/charlie-haley/asyncapi-go/bindings/amqp"
+	"github.com/charlie-haley/asyncapi-go/bindings/kafka"
+	"github.com/charlie-haley/asyncapi-go/bindings/solace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type routeBinding struct {
+	Path           string   `json:"path"`
+	Hops           []string `json:"hops"`
+	BindingVersion string   `json:"bindingVersion"`
+}
+
+func newRouteRegistry() *bindings.Registry {
+	r := bindings.NewRegistry()
+	r.Register("route", bindings.Channel, func() any { return &routeBinding{} })
+	r.RegisterVersions("route", bindings.Channel, bindings.Versions{
+		Supported: []string{"0.1.0", "0.2.0", "0.3.0"},
+		Fields: []bindings.Field{
+			{Path: "route", Removed: "0.2.0"},
+			{Path: "path", Added: "0.2.0"},
+			{Path: "hops", Added: "0.3.0"},
+			{Path: "stops/*/name", Added: "0.3.0"},
+		},
+		Migrations: []bindings.Migration{
+			{From: "0.1.0", Migrate: func(binding map[string]any) error {
+				binding["path"] = binding["route"]
+				return nil
+			}},
+		},
+	})
+	return r
+}
+
+func TestRegistry_DecodeVersion(t *testing.T) {
+	r := newRouteRegistry()
+
+	decoded, err := r.Decode("route", bindings.Channel, map[string]any{
+		"path":           "north",
+		"hops":           []any{"a", "b"},
+		"bindingVersion": "0.2.0",
+	})
+	var versionErr *bindings.VersionError
+	require.True(t, errors.As(err, &versionErr))
+	assert.Equal(t, []string{"/hops"}, versionErr.Fields)
+	assert.EqualError(t, err, "route channel binding version 0.2.0 doesn't define /hops")
+	assert.Equal(t, &routeBinding{Path: "north", BindingVersion: "0.2.0"}, decoded)
+
+	// Omitted and "latest" versions are decoded as the latest
+	for _, version := range []any{nil, bindings.LatestVersion} {
+		raw := map[string]any{"path": "north", "hops": []any{"a"}}
+		if version != nil {
+			raw["bindingVersion"] = version
+		}
+		decoded, err = r.Decode("route", bindings.Channel, raw)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, decoded.(*routeBinding).Hops)
+	}
+
+	_, err = r.Decode("route", bindings.Channel, map[string]any{"bindingVersion": "9.9.9"})
+	assert.EqualError(t, err, `unsupported route channel binding version "9.9.9", expected one of [0.1.0 0.2.0 0.3.0]`)
+}
+
+func TestRegistry_Migrate(t *testing.T) {
+	r := newRouteRegistry()
+	raw := map[string]any{
+		"route":          "north",
+		"stops":          []any{map[string]any{"name": "a"}, map[string]any{"name": "b"}},
+		"bindingVersion": "0.1.0",
+	}
+
+	migrated, err := r.Migrate("route", bindings.Channel, raw)
+	var versionErr *bindings.VersionError
+	require.True(t, errors.As(err, &versionErr))
+	assert.Equal(t, []string{"/stops/0/name", "/stops/1/name"}, versionErr.Fields)
+	assert.Equal(t, map[string]any{
+		"path":           "north",
+		"stops":          []any{map[string]any{}, map[string]any{}},
+		"bindingVersion": "0.3.0",
+	}, migrated)
+
+	// The raw binding isn't changed
+	assert.Equal(t, "north", raw["route"])
+
+	_, err = r.Migrate("unknown", bindings.Channel, raw)
+	assert.EqualError(t, err, "no versions registered for unknown channel binding")
+}
+
+func TestRegistry_RegisterVersions_Unsupported(t *testing.T) {
+	assert.Panics(t, func() {
+		bindings.NewRegistry().RegisterVersions("route", bindings.Channel, bindings.Versions{
+			Supported: []string{"0.1.0"},
+			Fields:    []bindings.Field{{Path: "path", Added: "0.2.0"}},
+		})
+	})
+}
+
+func TestDefault_Versions(t *testing.T) {
+	// topicConfiguration was added to the kafka channel binding in 0.4.0
+	decoded, err := bindings.Decode(kafka.Protocol, bindings.Channel, map[string]any{
+		"topic":              "user-signedup",
+		"topicConfiguration": map[string]any{"retention.ms": json.Number("1000")},
+		"bindingVersion":     "0.3.0",
+	})
+	assert.EqualError(t, err, "kafka channel binding version 0.3.0 doesn't define /topicConfiguration")
+	assert.Equal(t, &kafka.ChannelBinding{Topic: "user-signedup", BindingVersion: "0.3.0"}, decoded)
+
+	// replyTo was removed from the amqp operation binding in 0.3.0
+	migrated, err := bindings.Migrate(amqp.Protocol, bindings.Operation, map[string]any{
+		"replyTo":        "user.signedup.reply",
+		"priority":       json.Number("10"),
+		"bindingVersion": "0.2.0",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"priority": json.Number("10"), "bindingVersion": amqp.BindingVersion}, migrated)
+
+	// msgVpn was misspelled in the solace server binding before 0.3.0
+	migrated, err = bindings.Migrate(solace.Protocol, bindings.Server, map[string]any{
+		"msvVpn":         "ProdVPN",
+		"bindingVersion": "0.2.0",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"msgVpn": "ProdVPN", "bindingVersion": solace.BindingVersion}, migrated)
+}
@@ -0,0 +1,16 @@
+package websockets
+
+import "github.com/charlie-haley/asyncapi-go/bindings"
+
+//go:generate go run github.com/charlie-haley/asyncapi-go/cmd/bindingsgen
+
+// Protocol is the key websockets bindings appear under in a bindings object
+const Protocol = "ws"
+
+const BindingVersion = "0.1.0"
+
+func init() {
+	bindings.Register(Protocol, bindings.Channel, func() any { return &ChannelBinding{} })
+
+	bindings.RegisterVersions(Protocol, bindings.Channel, bindings.Versions{Supported: []string{BindingVersion}})
+}
@@ -0,0 +1,22 @@
+package websockets
+
+// ChannelBinding represents the WebSockets Channel Binding object.
+//
+// When using WebSockets, the channel represents the connection, so this object
+// describes the HTTP request that establishes it. Query and Headers hold
+// Schema Objects of type object, with a property for each query parameter or
+// header.
+// +binding
+type ChannelBinding struct {
+	// +binding:enum=GET;POST
+	Method         string      `json:"method,omitempty"`
+	Query          interface{} `json:"query,omitempty"`
+	Headers        interface{} `json:"headers,omitempty"`
+	BindingVersion string      `json:"bindingVersion,omitempty"`
+}
+
+// ChannelMethod represents the HTTP methods a connection can be established with.
+const (
+	ChannelMethodGet  = "GET"
+	ChannelMethodPost = "POST"
+)
@@ -0,0 +1,87 @@
+package websockets
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestChannelBinding_BuildObject(t *testing.T) {
+	cb := NewChannelBinding().
+		WithMethod(ChannelMethodGet).
+		WithQuery(map[string]interface{}{"type": "object", "properties": map[string]interface{}{"token": map[string]interface{}{"type": "string"}}}).
+		WithHeaders(map[string]interface{}{"type": "object"})
+
+	assert.Equal(t, ChannelMethodGet, cb.Method)
+	assert.Equal(t, map[string]interface{}{"type": "object", "properties": map[string]interface{}{"token": map[string]interface{}{"type": "string"}}}, cb.Query)
+	assert.Equal(t, map[string]interface{}{"type": "object"}, cb.Headers)
+}
+
+func TestChannelBinding_MarshalYAML(t *testing.T) {
+	cb := NewChannelBinding().
+		WithMethod(ChannelMethodPost).
+		WithQuery(map[string]interface{}{"type": "object"}).
+		WithBindingVersion(BindingVersion)
+
+	expectedYAML := `bindingVersion: 0.1.0
+method: POST
+query:
+  type: object
+`
+	marshaledYAML, err := yaml.Marshal(cb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedYAML, string(marshaledYAML))
+}
+
+func TestChannelBinding_UnmarshalYAML(t *testing.T) {
+	yamlString := `
+method: GET
+headers:
+  type: object
+  properties:
+    Authorization:
+      type: string
+`
+	var cb ChannelBinding
+	err := yaml.Unmarshal([]byte(yamlString), &cb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, ChannelMethodGet, cb.Method)
+	assert.Equal(t, map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{"Authorization": map[string]interface{}{"type": "string"}},
+	}, cb.Headers)
+}
+
+func TestChannelBinding_MarshalJSON(t *testing.T) {
+	cb := NewChannelBinding().
+		WithMethod(ChannelMethodGet).
+		WithHeaders(map[string]interface{}{"type": "object"})
+
+	expectedJSON := `{"method":"GET","headers":{"type":"object"}}`
+
+	marshaledJSON, err := json.Marshal(cb)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJSON, string(marshaledJSON))
+}
+
+func TestChannelBinding_UnmarshalJSON(t *testing.T) {
+	jsonString := `{
+		"method": "GET",
+		"query": {"type": "object", "properties": {"limit": {"type": "integer", "maximum": 100}}},
+		"bindingVersion": "0.1.0"
+	}`
+
+	var cb ChannelBinding
+	err := json.Unmarshal([]byte(jsonString), &cb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, ChannelMethodGet, cb.Method)
+	assert.Equal(t, map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{"limit": map[string]interface{}{"type": "integer", "maximum": json.Number("100")}},
+	}, cb.Query)
+	assert.Equal(t, BindingVersion, cb.BindingVersion)
+}
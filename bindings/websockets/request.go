@@ -0,0 +1,293 @@
+package websockets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charlie-haley/asyncapi-go/schemaformat"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Part identifies which part of a request a FieldError refers to
+type Part string
+
+const (
+	PartMethod  Part = "method"
+	PartQuery   Part = "query"
+	PartHeaders Part = "headers"
+)
+
+// FieldError describes a single violation in an upgrade request
+type FieldError struct {
+	// Part is the part of the request that failed validation
+	Part Part `json:"part"`
+	// Path is a JSON pointer to the offending value within the part
+	Path string `json:"path"`
+	// Message is a human readable description of the violation
+	Message string `json:"message"`
+}
+
+// String formats the error as "part/path: message"
+func (e FieldError) String() string {
+	return fmt.Sprintf("%s%s: %s", e.Part, e.Path, e.Message)
+}
+
+// ValidationError is returned when an upgrade request does not match its
+// channel binding
+type ValidationError struct {
+	Errors []FieldError `json:"errors"`
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, fieldErr := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("- %s", fieldErr))
+	}
+	return fmt.Sprintf("request validation failed:\n%s", strings.Join(msgs, "\n"))
+}
+
+// RequestValidator validates the requests that establish a WebSocket
+// connection against a channel binding. Its schemas are compiled once, and
+// it's safe for concurrent use.
+type RequestValidator struct {
+	method  string
+	query   *schemaFields
+	headers *schemaFields
+}
+
+// schemaFields is a compiled query or headers schema
+type schemaFields struct {
+	compiled *schemaformat.JSONSchemaDocument
+	// properties holds the schema of each property, by name
+	properties map[string]any
+}
+
+// NewRequestValidator compiles the query and headers schemas of a binding
+func NewRequestValidator(binding *ChannelBinding) (*RequestValidator, error) {
+	if binding == nil {
+		return nil, errors.New("binding is nil")
+	}
+
+	v := &RequestValidator{method: binding.Method}
+	var err error
+	if v.query, err = compileFields(binding.Query); err != nil {
+		return nil, fmt.Errorf("failed to compile %s schema: %w", PartQuery, err)
+	}
+	if v.headers, err = compileFields(binding.Headers); err != nil {
+		return nil, fmt.Errorf("failed to compile %s schema: %w", PartHeaders, err)
+	}
+	return v, nil
+}
+
+func compileFields(schema any) (*schemaFields, error) {
+	if schema == nil {
+		return nil, nil
+	}
+	compiled, err := schemaformat.CompileJSONSchema(schema, gojsonschema.Draft7)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := &schemaFields{compiled: compiled, properties: map[string]any{}}
+	if obj, ok := schema.(map[string]any); ok {
+		if properties, ok := obj["properties"].(map[string]any); ok {
+			fields.properties = properties
+		}
+	}
+	return fields, nil
+}
+
+// Validate checks a request's method, query string and headers against the
+// binding. It returns a *ValidationError listing every violation.
+func (v *RequestValidator) Validate(r *http.Request) error {
+	var fieldErrs []FieldError
+	if v.method != "" && !strings.EqualFold(r.Method, v.method) {
+		fieldErrs = append(fieldErrs, FieldError{
+			Part:    PartMethod,
+			Message: fmt.Sprintf("method must be %s, got %s", v.method, r.Method),
+		})
+	}
+
+	errs, err := v.queryErrors(r.URL.Query())
+	if err != nil {
+		return err
+	}
+	fieldErrs = append(fieldErrs, errs...)
+
+	errs, err = v.headerErrors(r.Header)
+	if err != nil {
+		return err
+	}
+	fieldErrs = append(fieldErrs, errs...)
+
+	if len(fieldErrs) > 0 {
+		return &ValidationError{Errors: fieldErrs}
+	}
+	return nil
+}
+
+// ValidateQuery checks a query string against the binding's query schema. A
+// binding without one accepts any query string.
+//
+// Parameters are converted to the type of their property in the schema, so
+// that ?limit=10 matches an integer, and parameters given more than once are
+// validated as an array if their property is one.
+func (v *RequestValidator) ValidateQuery(query url.Values) error {
+	errs, err := v.queryErrors(query)
+	if err != nil || len(errs) == 0 {
+		return err
+	}
+	return &ValidationError{Errors: errs}
+}
+
+// ValidateHeaders checks headers against the binding's headers schema. A
+// binding without one accepts any headers.
+//
+// Header names are matched to the properties of the schema case
+// insensitively, and converted like query parameters. Headers the schema
+// doesn't mention are validated under their canonical name, such as
+// Sec-Websocket-Key, so a schema that disallows additional properties must
+// list every header clients send.
+func (v *RequestValidator) ValidateHeaders(header http.Header) error {
+	errs, err := v.headerErrors(header)
+	if err != nil || len(errs) == 0 {
+		return err
+	}
+	return &ValidationError{Errors: errs}
+}
+
+// Middleware returns a handler that validates each request before calling
+// next, responding with 400 Bad Request to requests that don't match the
+// binding
+func (v *RequestValidator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := v.Validate(r); err != nil {
+			var validationErr *ValidationError
+			if errors.As(err, &validationErr) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (v *RequestValidator) queryErrors(query url.Values) ([]FieldError, error) {
+	if v.query == nil {
+		return nil, nil
+	}
+	value := make(map[string]any, len(query))
+	for name, values := range query {
+		value[name] = convert(values, v.query.properties[name])
+	}
+	return v.query.validate(value, PartQuery)
+}
+
+func (v *RequestValidator) headerErrors(header http.Header) ([]FieldError, error) {
+	if v.headers == nil {
+		return nil, nil
+	}
+
+	// Header names are case insensitive, so they're matched to the names
+	// the schema uses
+	names := make(map[string]string, len(v.headers.properties))
+	for name := range v.headers.properties {
+		names[http.CanonicalHeaderKey(name)] = name
+	}
+	value := make(map[string]any, len(header))
+	for name, values := range header {
+		name = http.CanonicalHeaderKey(name)
+		if property, ok := names[name]; ok {
+			name = property
+		}
+		value[name] = convert(values, v.headers.properties[name])
+	}
+	return v.headers.validate(value, PartHeaders)
+}
+
+func (f *schemaFields) validate(value map[string]any, part Part) ([]FieldError, error) {
+	err := f.compiled.ValidateValue(value)
+	if err == nil {
+		return nil, nil
+	}
+	var schemaErr *schemaformat.ValidationError
+	if !errors.As(err, &schemaErr) {
+		return nil, err
+	}
+	fieldErrs := make([]FieldError, 0, len(schemaErr.Errors))
+	for _, e := range schemaErr.Errors {
+		fieldErrs = append(fieldErrs, FieldError{Part: part, Path: e.Path, Message: e.Message})
+	}
+	sort.SliceStable(fieldErrs, func(i, j int) bool {
+		return fieldErrs[i].Path < fieldErrs[j].Path
+	})
+	return fieldErrs, nil
+}
+
+// convert converts the values of a query parameter or header to the type of
+// its property schema. Values that can't be converted are left as strings
+// for the schema to reject.
+func convert(values []string, schema any) any {
+	types := schemaTypes(schema)
+	if types["array"] {
+		var items any
+		if obj, ok := schema.(map[string]any); ok {
+			items = obj["items"]
+		}
+		out := make([]any, len(values))
+		for i, value := range values {
+			out[i] = convertValue(value, schemaTypes(items))
+		}
+		return out
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	return convertValue(values[0], types)
+}
+
+func convertValue(value string, types map[string]bool) any {
+	if types["string"] {
+		return value
+	}
+	if types["integer"] || types["number"] {
+		if _, err := strconv.ParseFloat(value, 64); err == nil && json.Valid([]byte(value)) {
+			return json.Number(value)
+		}
+	}
+	if types["boolean"] {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
+// schemaTypes returns the types a schema allows
+func schemaTypes(schema any) map[string]bool {
+	obj, ok := schema.(map[string]any)
+	if !ok {
+		return nil
+	}
+	types := map[string]bool{}
+	switch t := obj["type"].(type) {
+	case string:
+		types[t] = true
+	case []any:
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				types[s] = true
+			}
+		}
+	}
+	return types
+}
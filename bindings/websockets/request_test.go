@@ -0,0 +1,110 @@
+package websockets
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestValidator(t *testing.T) *RequestValidator {
+	t.Helper()
+	var cb ChannelBinding
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"method": "GET",
+		"query": {
+			"type": "object",
+			"properties": {
+				"token": {"type": "string", "minLength": 8},
+				"limit": {"type": "integer", "maximum": 100},
+				"compress": {"type": "boolean"},
+				"topic": {"type": "array", "items": {"type": "string"}}
+			},
+			"required": ["token"],
+			"additionalProperties": false
+		},
+		"headers": {
+			"type": "object",
+			"properties": {
+				"Authorization": {"type": "string", "pattern": "^Bearer "},
+				"x-retries": {"type": "integer"}
+			},
+			"required": ["Authorization"]
+		}
+	}`), &cb))
+
+	v, err := NewRequestValidator(&cb)
+	require.NoError(t, err)
+	return v
+}
+
+func TestRequestValidator_Validate(t *testing.T) {
+	v := newTestValidator(t)
+
+	r := httptest.NewRequest(http.MethodGet, "/ws?token=abcdefgh&limit=10&compress=true&topic=a&topic=b", nil)
+	r.Header.Set("authorization", "Bearer abc")
+	r.Header.Set("X-Retries", "3")
+	assert.NoError(t, v.Validate(r))
+
+	r = httptest.NewRequest(http.MethodPost, "/ws?token=abc&limit=many&other=1", nil)
+	r.Header.Set("X-Retries", "3")
+	err := v.Validate(r)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+
+	parts := map[Part][]string{}
+	for _, e := range validationErr.Errors {
+		parts[e.Part] = append(parts[e.Part], e.Path)
+	}
+	assert.Equal(t, []string{""}, parts[PartMethod])
+	assert.Equal(t, []string{"", "/limit", "/token"}, parts[PartQuery])
+	assert.Equal(t, []string{""}, parts[PartHeaders])
+	assert.Contains(t, err.Error(), "method must be GET, got POST")
+}
+
+func TestRequestValidator_ValidateQuery(t *testing.T) {
+	v := newTestValidator(t)
+
+	assert.NoError(t, v.ValidateQuery(url.Values{"token": {"abcdefgh"}, "limit": {"100"}}))
+
+	err := v.ValidateQuery(url.Values{"token": {"abcdefgh"}, "limit": {"101"}, "compress": {"maybe"}})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []FieldError{
+		{Part: PartQuery, Path: "/compress", Message: "Invalid type. Expected: boolean, given: string"},
+		{Part: PartQuery, Path: "/limit", Message: "Must be less than or equal to 100"},
+	}, validationErr.Errors)
+}
+
+func TestRequestValidator_NoSchemas(t *testing.T) {
+	v, err := NewRequestValidator(NewChannelBinding())
+	require.NoError(t, err)
+
+	r := httptest.NewRequest(http.MethodPost, "/ws?anything=1", nil)
+	assert.NoError(t, v.Validate(r))
+
+	_, err = NewRequestValidator(NewChannelBinding().WithQuery(map[string]interface{}{"type": 1}))
+	assert.ErrorContains(t, err, "failed to compile query schema")
+}
+
+func TestRequestValidator_Middleware(t *testing.T) {
+	v := newTestValidator(t)
+	handler := v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusSwitchingProtocols)
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/ws?token=abcdefgh", nil)
+	r.Header.Set("Authorization", "Bearer abc")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, r)
+	assert.Equal(t, http.StatusSwitchingProtocols, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ws", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "query: token is required")
+}
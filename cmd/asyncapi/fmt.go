@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charlie-haley/asyncapi-go/format"
+)
+
+// fmtCommand formats documents, like gofmt does Go source
+type fmtCommand struct {
+	check  bool
+	write  bool
+	stdout io.Writer
+	stderr io.Writer
+	status int
+}
+
+func runFmt(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	cmd := &fmtCommand{stdout: stdout, stderr: stderr}
+	flags := flag.NewFlagSet("fmt", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.BoolVar(&cmd.check, "check", false, "list files whose formatting differs and exit with status 1, without changing them")
+	flags.BoolVar(&cmd.write, "w", false, "write the result to the source file instead of standard output")
+	flags.Usage = func() {
+		fmt.Fprint(stderr, `Usage: asyncapi fmt [-check | -w] [path ...]
+
+Formats AsyncAPI documents canonically, keeping JSON documents JSON and
+writing everything else as YAML. Directories are searched for .yaml, .yml and
+.json files that are AsyncAPI documents. With no paths, standard input is
+formatted to standard output.
+
+Flags:
+`)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if cmd.check && cmd.write {
+		fmt.Fprintln(stderr, "asyncapi fmt: -check and -w can't be used together")
+		return 2
+	}
+
+	if flags.NArg() == 0 {
+		if cmd.write {
+			fmt.Fprintln(stderr, "asyncapi fmt: can't use -w with standard input")
+			return 2
+		}
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			cmd.fail("<standard input>", err)
+			return cmd.status
+		}
+		cmd.format("<standard input>", data, true)
+		return cmd.status
+	}
+
+	for _, path := range flags.Args() {
+		info, err := os.Stat(path)
+		if err != nil {
+			cmd.fail(path, err)
+			continue
+		}
+		if !info.IsDir() {
+			cmd.formatFile(path, true)
+			continue
+		}
+		err = filepath.WalkDir(path, func(name string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if entry.IsDir() {
+				if name != path && strings.HasPrefix(entry.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			switch strings.ToLower(filepath.Ext(name)) {
+			case ".yaml", ".yml", ".json":
+				cmd.formatFile(name, false)
+			}
+			return nil
+		})
+		if err != nil {
+			cmd.fail(path, err)
+		}
+	}
+	return cmd.status
+}
+
+// formatFile formats a file. Files found in directories that aren't AsyncAPI
+// documents are skipped rather than reported.
+func (c *fmtCommand) formatFile(path string, explicit bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		c.fail(path, err)
+		return
+	}
+	c.format(path, data, explicit)
+}
+
+func (c *fmtCommand) format(path string, data []byte, explicit bool) {
+	out, err := format.Source(data)
+	if err != nil {
+		if !explicit && errors.Is(err, format.ErrNotAsyncAPI) {
+			return
+		}
+		c.fail(path, err)
+		return
+	}
+
+	switch {
+	case c.check:
+		if !bytes.Equal(data, out) {
+			fmt.Fprintln(c.stdout, path)
+			if c.status == 0 {
+				c.status = 1
+			}
+		}
+	case c.write:
+		if bytes.Equal(data, out) {
+			return
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			c.fail(path, err)
+			return
+		}
+		if err := os.WriteFile(path, out, info.Mode().Perm()); err != nil {
+			c.fail(path, err)
+		}
+	default:
+		if _, err := c.stdout.Write(out); err != nil {
+			c.fail(path, err)
+		}
+	}
+}
+
+// fail reports an error, which makes the command exit with status 2
+func (c *fmtCommand) fail(path string, err error) {
+	fmt.Fprintf(c.stderr, "%s: %v\n", path, err)
+	c.status = 2
+}
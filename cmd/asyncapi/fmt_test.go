@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const unformatted = "info: {version: '1.0.0', title: Example}\nasyncapi: 2.6.0\nchannels: {}\n"
+
+const formatted = "asyncapi: 2.6.0\ninfo:\n  title: Example\n  version: 1.0.0\nchannels: {}\n"
+
+func TestFmt(t *testing.T) {
+	dir := t.TempDir()
+	spec := filepath.Join(dir, "spec.yaml")
+	require.NoError(t, os.WriteFile(spec, []byte(unformatted), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("name: not a spec\n"), 0o644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, ".hidden"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden", "spec.yaml"), []byte(unformatted), 0o644))
+
+	var stdout, stderr bytes.Buffer
+	status := run([]string{"fmt", "-check", dir}, nil, &stdout, &stderr)
+	assert.Equal(t, 1, status)
+	assert.Equal(t, spec+"\n", stdout.String())
+	assert.Empty(t, stderr.String())
+
+	stdout.Reset()
+	status = run([]string{"fmt", "-w", dir}, nil, &stdout, &stderr)
+	assert.Equal(t, 0, status)
+	assert.Empty(t, stdout.String())
+	data, err := os.ReadFile(spec)
+	require.NoError(t, err)
+	assert.Equal(t, formatted, string(data))
+
+	status = run([]string{"fmt", "--check", dir}, nil, &stdout, &stderr)
+	assert.Equal(t, 0, status)
+	assert.Empty(t, stdout.String())
+	assert.Empty(t, stderr.String())
+}
+
+func TestFmt_Stdin(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	status := run([]string{"fmt"}, strings.NewReader(unformatted), &stdout, &stderr)
+	assert.Equal(t, 0, status)
+	assert.Equal(t, formatted, stdout.String())
+}
+
+func TestFmt_Errors(t *testing.T) {
+	dir := t.TempDir()
+	config := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(config, []byte("name: not a spec\n"), 0o644))
+
+	var stdout, stderr bytes.Buffer
+	status := run([]string{"fmt", config}, nil, &stdout, &stderr)
+	assert.Equal(t, 2, status)
+	assert.Equal(t, config+": not an AsyncAPI document\n", stderr.String())
+
+	stderr.Reset()
+	status = run([]string{"fmt", "-check", "-w", config}, nil, &stdout, &stderr)
+	assert.Equal(t, 2, status)
+	assert.Contains(t, stderr.String(), "can't be used together")
+
+	stderr.Reset()
+	status = run([]string{"lint"}, nil, &stdout, &stderr)
+	assert.Equal(t, 2, status)
+	assert.Contains(t, stderr.String(), `unknown command "lint"`)
+}
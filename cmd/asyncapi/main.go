@@ -0,0 +1,52 @@
+// Command asyncapi works with AsyncAPI documents.
+//
+// Usage:
+//
+//	asyncapi <command> [arguments]
+//
+// The commands are:
+//
+//	fmt    format documents canonically
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	_ "github.com/charlie-haley/asyncapi-go/bindings/all"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs a command, returning the exit status
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	switch args[0] {
+	case "fmt":
+		return runFmt(args[1:], stdin, stdout, stderr)
+	case "help", "-h", "-help", "--help":
+		usage(stdout)
+		return 0
+	default:
+		fmt.Fprintf(stderr, "asyncapi: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+}
+
+func usage(w io.Writer) {
+	fmt.Fprint(w, `Usage: asyncapi <command> [arguments]
+
+Commands:
+  fmt    format documents canonically
+
+Run "asyncapi <command> -h" for the arguments of a command.
+`)
+}
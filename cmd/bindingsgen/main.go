@@ -1,8 +1,11 @@
 package main
 
 import (
+	"bytes"
+	"flag"
 	"fmt"
 	"go/ast"
+	"go/format"
 	"go/parser"
 	"go/token"
 	"log"
@@ -10,10 +13,16 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
 	"text/template"
 )
 
+var (
+	schemasDir = flag.String("schemas", "", "directory of the binding JSON Schemas to generate the binding structs from")
+	protocol   = flag.String("protocol", "", "name of the protocol in generated doc comments, defaults to the package name in upper case")
+)
+
 var reservedKeywords = map[string]bool{
 	"break": true, "case": true, "chan": true, "const": true,
 	"continue": true, "default": true, "defer": true, "else": true,
@@ -22,6 +31,8 @@ var reservedKeywords = map[string]bool{
 	"map": true, "package": true, "range": true, "return": true,
 	"select": true, "struct": true, "switch": true, "type": true,
 	"var": true,
+	// predeclared identifiers a parameter would shadow
+	"bool": true, "int": true, "string": true,
 }
 
 type DefaultField struct {
@@ -44,19 +55,33 @@ type Struct struct {
 	IsBinding      bool
 	ParentBinding  bool
 	NoMarshalFuncs bool
+	// AdditionalProperties is whether the struct keeps the fields it doesn't
+	// define in an AdditionalProperties map
+	AdditionalProperties bool
+	// ValidateOnly is whether the struct is nested in a binding without
+	// builders, so only its Validate method is generated
+	ValidateOnly bool
+	// Checks are the statements of the struct's Validate method
+	Checks []string
 }
 
 type TemplateData struct {
 	Package    string
 	Structs    []Struct
 	AllStructs map[string]Struct
+	// HasMarshalFuncs is whether any struct has generated marshal functions,
+	// which need the encoding imports
+	HasMarshalFuncs bool
+	// HasChecks is whether any Validate method checks a field, which needs
+	// the errors and bindings imports
+	HasChecks bool
 }
 
 // TODO: migrate away from ast.Package and use go/types
 func findParentBindings(pkg *ast.Package) map[string]bool {
 	parentBindings := make(map[string]bool)
 
-	for _, file := range pkg.Files {
+	for _, file := range sortedFiles(pkg) {
 		for _, decl := range file.Decls {
 			genDecl, ok := decl.(*ast.GenDecl)
 			if !ok {
@@ -99,6 +124,52 @@ func findParentBindings(pkg *ast.Package) map[string]bool {
 	return parentBindings
 }
 
+// findValidated returns the structs that get a Validate method: the bindings
+// and every struct nested in them
+func findValidated(pkg *ast.Package) map[string]bool {
+	specs := make(map[string]*ast.StructType)
+	var queue []string
+	for _, file := range sortedFiles(pkg) {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				specs[typeSpec.Name.Name] = structType
+				if genDecl.Doc != nil && strings.Contains(genDecl.Doc.Text(), "+binding") {
+					queue = append(queue, typeSpec.Name.Name)
+				}
+			}
+		}
+	}
+
+	validated := make(map[string]bool)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if validated[name] {
+			continue
+		}
+		validated[name] = true
+		for _, field := range specs[name].Fields.List {
+			_, fieldType, _ := processType(field.Type)
+			if nested := elemType(fieldType); specs[nested] != nil {
+				queue = append(queue, nested)
+			}
+		}
+	}
+	return validated
+}
+
 func findDefaultValues(field *ast.Field) []DefaultField {
 	var defaults []DefaultField
 	if field.Tag != nil {
@@ -117,7 +188,7 @@ func getSafeParamName(jsonTag string) string {
 	if jsonTag == "-" {
 		return "additionalProperties"
 	}
-	paramName := strings.ReplaceAll(jsonTag, ".", "")
+	paramName := strings.NewReplacer(".", "", "-", "", "$", "").Replace(jsonTag)
 	if reservedKeywords[paramName] {
 		return paramName + "Value"
 	}
@@ -143,11 +214,8 @@ func processType(fieldType ast.Expr) (string, string, bool) {
 		baseType, typeStr, _ := processType(t.Elt)
 		return baseType, "[]" + typeStr, false
 	case *ast.MapType:
-		keyType := fmt.Sprint(t.Key)
-		valueType := fmt.Sprint(t.Value)
-		if _, ok := t.Value.(*ast.InterfaceType); ok {
-			valueType = "interface{}"
-		}
+		_, keyType, _ := processType(t.Key)
+		_, valueType, _ := processType(t.Value)
 		return "", fmt.Sprintf("map[%s]%s", keyType, valueType), false
 	case *ast.InterfaceType:
 		return "", "interface{}", false
@@ -156,7 +224,7 @@ func processType(fieldType ast.Expr) (string, string, bool) {
 	}
 }
 
-func processStruct(typeSpec *ast.TypeSpec, doc *ast.CommentGroup, allStructs map[string]Struct, parentBindings map[string]bool, seen map[string]bool) []Struct {
+func processStruct(typeSpec *ast.TypeSpec, doc *ast.CommentGroup, allStructs map[string]Struct, parentBindings map[string]bool, validated map[string]bool, seen map[string]bool) []Struct {
 	structName := typeSpec.Name.Name
 	if seen[structName] {
 		return nil
@@ -187,6 +255,7 @@ func processStruct(typeSpec *ast.TypeSpec, doc *ast.CommentGroup, allStructs map
 		ParentBinding:  !isBinding && parentBindings[structName],
 		NoMarshalFuncs: noMarshalGen,
 	}
+	mainStruct.ValidateOnly = !isBinding && !mainStruct.ParentBinding && validated[structName]
 
 	var structs []Struct
 	var fields []Field
@@ -204,14 +273,30 @@ func processStruct(typeSpec *ast.TypeSpec, doc *ast.CommentGroup, allStructs map
 
 		jsonTag := strings.Split(strings.Split(tag, "json:\"")[1], "\"")[0]
 		jsonTag = strings.Split(jsonTag, ",")[0]
+		if jsonTag == "-" && field.Names[0].Name == "AdditionalProperties" {
+			mainStruct.AdditionalProperties = true
+		}
 
 		_, fieldType, _ := processType(field.Type)
-		fields = append(fields, Field{
+		structField := Field{
 			Name:      field.Names[0].Name,
 			Type:      fieldType,
 			JsonTag:   jsonTag,
 			ParamName: getSafeParamName(jsonTag),
-		})
+		}
+		fields = append(fields, structField)
+
+		if jsonTag != "-" {
+			markers, err := findMarkers(field)
+			if err != nil {
+				log.Fatalf("%s.%s: %v", structName, structField.Name, err)
+			}
+			checks, err := validateChecks(structField, markers, validated)
+			if err != nil {
+				log.Fatalf("%s: %v", structName, err)
+			}
+			mainStruct.Checks = append(mainStruct.Checks, checks...)
+		}
 
 		// Process default values
 		defaults := findDefaultValues(field)
@@ -220,19 +305,107 @@ func processStruct(typeSpec *ast.TypeSpec, doc *ast.CommentGroup, allStructs map
 
 	mainStruct.Fields = fields
 	mainStruct.DefaultFields = defaultFields
-	if isBinding || mainStruct.ParentBinding {
+	if isBinding || mainStruct.ParentBinding || mainStruct.ValidateOnly {
 		structs = append([]Struct{mainStruct}, structs...)
 	}
 
 	return structs
 }
 
+// sortedFiles returns the files of a package sorted by name, so the output
+// doesn't depend on map order
+func sortedFiles(pkg *ast.Package) []*ast.File {
+	names := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	files := make([]*ast.File, 0, len(names))
+	for _, name := range names {
+		files = append(files, pkg.Files[name])
+	}
+	return files
+}
+
+// writeTypes generates the binding structs of the package in pkgDir from the
+// binding schemas in dir
+func writeTypes(fset *token.FileSet, pkgDir, dir string, tmpl *template.Template) error {
+	packages, err := parser.ParseDir(fset, pkgDir, func(fi os.FileInfo) bool {
+		return !strings.HasPrefix(fi.Name(), "zz_generated") && !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.PackageClauseOnly)
+	if err != nil {
+		return err
+	}
+	var pkgName string
+	for name := range packages {
+		pkgName = name
+	}
+	if pkgName == "" {
+		return fmt.Errorf("no package found in %s", pkgDir)
+	}
+
+	name := *protocol
+	if name == "" {
+		name = strings.ToUpper(pkgName)
+	}
+	structs, enums, err := generateTypes(dir, name)
+	if err != nil {
+		return err
+	}
+
+	data := TypesData{
+		Package: pkgName,
+		Source:  filepath.ToSlash(dir),
+		Structs: structs,
+		Enums:   enums,
+	}
+	for _, s := range structs {
+		if s.AdditionalProperties && !s.IsBinding {
+			data.HasMarshalFuncs = true
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "types.go.tmpl", data); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format generated types: %w", err)
+	}
+	return os.WriteFile(filepath.Join(pkgDir, "zz_generated.types.go"), src, 0o644)
+}
+
 func main() {
+	flag.Parse()
 	fset := token.NewFileSet()
 	pkgDir := "."
 
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		log.Fatal("Failed to get caller information")
+	}
+
+	templateDir := filepath.Dir(filename)
+	tmpl, err := template.ParseFiles(
+		filepath.Join(templateDir, "binding.go.tmpl"),
+		filepath.Join(templateDir, "types.go.tmpl"),
+		filepath.Join(templateDir, "marshal.go.tmpl"),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *schemasDir != "" {
+		if err := writeTypes(fset, pkgDir, *schemasDir, tmpl); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// generated types are the input of the builders, unlike the builders
+	// from a previous run
 	packages, err := parser.ParseDir(fset, pkgDir, func(fi os.FileInfo) bool {
-		return !strings.HasPrefix(fi.Name(), "zz_generated")
+		return fi.Name() != "zz_generated.binding.go"
 	}, parser.ParseComments)
 	if err != nil {
 		log.Fatal(err)
@@ -240,6 +413,7 @@ func main() {
 
 	allStructs := make(map[string]Struct)
 	parentBindings := make(map[string]bool)
+	validated := make(map[string]bool)
 	var finalStructs []Struct
 	var pkgName string
 
@@ -250,14 +424,17 @@ func main() {
 		for parent := range findParentBindings(pkg) {
 			parentBindings[parent] = true
 		}
+		for name := range findValidated(pkg) {
+			validated[name] = true
+		}
 
-		for _, file := range pkg.Files {
+		for _, file := range sortedFiles(pkg) {
 			for _, decl := range file.Decls {
 				if genDecl, ok := decl.(*ast.GenDecl); ok {
 					for _, spec := range genDecl.Specs {
 						if typeSpec, ok := spec.(*ast.TypeSpec); ok {
 							if _, ok := typeSpec.Type.(*ast.StructType); ok {
-								structs := processStruct(typeSpec, genDecl.Doc, allStructs, parentBindings, make(map[string]bool))
+								structs := processStruct(typeSpec, genDecl.Doc, allStructs, parentBindings, validated, make(map[string]bool))
 								if len(structs) > 0 {
 									allStructs[typeSpec.Name.Name] = structs[0]
 								}
@@ -272,14 +449,14 @@ func main() {
 	// Second pass: process bindings and nested structs
 	seen := make(map[string]bool)
 	for _, pkg := range packages {
-		for _, file := range pkg.Files {
+		for _, file := range sortedFiles(pkg) {
 			for _, decl := range file.Decls {
 				if genDecl, ok := decl.(*ast.GenDecl); ok {
 					for _, spec := range genDecl.Specs {
 						if typeSpec, ok := spec.(*ast.TypeSpec); ok {
 							if _, ok := typeSpec.Type.(*ast.StructType); ok {
-								structs := processStruct(typeSpec, genDecl.Doc, allStructs, parentBindings, seen)
-								if len(structs) > 0 && (structs[0].IsBinding || structs[0].ParentBinding) {
+								structs := processStruct(typeSpec, genDecl.Doc, allStructs, parentBindings, validated, seen)
+								if len(structs) > 0 {
 									finalStructs = append(finalStructs, structs...)
 								}
 							}
@@ -290,31 +467,29 @@ func main() {
 		}
 	}
 
-	_, filename, _, ok := runtime.Caller(0)
-	if !ok {
-		log.Fatal("Failed to get caller information")
-	}
-
-	templatePath := filepath.Join(filepath.Dir(filename), "binding.go.tmpl")
-	tmpl, err := template.ParseFiles(templatePath)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	outputFile := filepath.Join(pkgDir, "zz_generated.binding.go")
-	f, err := os.Create(outputFile)
-	if err != nil {
-		log.Fatal(err)
-	}
-	defer f.Close()
-
 	data := TemplateData{
 		Package:    pkgName,
 		Structs:    finalStructs,
 		AllStructs: allStructs,
 	}
+	for _, s := range finalStructs {
+		if s.IsBinding && !s.NoMarshalFuncs {
+			data.HasMarshalFuncs = true
+		}
+		if len(s.Checks) > 0 {
+			data.HasChecks = true
+		}
+	}
 
-	if err := tmpl.Execute(f, data); err != nil {
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "binding.go.tmpl", data); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format generated bindings: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(pkgDir, "zz_generated.binding.go"), src, 0o644); err != nil {
 		log.Fatal(err)
 	}
 }
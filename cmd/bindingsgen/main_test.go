@@ -0,0 +1,25 @@
+package main
+
+import (
+	"go/parser"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessType(t *testing.T) {
+	tests := map[string]string{
+		"*Queue":                 "*Queue",
+		"[]string":               "[]string",
+		"map[string]*Queue":      "map[string]*Queue",
+		"map[string][]string":    "map[string][]string",
+		"map[string]interface{}": "map[string]interface{}",
+	}
+	for src, expected := range tests {
+		expr, err := parser.ParseExpr(src)
+		require.NoError(t, err)
+		_, typeStr, _ := processType(expr)
+		assert.Equal(t, expected, typeStr, src)
+	}
+}
@@ -0,0 +1,457 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/charlie-haley/asyncapi-go/internal/ordered"
+	"github.com/charlie-haley/asyncapi-go/spec"
+)
+
+// bindingKinds are the binding schema files of a protocol, in the order
+// their binding structs are generated
+var bindingKinds = []string{"server", "channel", "operation", "message"}
+
+// initialisms are the words written in upper case in Go names
+var initialisms = map[string]bool{
+	"API": true, "ARN": true, "AWS": true, "DLQ": true, "HTTP": true,
+	"HTTPS": true, "ID": true, "JSON": true, "SMS": true, "SNS": true,
+	"SQS": true, "TTL": true, "URI": true, "URL": true,
+}
+
+// jsonSchema is the subset of JSON Schema draft-07 used by binding schemas
+type jsonSchema struct {
+	ID                   string                 `json:"$id"`
+	Ref                  string                 `json:"$ref"`
+	Type                 interface{}            `json:"type"`
+	Description          string                 `json:"description"`
+	Properties           map[string]*jsonSchema `json:"properties"`
+	Required             []string               `json:"required"`
+	Enum                 []interface{}          `json:"enum"`
+	Default              interface{}            `json:"default"`
+	Minimum              json.Number            `json:"minimum"`
+	Maximum              json.Number            `json:"maximum"`
+	Items                *jsonSchema            `json:"items"`
+	OneOf                []*jsonSchema          `json:"oneOf"`
+	AnyOf                []*jsonSchema          `json:"anyOf"`
+	AdditionalProperties json.RawMessage        `json:"additionalProperties"`
+	Definitions          map[string]*jsonSchema `json:"definitions"`
+}
+
+// schemaFile is a binding schema, along with the order of its keys
+type schemaFile struct {
+	root *jsonSchema
+	keys ordered.Keys
+}
+
+// TypeField is a field of a struct generated from a schema
+type TypeField struct {
+	Name    string
+	Type    string
+	JsonTag string
+	Tag     string
+	Doc     []string
+
+	schema     *jsonSchema
+	kind       string
+	requiredIn int
+}
+
+// TypeStruct is a struct generated from a schema
+type TypeStruct struct {
+	Name                 string
+	Doc                  []string
+	Fields               []*TypeField
+	AdditionalProperties bool
+	IsBinding            bool
+
+	sources int
+	fields  map[string]*TypeField
+}
+
+// EnumValue is a constant for a value of an enum
+type EnumValue struct {
+	Name  string
+	Value string
+}
+
+// Enum is the block of constants for the values of a field
+type Enum struct {
+	Name   string
+	Field  string
+	Values []EnumValue
+}
+
+// TypesData is the data of the types template
+type TypesData struct {
+	Package string
+	Source  string
+	Structs []*TypeStruct
+	Enums   []Enum
+	// HasMarshalFuncs is whether any non-binding struct has generated marshal
+	// functions, which need the encoding imports
+	HasMarshalFuncs bool
+}
+
+// schemaGenerator generates the structs of a protocol's bindings from its
+// binding schemas
+type schemaGenerator struct {
+	protocol string
+	files    map[string]*schemaFile
+	structs  []*TypeStruct
+	byName   map[string]*TypeStruct
+	origins  map[string]string
+	enums    []Enum
+	// visited holds the schemas already added to a struct, by $id and
+	// JSON pointer
+	visited map[string]bool
+}
+
+// generateTypes reads the binding schemas in dir and returns the structs and
+// enums to generate. protocol is the name of the protocol used in doc
+// comments, such as SQS.
+func generateTypes(dir, protocol string) ([]*TypeStruct, []Enum, error) {
+	g := &schemaGenerator{
+		protocol: protocol,
+		files:    make(map[string]*schemaFile),
+		byName:   make(map[string]*TypeStruct),
+		origins:  make(map[string]string),
+		visited:  make(map[string]bool),
+	}
+
+	var roots []*schemaFile
+	var kinds []string
+	for _, kind := range bindingKinds {
+		data, err := os.ReadFile(filepath.Join(dir, kind+".json"))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read %s schema: %w", kind, err)
+		}
+		file, err := parseSchemaFile(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse %s schema: %w", kind, err)
+		}
+		g.files[file.root.ID] = file
+		roots = append(roots, file)
+		kinds = append(kinds, kind)
+	}
+	if len(roots) == 0 {
+		return nil, nil, fmt.Errorf("no binding schemas found in %s", dir)
+	}
+
+	for i, file := range roots {
+		name := goName(kinds[i]) + "Binding"
+		doc := []string{fmt.Sprintf("%s represents the %s %s Binding object.", name, protocol, goName(kinds[i]))}
+		doc = append(doc, wrap(file.root.Description, 76)...)
+		doc = append(doc, "+binding")
+		s, err := g.addStruct(name, name, doc, file, nil, file.root)
+		if err != nil {
+			return nil, nil, err
+		}
+		s.IsBinding = true
+	}
+
+	for _, s := range g.structs {
+		for _, field := range s.Fields {
+			finishField(field, field.requiredIn == s.sources)
+		}
+	}
+	return g.structs, g.enums, nil
+}
+
+func parseSchemaFile(data []byte) (*schemaFile, error) {
+	var root jsonSchema
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+	_, keys, err := ordered.DecodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return &schemaFile{root: &root, keys: keys}, nil
+}
+
+// addStruct adds the struct for an object schema at path in file, merging
+// it with the struct from another schema with the same origin. A field is
+// only required when every merged schema requires it.
+func (g *schemaGenerator) addStruct(name, origin string, doc []string, file *schemaFile, path []string, schema *jsonSchema) (*TypeStruct, error) {
+	s, ok := g.byName[name]
+	if ok && g.origins[name] != origin {
+		return nil, fmt.Errorf("struct %s is generated from both %s and %s", name, g.origins[name], origin)
+	}
+	if !ok {
+		s = &TypeStruct{Name: name, Doc: doc, fields: make(map[string]*TypeField)}
+		g.structs = append(g.structs, s)
+		g.byName[name] = s
+		g.origins[name] = origin
+	}
+	visited := file.root.ID + "#" + spec.JSONPointer(path...)
+	if g.visited[visited] {
+		return s, nil
+	}
+	g.visited[visited] = true
+	s.sources++
+	if string(schema.AdditionalProperties) != "false" {
+		s.AdditionalProperties = true
+	}
+
+	required := make(map[string]bool, len(schema.Required))
+	for _, key := range schema.Required {
+		required[key] = true
+	}
+	propertiesPath := append(append([]string{}, path...), "properties")
+	for _, key := range file.keys[spec.JSONPointer(propertiesPath...)] {
+		property := schema.Properties[key]
+		propertyPath := append(append([]string{}, propertiesPath...), key)
+		goType, kind, description, err := g.goType(s.Name, key, file, propertyPath, property)
+		if err != nil {
+			return nil, err
+		}
+
+		field, ok := s.fields[key]
+		if !ok {
+			field = &TypeField{
+				Name:    goName(key),
+				Type:    goType,
+				JsonTag: key,
+				Doc:     wrap(description, 74),
+				schema:  property,
+				kind:    kind,
+			}
+			s.fields[key] = field
+			s.Fields = append(s.Fields, field)
+			g.addEnum(s.Name, field)
+		} else if field.Type != goType {
+			return nil, fmt.Errorf("field %s of %s is both %s and %s", key, s.Name, field.Type, goType)
+		}
+		if required[key] {
+			field.requiredIn++
+		}
+	}
+	return s, nil
+}
+
+// goType returns the Go type of a property, whether it's a scalar, struct or
+// other type, and its description
+func (g *schemaGenerator) goType(parent, key string, file *schemaFile, path []string, schema *jsonSchema) (string, string, string, error) {
+	description := schema.Description
+	if schema.Ref != "" {
+		refFile, name, ok := g.resolve(file, schema.Ref)
+		if !ok {
+			return "interface{}", "other", description, nil
+		}
+		definition := refFile.root.Definitions[name]
+		if description == "" {
+			description = definition.Description
+		}
+		structName := goName(name)
+		doc := append([]string{fmt.Sprintf("%s represents the %s %s object.", structName, g.protocol, name)}, wrap(definition.Description, 76)...)
+		if _, err := g.addStruct(structName, "#/definitions/"+name, doc, refFile, []string{"definitions", name}, definition); err != nil {
+			return "", "", "", err
+		}
+		return "*" + structName, "struct", description, nil
+	}
+	if len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 {
+		return "interface{}", "other", description, nil
+	}
+
+	schemaType, _ := schema.Type.(string)
+	switch schemaType {
+	case "string":
+		return "string", "string", description, nil
+	case "boolean":
+		return "bool", "scalar", description, nil
+	case "integer":
+		return "int", "scalar", description, nil
+	case "number":
+		return "float64", "scalar", description, nil
+	case "array":
+		if schema.Items == nil {
+			return "[]interface{}", "other", description, nil
+		}
+		itemType, _, _, err := g.goType(parent, key, file, append(path, "items"), schema.Items)
+		if err != nil {
+			return "", "", "", err
+		}
+		return "[]" + strings.TrimPrefix(itemType, "*"), "other", description, nil
+	case "object", "":
+		if len(schema.Properties) == 0 {
+			if schemaType == "" {
+				return "interface{}", "other", description, nil
+			}
+			return "map[string]interface{}", "other", description, nil
+		}
+		origin := parent + "." + key
+		structName := goName(key)
+		if existing, ok := g.origins[structName]; ok && existing != origin {
+			structName = parent + structName
+		}
+		doc := append([]string{fmt.Sprintf("%s represents the %s %s object.", structName, g.protocol, key)}, wrap(schema.Description, 76)...)
+		if _, err := g.addStruct(structName, origin, doc, file, path, schema); err != nil {
+			return "", "", "", err
+		}
+		return "*" + structName, "struct", description, nil
+	}
+	return "interface{}", "other", description, nil
+}
+
+// resolve returns the schema file and definition name a $ref points to, if
+// it's a definition of one of the protocol's schemas
+func (g *schemaGenerator) resolve(file *schemaFile, ref string) (*schemaFile, string, bool) {
+	base, fragment, _ := strings.Cut(ref, "#")
+	name, ok := strings.CutPrefix(fragment, "/definitions/")
+	if !ok {
+		return nil, "", false
+	}
+	if base != "" {
+		if file, ok = g.files[base]; !ok {
+			return nil, "", false
+		}
+	}
+	if _, ok := file.root.Definitions[name]; !ok {
+		return nil, "", false
+	}
+	return file, name, true
+}
+
+// addEnum adds the constants for the values of a string field's enum. The
+// binding version isn't an enum of the Go types, as they're decoded from
+// every supported version.
+func (g *schemaGenerator) addEnum(structName string, field *TypeField) {
+	if field.kind != "string" || len(field.schema.Enum) == 0 || field.JsonTag == "bindingVersion" {
+		return
+	}
+	enum := Enum{Name: structName + field.Name, Field: structName + "." + field.Name}
+	for _, value := range field.schema.Enum {
+		if s, ok := value.(string); ok {
+			enum.Values = append(enum.Values, EnumValue{Name: enum.Name + goName(s), Value: strconv.Quote(s)})
+		}
+	}
+	g.enums = append(g.enums, enum)
+}
+
+// finishField sets the final type and tags of a field once it's known if
+// the field is required. Optional booleans and numbers are pointers, so that an
+// unset field can be told apart from its zero value, unless their default is
+// the zero value anyway.
+func finishField(field *TypeField, required bool) {
+	if field.kind == "scalar" && !required {
+		switch field.schema.Default {
+		case false, 0.0:
+		default:
+			field.Type = "*" + field.Type
+		}
+	}
+
+	jsonTag := field.JsonTag
+	if !required {
+		jsonTag += ",omitempty"
+	}
+	field.Tag = fmt.Sprintf("json:%q", jsonTag)
+	field.Doc = append(field.Doc, markers(field, required)...)
+	// other defaults already apply when a field is omitted, so builders only
+	// set the binding version
+	if value, ok := field.schema.Default.(string); ok && field.JsonTag == "bindingVersion" {
+		field.Tag += fmt.Sprintf(" default:%q", strconv.Quote(value))
+	}
+}
+
+// markers returns the validation markers of a field
+func markers(field *TypeField, required bool) []string {
+	var markers []string
+	if required {
+		markers = append(markers, "+binding:required")
+	}
+	if field.kind == "string" && len(field.schema.Enum) > 0 && field.JsonTag != "bindingVersion" {
+		var values []string
+		for _, value := range field.schema.Enum {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+		markers = append(markers, "+binding:enum="+strings.Join(values, ";"))
+	}
+	if field.kind == "scalar" && field.Type != "bool" && field.Type != "*bool" {
+		if field.schema.Minimum != "" {
+			markers = append(markers, "+binding:min="+field.schema.Minimum.String())
+		}
+		if field.schema.Maximum != "" {
+			markers = append(markers, "+binding:max="+field.schema.Maximum.String())
+		}
+	}
+	return markers
+}
+
+// goName converts a JSON name to an exported Go name, such as fifoQueue to
+// FifoQueue and email-json to EmailJSON
+func goName(name string) string {
+	var b strings.Builder
+	for _, word := range splitWords(name) {
+		upper := strings.ToUpper(word)
+		switch {
+		case initialisms[upper], word == upper:
+			b.WriteString(upper)
+		default:
+			runes := []rune(word)
+			b.WriteRune(unicode.ToUpper(runes[0]))
+			b.WriteString(string(runes[1:]))
+		}
+	}
+	return b.String()
+}
+
+// splitWords splits a camel case, kebab case or snake case name into words
+func splitWords(name string) []string {
+	var words []string
+	var word []rune
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = nil
+			}
+			continue
+		}
+		if len(word) > 0 && unicode.IsUpper(r) {
+			prev := word[len(word)-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if !unicode.IsUpper(prev) || nextLower {
+				words = append(words, string(word))
+				word = nil
+			}
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	return words
+}
+
+// wrap splits text into lines of at most width characters
+func wrap(text string, width int) []string {
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoName(t *testing.T) {
+	tests := map[string]string{
+		"fifoQueue":            "FifoQueue",
+		"arn":                  "ARN",
+		"email-json":           "EmailJSON",
+		"perMessageGroupId":    "PerMessageGroupID",
+		"FIFO":                 "FIFO",
+		"MessageAttributes":    "MessageAttributes",
+		"$ref":                 "Ref",
+		"max.message.bytes":    "MaxMessageBytes",
+		"confluent.key.schema": "ConfluentKeySchema",
+	}
+	for name, expected := range tests {
+		assert.Equal(t, expected, goName(name), name)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	assert.Equal(t, []string{"one two", "three"}, wrap("one two three", 8))
+	assert.Nil(t, wrap("", 8))
+}
+
+func TestGenerateTypes(t *testing.T) {
+	structs, enums, err := generateTypes("../../internal/validation/bindings/sqs/0.3.0", "SQS")
+	require.NoError(t, err)
+
+	byName := make(map[string]*TypeStruct)
+	var names []string
+	for _, s := range structs {
+		byName[s.Name] = s
+		names = append(names, s.Name)
+	}
+	assert.Equal(t, []string{"ChannelBinding", "Queue", "RedrivePolicy", "Identifier", "Policy", "Statement", "OperationBinding"}, names)
+
+	channel := byName["ChannelBinding"]
+	assert.True(t, channel.IsBinding)
+	assert.False(t, channel.AdditionalProperties)
+	assert.Equal(t, "ChannelBinding represents the SQS Channel Binding object.", channel.Doc[0])
+	assert.Equal(t, "+binding", channel.Doc[len(channel.Doc)-1])
+
+	fields := func(s *TypeStruct) map[string]*TypeField {
+		m := make(map[string]*TypeField)
+		for _, f := range s.Fields {
+			m[f.JsonTag] = f
+		}
+		return m
+	}
+
+	channelFields := fields(channel)
+	assert.Equal(t, "*Queue", channelFields["queue"].Type)
+	assert.Equal(t, `json:"queue"`, channelFields["queue"].Tag)
+	assert.Equal(t, `json:"bindingVersion,omitempty" default:"\"latest\""`, channelFields["bindingVersion"].Tag)
+
+	// queue is defined by both schemas, and only name is required by both
+	queue := byName["Queue"]
+	assert.True(t, queue.AdditionalProperties)
+	queueFields := fields(queue)
+	assert.Equal(t, `json:"name"`, queueFields["name"].Tag)
+	assert.Equal(t, "bool", queueFields["fifoQueue"].Type)
+	assert.Equal(t, `json:"fifoQueue,omitempty"`, queueFields["fifoQueue"].Tag)
+	assert.Equal(t, "int", queueFields["deliveryDelay"].Type)
+	assert.Equal(t, "*int", queueFields["visibilityTimeout"].Type)
+	assert.Equal(t, "map[string]interface{}", queueFields["tags"].Type)
+	assert.Equal(t, "Ref", queueFields["$ref"].Name)
+	assert.Equal(t, []string{"Is this a FIFO queue?"}, queueFields["fifoQueue"].Doc)
+	assert.Contains(t, queueFields["name"].Doc, "+binding:required")
+	assert.Contains(t, queueFields["fifoThroughputLimit"].Doc, "+binding:enum=perQueue;perMessageGroupId")
+	assert.Subset(t, queueFields["deliveryDelay"].Doc, []string{"+binding:min=0", "+binding:max=15"})
+
+	assert.Equal(t, "*Identifier", fields(byName["RedrivePolicy"])["deadLetterQueue"].Type)
+	assert.Equal(t, "[]Statement", fields(byName["Policy"])["statements"].Type)
+	assert.Equal(t, "interface{}", fields(byName["Statement"])["principal"].Type)
+	assert.Equal(t, "[]Queue", fields(byName["OperationBinding"])["queues"].Type)
+
+	require.Len(t, enums, 3)
+	assert.Equal(t, Enum{
+		Name:  "QueueDeduplicationScope",
+		Field: "Queue.DeduplicationScope",
+		Values: []EnumValue{
+			{Name: "QueueDeduplicationScopeQueue", Value: `"queue"`},
+			{Name: "QueueDeduplicationScopeMessageGroup", Value: `"messageGroup"`},
+		},
+	}, enums[0])
+}
+
+func TestGenerateTypes_NoSchemas(t *testing.T) {
+	_, _, err := generateTypes(t.TempDir(), "SQS")
+	assert.Error(t, err)
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+)
+
+// numericTypes are the field types +binding:min and +binding:max apply to
+var numericTypes = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"float32": true, "float64": true,
+}
+
+// Markers are the validation markers of a field, set with comments such as
+// +binding:required, +binding:enum=queue;routingKey, +binding:min=1 and
+// +binding:max=2
+type Markers struct {
+	Required bool
+	Enum     []string
+	Min      string
+	Max      string
+}
+
+// findMarkers returns the validation markers in the comments of a field
+func findMarkers(field *ast.Field) (Markers, error) {
+	var markers Markers
+	for _, group := range []*ast.CommentGroup{field.Doc, field.Comment} {
+		if group == nil {
+			continue
+		}
+		for _, comment := range group.List {
+			text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+			marker, ok := strings.CutPrefix(text, "+binding:")
+			if !ok {
+				continue
+			}
+			name, value, _ := strings.Cut(marker, "=")
+			switch name {
+			case "required":
+				markers.Required = true
+			case "enum":
+				markers.Enum = strings.Split(value, ";")
+			case "min":
+				markers.Min = value
+			case "max":
+				markers.Max = value
+			default:
+				return markers, fmt.Errorf("unknown marker +binding:%s", name)
+			}
+			if name == "min" || name == "max" {
+				if _, err := strconv.ParseFloat(value, 64); err != nil {
+					return markers, fmt.Errorf("+binding:%s value %q isn't a number", name, value)
+				}
+			}
+		}
+	}
+	return markers, nil
+}
+
+// elemType returns the named type a field type refers to, such as Queue for
+// []*Queue or map[string]Queue
+func elemType(fieldType string) string {
+	for {
+		switch {
+		case strings.HasPrefix(fieldType, "*"):
+			fieldType = fieldType[1:]
+		case strings.HasPrefix(fieldType, "[]"):
+			fieldType = fieldType[2:]
+		case strings.HasPrefix(fieldType, "map["):
+			fieldType = fieldType[strings.Index(fieldType, "]")+1:]
+		default:
+			return fieldType
+		}
+	}
+}
+
+// validateChecks returns the statements of a struct's Validate method that
+// check a field, given the structs that have a Validate method
+func validateChecks(field Field, markers Markers, validated map[string]bool) ([]string, error) {
+	path := strconv.Quote(field.JsonTag)
+	value := "t." + field.Name
+	pointer := strings.HasPrefix(field.Type, "*")
+	baseType := strings.TrimPrefix(field.Type, "*")
+
+	var checks []string
+	if markers.Required {
+		switch {
+		case pointer, field.Type == "interface{}":
+			checks = append(checks, fmt.Sprintf("errs = append(errs, bindings.Required(%s, %s != nil))", path, value))
+		case field.Type == "string":
+			checks = append(checks, fmt.Sprintf("errs = append(errs, bindings.Required(%s, %s != \"\"))", path, value))
+		case strings.HasPrefix(field.Type, "[]"), strings.HasPrefix(field.Type, "map["):
+			checks = append(checks, fmt.Sprintf("errs = append(errs, bindings.Required(%s, len(%s) > 0))", path, value))
+		}
+		// booleans, numbers and structs always have a value
+	}
+
+	if len(markers.Enum) > 0 {
+		values := make([]string, len(markers.Enum))
+		for i, v := range markers.Enum {
+			values[i] = strconv.Quote(v)
+		}
+		enumValues := strings.Join(values, ", ")
+		switch field.Type {
+		case "string":
+			checks = append(checks, fmt.Sprintf("errs = append(errs, bindings.Enum(%s, %s, %s))", path, value, enumValues))
+		case "*string":
+			checks = append(checks, fmt.Sprintf("if %s != nil {\n\t\terrs = append(errs, bindings.Enum(%s, *%s, %s))\n\t}", value, path, value, enumValues))
+		case "[]string":
+			checks = append(checks, fmt.Sprintf("for i, item := range %s {\n\t\terrs = append(errs, bindings.Enum(bindings.Index(%s, i), item, %s))\n\t}", value, path, enumValues))
+		default:
+			return nil, fmt.Errorf("+binding:enum isn't supported on %s field %s", field.Type, field.Name)
+		}
+	}
+
+	if markers.Min != "" || markers.Max != "" {
+		if !numericTypes[baseType] {
+			return nil, fmt.Errorf("+binding:min and +binding:max aren't supported on %s field %s", field.Type, field.Name)
+		}
+		var ranges []string
+		number := value
+		if pointer {
+			number = "*" + value
+		}
+		if markers.Min != "" {
+			ranges = append(ranges, fmt.Sprintf("errs = append(errs, bindings.Min(%s, %s, %s))", path, number, markers.Min))
+		}
+		if markers.Max != "" {
+			ranges = append(ranges, fmt.Sprintf("errs = append(errs, bindings.Max(%s, %s, %s))", path, number, markers.Max))
+		}
+		// an unset optional number is nil, or zero when it isn't a pointer
+		switch {
+		case pointer:
+			checks = append(checks, fmt.Sprintf("if %s != nil {\n\t\t%s\n\t}", value, strings.Join(ranges, "\n\t\t")))
+		case markers.Required:
+			checks = append(checks, ranges...)
+		default:
+			checks = append(checks, fmt.Sprintf("if %s != 0 {\n\t\t%s\n\t}", value, strings.Join(ranges, "\n\t\t")))
+		}
+	}
+
+	if validated[elemType(field.Type)] {
+		switch {
+		case strings.HasPrefix(field.Type, "[]*"):
+			checks = append(checks, fmt.Sprintf("for i, item := range %s {\n\t\tif item != nil {\n\t\t\terrs = append(errs, bindings.Nest(bindings.Index(%s, i), item.Validate()))\n\t\t}\n\t}", value, path))
+		case strings.HasPrefix(field.Type, "[]"):
+			checks = append(checks, fmt.Sprintf("for i, item := range %s {\n\t\terrs = append(errs, bindings.Nest(bindings.Index(%s, i), item.Validate()))\n\t}", value, path))
+		// map values are validated in key order so errors are reported in
+		// the same order every time
+		case strings.HasPrefix(field.Type, "map[") && strings.Contains(field.Type, "]*"):
+			checks = append(checks, fmt.Sprintf("for _, k := range bindings.SortedKeys(%s) {\n\t\tif v := %s[k]; v != nil {\n\t\t\terrs = append(errs, bindings.Nest(%s+\".\"+k, v.Validate()))\n\t\t}\n\t}", value, value, path))
+		case strings.HasPrefix(field.Type, "map["):
+			checks = append(checks, fmt.Sprintf("for _, k := range bindings.SortedKeys(%s) {\n\t\terrs = append(errs, bindings.Nest(%s+\".\"+k, %s[k].Validate()))\n\t}", value, path, value))
+		case pointer:
+			checks = append(checks, fmt.Sprintf("if %s != nil {\n\t\terrs = append(errs, bindings.Nest(%s, %s.Validate()))\n\t}", value, path, value))
+		default:
+			checks = append(checks, fmt.Sprintf("errs = append(errs, bindings.Nest(%s, %s.Validate()))", path, value))
+		}
+	}
+	return checks, nil
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindMarkers(t *testing.T) {
+	src := `package test
+
+type Queue struct {
+	// Name is the name of the queue
+	// +binding:required
+	// +binding:enum=a;b
+	Name string
+	Size int // +binding:min=1
+	// +binding:max=2.5
+	Ratio float64
+	// +binding:unknown
+	Other string
+	// +binding:min=one
+	Count int
+}
+`
+	file, err := parser.ParseFile(token.NewFileSet(), "test.go", src, parser.ParseComments)
+	require.NoError(t, err)
+	fields := file.Scope.Lookup("Queue").Decl.(*ast.TypeSpec).Type.(*ast.StructType).Fields.List
+
+	markers, err := findMarkers(fields[0])
+	require.NoError(t, err)
+	assert.Equal(t, Markers{Required: true, Enum: []string{"a", "b"}}, markers)
+
+	markers, err = findMarkers(fields[1])
+	require.NoError(t, err)
+	assert.Equal(t, Markers{Min: "1"}, markers)
+
+	markers, err = findMarkers(fields[2])
+	require.NoError(t, err)
+	assert.Equal(t, Markers{Max: "2.5"}, markers)
+
+	_, err = findMarkers(fields[3])
+	assert.EqualError(t, err, "unknown marker +binding:unknown")
+
+	_, err = findMarkers(fields[4])
+	assert.EqualError(t, err, `+binding:min value "one" isn't a number`)
+}
+
+func TestElemType(t *testing.T) {
+	assert.Equal(t, "Queue", elemType("Queue"))
+	assert.Equal(t, "Queue", elemType("*Queue"))
+	assert.Equal(t, "Queue", elemType("[]*Queue"))
+	assert.Equal(t, "Queue", elemType("map[string]Queue"))
+}
+
+func TestValidateChecks(t *testing.T) {
+	validated := map[string]bool{"Queue": true}
+	tests := []struct {
+		name     string
+		field    Field
+		markers  Markers
+		expected []string
+	}{
+		{
+			name:     "required string",
+			field:    Field{Name: "Name", Type: "string", JsonTag: "name"},
+			markers:  Markers{Required: true},
+			expected: []string{`errs = append(errs, bindings.Required("name", t.Name != ""))`},
+		},
+		{
+			name:    "optional enum pointer",
+			field:   Field{Name: "Type", Type: "*string", JsonTag: "type"},
+			markers: Markers{Enum: []string{"a", "b"}},
+			expected: []string{"if t.Type != nil {\n\t\t" +
+				`errs = append(errs, bindings.Enum("type", *t.Type, "a", "b"))` + "\n\t}"},
+		},
+		{
+			name:    "optional range",
+			field:   Field{Name: "QoS", Type: "int", JsonTag: "qos"},
+			markers: Markers{Min: "0", Max: "2"},
+			expected: []string{"if t.QoS != 0 {\n\t\t" +
+				`errs = append(errs, bindings.Min("qos", t.QoS, 0))` + "\n\t\t" +
+				`errs = append(errs, bindings.Max("qos", t.QoS, 2))` + "\n\t}"},
+		},
+		{
+			name:  "nested slice",
+			field: Field{Name: "Queues", Type: "[]Queue", JsonTag: "queues"},
+			expected: []string{"for i, item := range t.Queues {\n\t\t" +
+				`errs = append(errs, bindings.Nest(bindings.Index("queues", i), item.Validate()))` + "\n\t}"},
+		},
+		{
+			name:  "nested map",
+			field: Field{Name: "Queues", Type: "map[string]*Queue", JsonTag: "queues"},
+			expected: []string{"for _, k := range bindings.SortedKeys(t.Queues) {\n\t\t" +
+				"if v := t.Queues[k]; v != nil {\n\t\t\t" +
+				`errs = append(errs, bindings.Nest("queues"+"."+k, v.Validate()))` + "\n\t\t}\n\t}"},
+		},
+		{
+			name:  "unvalidated struct",
+			field: Field{Name: "Other", Type: "*Other", JsonTag: "other"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checks, err := validateChecks(tt.field, tt.markers, validated)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, checks)
+		})
+	}
+}
+
+func TestValidateChecks_Unsupported(t *testing.T) {
+	_, err := validateChecks(Field{Name: "Size", Type: "int", JsonTag: "size"}, Markers{Enum: []string{"1"}}, nil)
+	assert.Error(t, err)
+
+	_, err = validateChecks(Field{Name: "Name", Type: "string", JsonTag: "name"}, Markers{Min: "1"}, nil)
+	assert.Error(t, err)
+}
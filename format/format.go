@@ -0,0 +1,271 @@
+// Package format formats AsyncAPI documents canonically, so that documents
+// are written the same way whichever tool or person last edited them.
+//
+// Fields are ordered the way the specification lists them, followed by
+// unknown fields and then specification extensions (x-*), each in the order
+// they appear. The keys of maps such as channels, and of free-form values
+// such as schemas and bindings, keep their order. YAML is written in block
+// style with two space indentation, quoting strings only where YAML needs it,
+// and booleans, numbers and nulls are written in their canonical form.
+// Comments, refs and anchors are kept.
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charlie-haley/asyncapi-go/asyncapi2"
+	"github.com/charlie-haley/asyncapi-go/internal/ordered"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNotAsyncAPI is returned for documents without an asyncapi field
+var ErrNotAsyncAPI = errors.New("not an AsyncAPI document")
+
+// Source formats a JSON or YAML document, keeping its format
+func Source(data []byte) ([]byte, error) {
+	if isJSON(data) {
+		return JSON(data)
+	}
+	return YAML(data)
+}
+
+// YAML formats a JSON or YAML document as YAML
+func YAML(data []byte) ([]byte, error) {
+	doc, err := parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to write YAML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write YAML: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// JSON formats a JSON or YAML document as JSON. Comments are dropped, and
+// anchors and merge keys expanded.
+func JSON(data []byte) ([]byte, error) {
+	doc, err := parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var compact bytes.Buffer
+	if err := ordered.NodeToJSON(&compact, doc); err != nil {
+		return nil, fmt.Errorf("failed to write JSON: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, compact.Bytes(), "", "  "); err != nil {
+		return nil, fmt.Errorf("failed to write JSON: %w", err)
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// parse reads a document and formats it in place
+func parse(data []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse document: %w", err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, ErrNotAsyncAPI
+	}
+
+	root := doc.Content[0]
+	version := mappingValue(root, "asyncapi")
+	if version == nil {
+		return nil, ErrNotAsyncAPI
+	}
+	if !strings.HasPrefix(version.Value, "2.") {
+		return nil, fmt.Errorf("unsupported AsyncAPI version: %s", version.Value)
+	}
+
+	// A comment at the top of the file stays there when fields are moved
+	header := root.Content[0].HeadComment
+	root.Content[0].HeadComment = ""
+	if err := formatNode(root, nil); err != nil {
+		return nil, err
+	}
+	if header != "" {
+		root.Content[0].HeadComment = strings.TrimSpace(header + "\n" + root.Content[0].HeadComment)
+	}
+	doc.Style = 0
+	return &doc, nil
+}
+
+// formatNode orders the fields of objects and normalizes the style of
+// values below node, which is at path in the document
+func formatNode(node *yaml.Node, path []string) error {
+	node.Style = 0
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		if fields, ok := asyncapi2.FieldOrder(path...); ok || mappingValue(node, "$ref") != nil {
+			sortFields(node, fields)
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if err := formatNode(key, nil); err != nil {
+				return err
+			}
+			if err := formatNode(node.Content[i+1], appendPath(path, key.Value)); err != nil {
+				return err
+			}
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			if err := formatNode(item, appendPath(path, strconv.Itoa(i))); err != nil {
+				return err
+			}
+		}
+	case yaml.ScalarNode:
+		return formatScalar(node)
+	}
+	return nil
+}
+
+// formatScalar writes booleans, numbers and nulls in canonical form, and
+// double quotes strings that can't be written plain
+func formatScalar(node *yaml.Node) error {
+	switch node.ShortTag() {
+	case "!!str":
+		quoted, err := needsQuotes(node.Value)
+		if err != nil {
+			return err
+		}
+		if quoted {
+			node.Style = yaml.DoubleQuotedStyle
+		}
+	case "!!null":
+		node.Value = "null"
+	case "!!bool":
+		var value bool
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+		node.Value = strconv.FormatBool(value)
+	case "!!int", "!!float":
+		if decimalInteger.MatchString(node.Value) {
+			// Kept as is, as YAML reads integers too large for 64 bits as
+			// floats
+			return nil
+		}
+		if node.ShortTag() == "!!float" {
+			return formatFloat(node)
+		}
+		var value any
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+		node.Value = fmt.Sprint(value)
+	}
+	return nil
+}
+
+// decimalInteger matches integers written in canonical form
+var decimalInteger = regexp.MustCompile(`^-?(0|[1-9][0-9]*)$`)
+
+func formatFloat(node *yaml.Node) error {
+	var value float64
+	if err := node.Decode(&value); err != nil {
+		return err
+	}
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		// JSON can't hold these, so they're left for validation
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	node.Value = string(data)
+	if !strings.ContainsAny(node.Value, ".e") {
+		// Keep floats floats, as an integer would change the type in YAML
+		node.Value += ".0"
+	}
+	return nil
+}
+
+// needsQuotes reports whether YAML would quote a string, with whichever
+// quotes it prefers, rather than write it plain or as a block scalar. Strings
+// that YAML 1.1 reads as other types, such as yes and no, are quoted too.
+func needsQuotes(value string) (bool, error) {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+	return data[0] == '\'' || data[0] == '"', nil
+}
+
+// sortFields orders the entries of a mapping: $ref first, then the fields in
+// the order given, then unknown keys and then extensions, in their order
+func sortFields(node *yaml.Node, fields []string) {
+	rank := func(key string) int {
+		switch {
+		case key == "$ref":
+			return -1
+		case strings.HasPrefix(key, "x-"):
+			return len(fields) + 1
+		}
+		for i, field := range fields {
+			if field == key {
+				return i
+			}
+		}
+		return len(fields)
+	}
+
+	type entry struct {
+		key, value *yaml.Node
+		rank       int
+	}
+	entries := make([]entry, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		entries = append(entries, entry{node.Content[i], node.Content[i+1], rank(node.Content[i].Value)})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].rank < entries[j].rank
+	})
+
+	node.Content = node.Content[:0]
+	for _, e := range entries {
+		node.Content = append(node.Content, e.key, e.value)
+	}
+}
+
+// mappingValue returns the value of a key in a mapping, or nil
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// appendPath appends a segment to a copy of path
+func appendPath(path []string, segment string) []string {
+	out := make([]string, len(path), len(path)+1)
+	copy(out, path)
+	return append(out, segment)
+}
+
+// isJSON reports whether a document is JSON rather than YAML
+func isJSON(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte("{"))
+}
@@ -0,0 +1,141 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestYAML(t *testing.T) {
+	source := `# Header comment
+x-owner: team
+info:
+  version: '1.0.0'
+  title: "Example"
+channels:
+  user/signedup:
+    subscribe:
+      message:
+        payload:
+          type: object
+          properties:
+            zebra: {type: string}
+            apple: {type: integer, minimum: 1.50, maximum: 0x10}
+    description: Signed up # line comment
+asyncapi: 2.6.0
+components:
+  messages:
+    Signup:
+      x-kind: event
+      payload:
+        $ref: "#/components/schemas/User"
+        description: ignored
+      name: Signup
+      deprecated: True
+      headers: ~
+`
+	expected := `# Header comment
+asyncapi: 2.6.0
+info:
+  title: Example
+  version: 1.0.0
+channels:
+  user/signedup:
+    description: Signed up # line comment
+    subscribe:
+      message:
+        payload:
+          type: object
+          properties:
+            zebra:
+              type: string
+            apple:
+              type: integer
+              minimum: 1.5
+              maximum: 16
+components:
+  messages:
+    Signup:
+      headers: null
+      payload:
+        $ref: "#/components/schemas/User"
+        description: ignored
+      name: Signup
+      deprecated: true
+      x-kind: event
+x-owner: team
+`
+	out, err := YAML([]byte(source))
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(out))
+
+	again, err := YAML(out)
+	require.NoError(t, err)
+	assert.Equal(t, string(out), string(again), "formatting should be idempotent")
+}
+
+func TestYAML_Scalars(t *testing.T) {
+	source := `asyncapi: 2.6.0
+info:
+  title: "yes"
+  version: "1.0"
+x-values:
+  float: 2.
+  exponent: 1e3
+  quoted: 'it''s: here'
+  octal: 0o17
+  big: 123456789012345678901234
+  bigHex: 0xFFFFFFFFFFFFFFFF
+`
+	expected := `asyncapi: 2.6.0
+info:
+  title: "yes"
+  version: "1.0"
+x-values:
+  float: 2.0
+  exponent: 1000.0
+  quoted: "it's: here"
+  octal: 15
+  big: 123456789012345678901234
+  bigHex: 18446744073709551615
+`
+	out, err := YAML([]byte(source))
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(out))
+}
+
+func TestJSON(t *testing.T) {
+	source := `{"info": {"version": "1.0.0", "title": "Example"}, "asyncapi": "2.6.0", "x-note": "<b>", "channels": {}}`
+	expected := `{
+  "asyncapi": "2.6.0",
+  "info": {
+    "title": "Example",
+    "version": "1.0.0"
+  },
+  "channels": {},
+  "x-note": "<b>"
+}
+`
+	out, err := Source([]byte(source))
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(out))
+
+	again, err := Source(out)
+	require.NoError(t, err)
+	assert.Equal(t, string(out), string(again), "formatting should be idempotent")
+}
+
+func TestSource_Errors(t *testing.T) {
+	_, err := Source([]byte("name: not a spec\n"))
+	assert.ErrorIs(t, err, ErrNotAsyncAPI)
+
+	_, err = Source([]byte("- a\n- b\n"))
+	assert.ErrorIs(t, err, ErrNotAsyncAPI)
+
+	_, err = Source([]byte(`{"asyncapi": "3.0.0"}`))
+	assert.EqualError(t, err, "unsupported AsyncAPI version: 3.0.0")
+
+	_, err = Source([]byte("asyncapi: [\n"))
+	assert.ErrorContains(t, err, "failed to parse document")
+}
@@ -0,0 +1,163 @@
+// Package ordered decodes JSON and YAML documents into generic values while
+// recording the order of their keys, which Go maps lose, and encodes the
+// values back in that order.
+package ordered
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Keys maps the JSON pointer of each object in a document to its keys, in
+// the order they appear
+type Keys map[string][]string
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// DecodeJSON decodes a JSON document into the same values as json.Unmarshal
+// into an interface{}, except that numbers are json.Numbers so they keep
+// their exact value, also returning the order of the keys of its objects
+func DecodeJSON(data []byte) (any, Keys, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	keys := make(Keys)
+	value, err := decodeValue(dec, "", keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("invalid data after top-level value at offset %d", dec.InputOffset())
+	}
+	return value, keys, nil
+}
+
+func decodeValue(dec *json.Decoder, pointer string, keys Keys) (any, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		obj := make(map[string]any)
+		var order []string
+		for dec.More() {
+			keyToken, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key := keyToken.(string)
+			value, err := decodeValue(dec, pointer+"/"+pointerEscaper.Replace(key), keys)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := obj[key]; !ok {
+				order = append(order, key)
+			}
+			obj[key] = value
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		keys[pointer] = order
+		return obj, nil
+	case json.Delim('['):
+		arr := []any{}
+		for i := 0; dec.More(); i++ {
+			value, err := decodeValue(dec, pointer+"/"+strconv.Itoa(i), keys)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, value)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	default:
+		return token, nil
+	}
+}
+
+// Marshal encodes v like json.Marshal, writing the keys of each object in
+// the order recorded for its pointer. Keys without a recorded order, such as
+// those of objects inlined from refs, follow in sorted order.
+func (k Keys) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := k.encode(&buf, v, ""); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (k Keys) encode(buf *bytes.Buffer, v any, pointer string) error {
+	switch v := v.(type) {
+	case map[string]any:
+		buf.WriteByte('{')
+		for i, key := range k.order(v, pointer) {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			keyData, err := json.Marshal(key)
+			if err != nil {
+				return err
+			}
+			buf.Write(keyData)
+			buf.WriteByte(':')
+			if err := k.encode(buf, v[key], pointer+"/"+pointerEscaper.Replace(key)); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case []any:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := k.encode(buf, item, pointer+"/"+strconv.Itoa(i)); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+// order returns the keys of obj, those recorded for pointer first
+func (k Keys) order(obj map[string]any, pointer string) []string {
+	order := make([]string, 0, len(obj))
+	for _, key := range k[pointer] {
+		if _, ok := obj[key]; ok {
+			order = append(order, key)
+		}
+	}
+	if len(order) == len(obj) {
+		return order
+	}
+
+	recorded := make(map[string]bool, len(order))
+	for _, key := range order {
+		recorded[key] = true
+	}
+	var rest []string
+	for key := range obj {
+		if !recorded[key] {
+			rest = append(rest, key)
+		}
+	}
+	sort.Strings(rest)
+	return append(order, rest...)
+}
@@ -0,0 +1,75 @@
+package ordered
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeJSON(t *testing.T) {
+	data := []byte(`{"z": 1, "a": {"y": [{"b": true, "a~/": null}], "x": "s"}, "big": 12345678901234567890}`)
+	value, keys, err := DecodeJSON(data)
+	require.NoError(t, err)
+
+	var expected any
+	require.NoError(t, jsonnumber.Unmarshal(data, &expected))
+	assert.Equal(t, expected, value)
+	assert.Equal(t, json.Number("12345678901234567890"), value.(map[string]any)["big"])
+	assert.Equal(t, Keys{
+		"":       {"z", "a", "big"},
+		"/a":     {"y", "x"},
+		"/a/y/0": {"b", "a~/"},
+	}, keys)
+
+	out, err := keys.Marshal(value)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(out))
+	assert.Equal(t, `{"z":1,"a":{"y":[{"b":true,"a~/":null}],"x":"s"},"big":12345678901234567890}`, string(out))
+
+	_, _, err = DecodeJSON([]byte(`{"a": 1} {}`))
+	assert.Error(t, err)
+	_, _, err = DecodeJSON([]byte(`{"a": `))
+	assert.Error(t, err)
+}
+
+func TestKeys_Marshal_Unrecorded(t *testing.T) {
+	keys := Keys{"": {"b", "gone"}}
+	out, err := keys.Marshal(map[string]any{"c": 1, "a": map[string]any{"z": 1, "y": 2}, "b": 3})
+	require.NoError(t, err)
+	assert.Equal(t, `{"b":3,"a":{"y":2,"z":1},"c":1}`, string(out))
+}
+
+func TestYAMLToJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		yaml     string
+		expected string
+	}{
+		{name: "order", yaml: "z: 1\na:\n  y: [1, 2.5]\n  b: ~\n", expected: `{"z":1,"a":{"y":[1,2.5],"b":null}}`},
+		{name: "yaml 1.2 booleans", yaml: "y: on\nn: true\n", expected: `{"y":"on","n":true}`},
+		{name: "non-string keys", yaml: "200: ok\ntrue: yes\n", expected: `{"200":"ok","true":"yes"}`},
+		{name: "timestamps stay strings", yaml: "date: 2024-01-02\n", expected: `{"date":"2024-01-02"}`},
+		{name: "aliases and merge keys", yaml: "base: &base {a: 1, b: 2}\nother:\n  <<: *base\n  b: 3\n  c: *base\n", expected: `{"base":{"a":1,"b":2},"other":{"a":1,"b":3,"c":{"a":1,"b":2}}}`},
+		{name: "large numbers", yaml: "a: 12345678901234567890\nb: -1.5e+300\nc: 1.50\n", expected: `{"a":12345678901234567890,"b":-1.5e+300,"c":1.50}`},
+		{name: "other number forms", yaml: "a: 0x10\nb: 0o17\nc: +1\nd: .5\n", expected: `{"a":16,"b":15,"c":1,"d":0.5}`},
+		{name: "empty", yaml: "", expected: `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := YAMLToJSON([]byte(tt.yaml))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(out))
+		})
+	}
+}
+
+func TestYAMLToJSON_Errors(t *testing.T) {
+	for _, data := range []string{"a: 1\na: 2\n", "? [a]\n: 1\n", "a: .inf\n", "a: [\n"} {
+		_, err := YAMLToJSON([]byte(data))
+		assert.Error(t, err, data)
+	}
+}
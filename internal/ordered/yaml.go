@@ -0,0 +1,204 @@
+package ordered
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YAMLToJSON converts the first document in data to JSON, keeping the order
+// of mapping keys. Aliases and merge keys are expanded.
+func YAMLToJSON(data []byte) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := NodeToJSON(&buf, &doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// NodeToJSON writes a YAML node as JSON, keeping the order of mapping keys.
+// Aliases and merge keys are expanded.
+func NodeToJSON(buf *bytes.Buffer, node *yaml.Node) error {
+	switch node.Kind {
+	case 0:
+		// An empty document
+		buf.WriteString("null")
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			buf.WriteString("null")
+			return nil
+		}
+		return NodeToJSON(buf, node.Content[0])
+	case yaml.AliasNode:
+		return NodeToJSON(buf, node.Alias)
+	case yaml.SequenceNode:
+		buf.WriteByte('[')
+		for i, item := range node.Content {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := NodeToJSON(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case yaml.MappingNode:
+		entries, err := mappingEntries(node)
+		if err != nil {
+			return err
+		}
+		buf.WriteByte('{')
+		for i, entry := range entries {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSON(buf, entry.key); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := NodeToJSON(buf, entry.value); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case yaml.ScalarNode:
+		return scalarToJSON(buf, node)
+	default:
+		return fmt.Errorf("line %d: unsupported YAML node kind %d", node.Line, node.Kind)
+	}
+	return nil
+}
+
+// jsonNumber matches numbers written the way JSON writes them
+var jsonNumber = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+func scalarToJSON(buf *bytes.Buffer, node *yaml.Node) error {
+	var value any
+	switch node.ShortTag() {
+	case "!!null":
+	case "!!int", "!!float":
+		if jsonNumber.MatchString(node.Value) {
+			// Written as is, as integers too large for an int64 would
+			// otherwise lose precision as a float64
+			buf.WriteString(node.Value)
+			return nil
+		}
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+	case "!!bool":
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+	default:
+		// Strings, and timestamps and custom tags which JSON can only hold
+		// as their text
+		value = node.Value
+	}
+
+	if err := writeJSON(buf, value); err != nil {
+		return fmt.Errorf("line %d: failed to convert %q to JSON: %w", node.Line, node.Value, err)
+	}
+	return nil
+}
+
+// writeJSON writes a value as JSON without escaping HTML characters, which
+// only matters when JSON is embedded in HTML
+func writeJSON(buf *bytes.Buffer, value any) error {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return err
+	}
+	// Encode ends each value with a newline
+	buf.Truncate(buf.Len() - 1)
+	return nil
+}
+
+type mappingEntry struct {
+	key   string
+	value *yaml.Node
+}
+
+// mappingEntries returns the entries of a mapping in order, with the entries
+// of merged mappings in place of their merge key unless the mapping sets the
+// same key itself
+func mappingEntries(node *yaml.Node) ([]mappingEntry, error) {
+	explicit := make(map[string]bool)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i]
+		if key.ShortTag() == "!!merge" {
+			continue
+		}
+		name, err := mappingKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if explicit[name] {
+			return nil, fmt.Errorf("line %d: mapping key %q already defined", key.Line, name)
+		}
+		explicit[name] = true
+	}
+
+	var entries []mappingEntry
+	seen := make(map[string]bool)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if key.ShortTag() != "!!merge" {
+			name, _ := mappingKey(key)
+			entries = append(entries, mappingEntry{key: name, value: value})
+			seen[name] = true
+			continue
+		}
+
+		merged := []*yaml.Node{value}
+		if resolveAlias(value).Kind == yaml.SequenceNode {
+			merged = resolveAlias(value).Content
+		}
+		for _, m := range merged {
+			m = resolveAlias(m)
+			if m.Kind != yaml.MappingNode {
+				return nil, fmt.Errorf("line %d: map merge requires a mapping or a sequence of mappings", m.Line)
+			}
+			mEntries, err := mappingEntries(m)
+			if err != nil {
+				return nil, err
+			}
+			for _, entry := range mEntries {
+				if explicit[entry.key] || seen[entry.key] {
+					continue
+				}
+				entries = append(entries, entry)
+				seen[entry.key] = true
+			}
+		}
+	}
+	return entries, nil
+}
+
+// mappingKey returns a mapping key as a JSON object key
+func mappingKey(key *yaml.Node) (string, error) {
+	key = resolveAlias(key)
+	if key.Kind != yaml.ScalarNode {
+		return "", fmt.Errorf("line %d: mapping keys must be scalars to convert to JSON", key.Line)
+	}
+	if key.ShortTag() == "!!null" {
+		return "null", nil
+	}
+	return key.Value, nil
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
@@ -1,21 +1,31 @@
 package refresolver
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
-	"sigs.k8s.io/yaml"
+	"github.com/charlie-haley/asyncapi-go/internal/ordered"
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
 )
 
 type RefResolver struct {
-	Cache       map[string]interface{}
+	Cache map[string]interface{}
+	// Shared, if set, caches decoded files and remote documents across resolvers
+	Shared *SharedCache
+	// OnLoad, if set, is called with the path or URL of every file or remote
+	// document the resolver reads or takes from Shared
+	OnLoad      func(location string)
 	basePath    string
 	currentFile string
+	// fsys, if set, is read for file refs instead of the OS file system
+	fsys fs.FS
 }
 
 func New(basePath string) *RefResolver {
@@ -25,11 +35,21 @@ func New(basePath string) *RefResolver {
 	}
 }
 
-func (r *RefResolver) ResolveRefs(doc interface{}) (interface{}, error) {
-	return r.resolveRefsRecursive(doc, make(map[string]bool))
+// NewFS creates a resolver that reads file refs from fsys, relative to
+// basePath, a slash separated directory within fsys
+func NewFS(fsys fs.FS, basePath string) *RefResolver {
+	r := New(basePath)
+	r.fsys = fsys
+	return r
 }
 
-func (r *RefResolver) resolveRefsRecursive(v interface{}, visited map[string]bool) (interface{}, error) {
+// ResolveRefs replaces every $ref in doc with the value it references. ctx
+// applies to the whole resolution, including fetching remote refs.
+func (r *RefResolver) ResolveRefs(ctx context.Context, doc interface{}) (interface{}, error) {
+	return r.resolveRefsRecursive(ctx, doc, make(map[string]bool))
+}
+
+func (r *RefResolver) resolveRefsRecursive(ctx context.Context, v interface{}, visited map[string]bool) (interface{}, error) {
 	switch val := v.(type) {
 	case map[string]interface{}:
 		if ref, ok := val["$ref"]; ok {
@@ -46,17 +66,17 @@ func (r *RefResolver) resolveRefsRecursive(v interface{}, visited map[string]boo
 			newVisited := copyVisitedMap(visited)
 			newVisited[refStr] = true
 
-			resolved, err := r.resolveRef(refStr)
+			resolved, err := r.resolveRef(ctx, refStr)
 			if err != nil {
 				return nil, err
 			}
 
-			return r.resolveRefsRecursive(resolved, newVisited)
+			return r.resolveRefsRecursive(ctx, resolved, newVisited)
 		}
 
 		result := make(map[string]interface{})
 		for k, v := range val {
-			resolved, err := r.resolveRefsRecursive(v, visited)
+			resolved, err := r.resolveRefsRecursive(ctx, v, visited)
 			if err != nil {
 				return nil, err
 			}
@@ -67,7 +87,7 @@ func (r *RefResolver) resolveRefsRecursive(v interface{}, visited map[string]boo
 	case []interface{}:
 		result := make([]interface{}, len(val))
 		for i, v := range val {
-			resolved, err := r.resolveRefsRecursive(v, visited)
+			resolved, err := r.resolveRefsRecursive(ctx, v, visited)
 			if err != nil {
 				return nil, err
 			}
@@ -89,10 +109,13 @@ func copyVisitedMap(visited map[string]bool) map[string]bool {
 	return newVisited
 }
 
-func (r *RefResolver) resolveRef(ref string) (interface{}, error) {
+func (r *RefResolver) resolveRef(ctx context.Context, ref string) (interface{}, error) {
 	if cached, ok := r.Cache[ref]; ok {
 		return cached, nil
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", ref, err)
+	}
 
 	var resolved interface{}
 	var err error
@@ -101,9 +124,11 @@ func (r *RefResolver) resolveRef(ref string) (interface{}, error) {
 	case strings.HasPrefix(ref, "#"):
 		resolved, err = r.resolveLocalRef(ref)
 	case strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://"):
-		resolved, err = r.resolveRemoteRef(ref)
+		resolved, err = r.resolveRemoteRef(ctx, ref)
+	case r.fsys != nil:
+		resolved, err = r.resolveFSRef(ctx, ref)
 	default:
-		resolved, err = r.resolveFileRef(ref)
+		resolved, err = r.resolveFileRef(ctx, ref)
 	}
 
 	if err != nil {
@@ -139,7 +164,7 @@ func (r *RefResolver) resolveLocalRef(ref string) (interface{}, error) {
 	return nil, fmt.Errorf("failed to resolve reference: %s", ref)
 }
 
-func (r *RefResolver) resolveFileRef(ref string) (interface{}, error) {
+func (r *RefResolver) resolveFileRef(ctx context.Context, ref string) (interface{}, error) {
 	var absPath string
 	if filepath.IsAbs(ref) {
 		absPath = ref
@@ -155,47 +180,138 @@ func (r *RefResolver) resolveFileRef(ref string) (interface{}, error) {
 		r.currentFile = prevFile
 	}()
 
-	data, err := os.ReadFile(absPath)
+	// Key by absolute path, so the same file reached through different
+	// relative refs is shared
+	key := absPath
+	if abs, err := filepath.Abs(absPath); err == nil {
+		key = abs
+	}
+	doc, err := r.load(ctx, "file:"+key, absPath, func() (interface{}, error) {
+		data, err := os.ReadFile(absPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file %s: %w", absPath, err)
+		}
+		return decodeFile(absPath, data)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to read file %s: %w", absPath, err)
+		return nil, err
 	}
+	return r.resolveLoaded(ctx, absPath, doc)
+}
 
-	var doc interface{}
-	if err := json.Unmarshal(data, &doc); err != nil {
-		if err := yaml.Unmarshal(data, &doc); err != nil {
-			return nil, fmt.Errorf("failed to parse file %s as JSON or YAML: %w", absPath, err)
-		}
+// resolveFSRef reads a file ref from the resolver's fs.FS. Refs are slash
+// separated paths relative to the referencing file and can't leave the FS.
+func (r *RefResolver) resolveFSRef(ctx context.Context, ref string) (interface{}, error) {
+	dir := r.basePath
+	if r.currentFile != "" {
+		dir = path.Dir(r.currentFile)
+	}
+	name := path.Join(dir, ref)
+	if !fs.ValidPath(name) {
+		return nil, fmt.Errorf("failed to read file %s: reference is outside the file system", ref)
 	}
 
-	resolved, err := r.resolveRefsRecursive(doc, make(map[string]bool))
+	prevFile := r.currentFile
+	r.currentFile = name
+	defer func() {
+		r.currentFile = prevFile
+	}()
+
+	doc, err := r.load(ctx, "fs:"+name, name, func() (interface{}, error) {
+		data, err := fs.ReadFile(r.fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file %s: %w", name, err)
+		}
+		return decodeFile(name, data)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to resolve references in %s: %w", absPath, err)
+		return nil, err
 	}
+	return r.resolveLoaded(ctx, name, doc)
+}
 
-	return resolved, nil
+func (r *RefResolver) resolveRemoteRef(ctx context.Context, ref string) (interface{}, error) {
+	doc, err := r.load(ctx, ref, ref, func() (interface{}, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, ref, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s: %w", ref, err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s: %w", ref, err)
+		}
+		defer resp.Body.Close()
+
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response from %s: %w", ref, err)
+		}
+		if isSourceFile(ref) {
+			return string(data), nil
+		}
+
+		var doc interface{}
+		if err := jsonnumber.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse response from %s: %w", ref, err)
+		}
+		return doc, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.resolveLoaded(ctx, ref, doc)
 }
 
-func (r *RefResolver) resolveRemoteRef(ref string) (interface{}, error) {
-	resp, err := http.Get(ref)
+// load reads a file or remote document with read, through the shared cache
+// if there is one, and reports its location to OnLoad
+func (r *RefResolver) load(ctx context.Context, key, location string, read func() (interface{}, error)) (interface{}, error) {
+	var doc interface{}
+	var err error
+	if r.Shared != nil {
+		doc, err = r.Shared.load(ctx, key, read)
+	} else {
+		doc, err = read()
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch %s: %w", ref, err)
+		return nil, err
 	}
-	defer resp.Body.Close()
+	if r.OnLoad != nil {
+		r.OnLoad(location)
+	}
+	return doc, nil
+}
 
-	data, err := io.ReadAll(resp.Body)
+// resolveLoaded resolves the refs within a loaded file or remote document
+func (r *RefResolver) resolveLoaded(ctx context.Context, location string, doc interface{}) (interface{}, error) {
+	resolved, err := r.resolveRefsRecursive(ctx, doc, make(map[string]bool))
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response from %s: %w", ref, err)
+		return nil, fmt.Errorf("failed to resolve references in %s: %w", location, err)
 	}
+	return resolved, nil
+}
 
-	var doc interface{}
-	if err := json.Unmarshal(data, &doc); err != nil {
-		return nil, fmt.Errorf("failed to parse response from %s: %w", ref, err)
+// decodeFile decodes a referenced file as JSON or YAML, or returns it as a
+// string if it holds schema source text
+func decodeFile(name string, data []byte) (interface{}, error) {
+	if isSourceFile(name) {
+		return string(data), nil
 	}
 
-	resolved, err := r.resolveRefsRecursive(doc, make(map[string]bool))
-	if err != nil {
-		return nil, fmt.Errorf("failed to resolve references in %s: %w", ref, err)
+	var doc interface{}
+	if err := jsonnumber.Unmarshal(data, &doc); err != nil {
+		jsonData, err := ordered.YAMLToJSON(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse file %s as JSON or YAML: %w", name, err)
+		}
+		if err := jsonnumber.Unmarshal(jsonData, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse file %s as JSON or YAML: %w", name, err)
+		}
 	}
+	return doc, nil
+}
 
-	return resolved, nil
+// isSourceFile reports whether a referenced file holds schema source text,
+// such as a .proto file, that is embedded as a string rather than parsed
+func isSourceFile(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".proto")
 }
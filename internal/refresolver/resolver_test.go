@@ -1,6 +1,7 @@
 package refresolver
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -81,7 +82,7 @@ func TestResolveLocalRef(t *testing.T) {
 	resolver := New("")
 	resolver.Cache["#"] = docMap
 
-	resolved, err := resolver.ResolveRefs(docMap)
+	resolved, err := resolver.ResolveRefs(context.Background(), docMap)
 	require.NoError(t, err)
 
 	// Convert back to Document
@@ -122,7 +123,7 @@ func TestResolveFileRef(t *testing.T) {
 	require.NoError(t, json.Unmarshal(data, &docMap))
 
 	resolver := New(tmpDir)
-	resolved, err := resolver.ResolveRefs(docMap)
+	resolved, err := resolver.ResolveRefs(context.Background(), docMap)
 	require.NoError(t, err)
 
 	// Convert back to Document
@@ -135,6 +136,20 @@ func TestResolveFileRef(t *testing.T) {
 	assert.Equal(t, "email", resolvedDoc.Schema.Format)
 }
 
+func TestResolveFileRef_ProtoSource(t *testing.T) {
+	tmpDir := t.TempDir()
+	source := "syntax = \"proto3\";\nmessage User { string id = 1; }\n"
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "user.proto"), []byte(source), 0644))
+
+	doc := map[string]interface{}{
+		"payload": map[string]interface{}{"$ref": "user.proto"},
+	}
+
+	resolved, err := New(tmpDir).ResolveRefs(context.Background(), doc)
+	require.NoError(t, err)
+	assert.Equal(t, source, resolved.(map[string]interface{})["payload"])
+}
+
 func TestResolveRemoteRef(t *testing.T) {
 	testSchema := &Schema{
 		Type:   "string",
@@ -159,7 +174,7 @@ func TestResolveRemoteRef(t *testing.T) {
 	require.NoError(t, json.Unmarshal(data, &docMap))
 
 	resolver := New("")
-	resolved, err := resolver.ResolveRefs(docMap)
+	resolved, err := resolver.ResolveRefs(context.Background(), docMap)
 	require.NoError(t, err)
 
 	// Convert back to Document
@@ -191,7 +206,7 @@ func TestCircularRef(t *testing.T) {
 	resolver := New("")
 	resolver.Cache["#"] = docMap
 
-	_, err = resolver.ResolveRefs(docMap)
+	_, err = resolver.ResolveRefs(context.Background(), docMap)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "circular reference")
 }
@@ -235,7 +250,7 @@ func TestNestedRefs(t *testing.T) {
 	resolver := New("")
 	resolver.Cache["#"] = docMap
 
-	resolved, err := resolver.ResolveRefs(docMap)
+	resolved, err := resolver.ResolveRefs(context.Background(), docMap)
 	require.NoError(t, err)
 
 	// Convert back to Document
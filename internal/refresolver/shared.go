@@ -0,0 +1,63 @@
+package refresolver
+
+import (
+	"context"
+	"sync"
+)
+
+// SharedCache holds decoded files and remote documents so that resolvers
+// parsing documents which reference the same files only read them once. It's
+// safe for concurrent use. Values are never modified by resolvers, since
+// resolution copies every object it descends into.
+type SharedCache struct {
+	mu      sync.Mutex
+	entries map[string]*sharedEntry
+}
+
+// sharedEntry is a cached value, which is being read until done is closed
+type sharedEntry struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// NewSharedCache creates an empty cache
+func NewSharedCache() *SharedCache {
+	return &SharedCache{entries: make(map[string]*sharedEntry)}
+}
+
+// load returns the cached value for key, calling read and caching its result
+// if there is none. Concurrent loads of the same key wait for a single read.
+// Errors aren't cached, so a cancelled or failed read is retried.
+func (c *SharedCache) load(ctx context.Context, key string, read func() (interface{}, error)) (interface{}, error) {
+	for {
+		c.mu.Lock()
+		entry, ok := c.entries[key]
+		if !ok {
+			entry = &sharedEntry{done: make(chan struct{})}
+			c.entries[key] = entry
+			c.mu.Unlock()
+
+			entry.value, entry.err = read()
+			if entry.err != nil {
+				c.mu.Lock()
+				delete(c.entries, key)
+				c.mu.Unlock()
+			}
+			close(entry.done)
+			return entry.value, entry.err
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if entry.err == nil {
+			return entry.value, nil
+		}
+		// The read failed, perhaps because its caller was cancelled, so try
+		// again. The failed entry has been removed, so this caller reads.
+	}
+}
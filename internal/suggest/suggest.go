@@ -0,0 +1,45 @@
+// Package suggest finds the names that misspelled ones were likely meant to be
+package suggest
+
+// Distance returns the Levenshtein distance between two strings, the number
+// of single character insertions, deletions and substitutions to turn one
+// into the other
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// Closest returns the candidate nearest to name, or an empty string if none
+// is close enough to be a plausible misspelling. Candidates are close enough
+// if they're at most two edits away, and fewer edits than half of name's
+// length. Ties go to the earliest candidate.
+func Closest(name string, candidates []string) string {
+	best, bestDistance := "", 0
+	for _, candidate := range candidates {
+		d := Distance(name, candidate)
+		if d > 2 || d*2 >= len([]rune(name)) {
+			continue
+		}
+		if best == "" || d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
@@ -0,0 +1,27 @@
+package suggest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistance(t *testing.T) {
+	assert.Equal(t, 0, Distance("channels", "channels"))
+	assert.Equal(t, 1, Distance("subcribe", "subscribe"))
+	assert.Equal(t, 1, Distance("bindngs", "bindings"))
+	assert.Equal(t, 2, Distance("pyaload", "payload"))
+	assert.Equal(t, 3, Distance("kitten", "sitting"))
+	assert.Equal(t, 4, Distance("", "info"))
+	assert.Equal(t, 1, Distance("café", "cafe"))
+}
+
+func TestClosest(t *testing.T) {
+	candidates := []string{"publish", "subscribe", "bindings", "servers", "parameters"}
+
+	assert.Equal(t, "subscribe", Closest("subcribe", candidates))
+	assert.Equal(t, "bindings", Closest("bindngs", candidates))
+	assert.Equal(t, "servers", Closest("server", candidates))
+	assert.Equal(t, "", Closest("topic", candidates))
+	assert.Equal(t, "", Closest("ab", []string{"ac"}), "one edit is too many for a two letter name")
+}
@@ -0,0 +1,279 @@
+package validation
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/asyncapi/spec-json-schemas/v6"
+	"github.com/charlie-haley/asyncapi-go/spec"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// BindingKind is the type of object a binding is attached to
+type BindingKind string
+
+const (
+	ServerBinding    BindingKind = "server"
+	ChannelBinding   BindingKind = "channel"
+	OperationBinding BindingKind = "operation"
+	MessageBinding   BindingKind = "message"
+)
+
+// Diagnostic codes reported by ValidateBinding
+const (
+	CodeBindingSchema         = "binding-schema"
+	CodeBindingVersionUnknown = "binding-version-unknown"
+)
+
+// latestBindingVersion is the bindingVersion value that explicitly requests the latest schema
+const latestBindingVersion = "latest"
+
+//go:embed bindings/*/*/*.json
+var bindingSchemaFS embed.FS
+
+// bindingDirs maps binding keys to the directory holding their schemas, for
+// protocols where the two differ.
+var bindingDirs = map[string]string{
+	"ws": "websockets",
+}
+
+var (
+	// definitions holds the shared schemas that binding schemas refer to by URL
+	definitionsOnce sync.Once
+	definitions     map[string]json.RawMessage
+	definitionsErr  error
+
+	// bindingSchemaCache holds compiled binding schemas keyed by their embedded path
+	bindingSchemaCache sync.Map
+)
+
+// BindingVersions returns the binding versions with a schema for the given
+// protocol and kind, from oldest to newest.
+func BindingVersions(protocol string, kind BindingKind) []string {
+	dir := bindingDir(protocol)
+	entries, err := bindingSchemaFS.ReadDir(path.Join("bindings", dir))
+	if err != nil {
+		return nil
+	}
+
+	var versions []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := bindingSchemaFS.Open(bindingSchemaPath(dir, entry.Name(), kind)); err == nil {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return CompareVersions(versions[i], versions[j]) < 0
+	})
+	return versions
+}
+
+// LatestBindingVersion returns the newest binding version with a schema for
+// the given protocol and kind, or an empty string if there is none.
+func LatestBindingVersion(protocol string, kind BindingKind) string {
+	versions := BindingVersions(protocol, kind)
+	if len(versions) == 0 {
+		return ""
+	}
+	return versions[len(versions)-1]
+}
+
+// ValidateBinding validates a single binding object against the official
+// schema for its declared bindingVersion, or the latest version when none is
+// declared. Bindings for protocols or kinds without a published schema are
+// skipped. Diagnostic paths are prefixed with basePath.
+func ValidateBinding(basePath string, protocol string, kind BindingKind, binding any) spec.Diagnostics {
+	versions := BindingVersions(protocol, kind)
+	if len(versions) == 0 {
+		return nil
+	}
+
+	version := versions[len(versions)-1]
+	if m, ok := binding.(map[string]any); ok {
+		switch declared, _ := m["bindingVersion"].(string); declared {
+		case "":
+		case latestBindingVersion:
+			// "latest" is not part of the schema's enum, validate as if omitted
+			binding = withoutKey(m, "bindingVersion")
+		default:
+			version = declared
+		}
+	}
+
+	if !containsString(versions, version) {
+		return spec.Diagnostics{{
+			Code:     CodeBindingVersionUnknown,
+			Severity: spec.SeverityWarning,
+			Path:     basePath + spec.JSONPointer("bindingVersion"),
+			Message:  fmt.Sprintf("no schema for %s %s binding version %s, known versions are %s", protocol, kind, version, strings.Join(versions, ", ")),
+		}}
+	}
+
+	schema, err := bindingSchema(bindingSchemaPath(bindingDir(protocol), version, kind))
+	if err != nil {
+		return spec.Diagnostics{{
+			Code:     CodeBindingSchema,
+			Severity: spec.SeverityError,
+			Path:     basePath,
+			Message:  err.Error(),
+		}}
+	}
+
+	result, err := schema.Validate(gojsonschema.NewGoLoader(binding))
+	if err != nil {
+		return spec.Diagnostics{{
+			Code:     CodeBindingSchema,
+			Severity: spec.SeverityError,
+			Path:     basePath,
+			Message:  fmt.Sprintf("failed to validate %s %s binding: %s", protocol, kind, err),
+		}}
+	}
+
+	var diags spec.Diagnostics
+	for _, resultErr := range result.Errors() {
+		diags = append(diags, spec.Diagnostic{
+			Code:     CodeBindingSchema,
+			Severity: spec.SeverityError,
+			Path:     basePath + contextPointer(resultErr.Context()),
+			Message:  fmt.Sprintf("%s %s binding %s: %s", protocol, kind, version, resultErr.Description()),
+		})
+	}
+	return diags
+}
+
+// CompareVersions compares two dotted version strings numerically, returning
+// -1, 0 or 1. Non-numeric segments compare as zero.
+func CompareVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var av, bv int
+		if i < len(as) {
+			av, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bv, _ = strconv.Atoi(bs[i])
+		}
+		switch {
+		case av < bv:
+			return -1
+		case av > bv:
+			return 1
+		}
+	}
+	return 0
+}
+
+// bindingSchema compiles and caches the binding schema at the given embedded path
+func bindingSchema(schemaPath string) (*gojsonschema.Schema, error) {
+	if cached, ok := bindingSchemaCache.Load(schemaPath); ok {
+		return cached.(*gojsonschema.Schema), nil
+	}
+
+	data, err := bindingSchemaFS.ReadFile(schemaPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read binding schema %s: %w", schemaPath, err)
+	}
+
+	loader := gojsonschema.NewSchemaLoader()
+	defs, err := sharedDefinitions()
+	if err != nil {
+		return nil, err
+	}
+	for id, raw := range defs {
+		if err := loader.AddSchema(id, gojsonschema.NewBytesLoader(raw)); err != nil {
+			return nil, fmt.Errorf("failed to load definition %s: %w", id, err)
+		}
+	}
+
+	schema, err := loader.Compile(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile binding schema %s: %w", schemaPath, err)
+	}
+
+	actual, _ := bindingSchemaCache.LoadOrStore(schemaPath, schema)
+	return actual.(*gojsonschema.Schema), nil
+}
+
+// sharedDefinitions returns the AsyncAPI and JSON Schema definitions that the
+// binding schemas reference by URL. They are taken from the bundled 3.0.0
+// specification schema, which includes every definition the bindings need.
+func sharedDefinitions() (map[string]json.RawMessage, error) {
+	definitionsOnce.Do(func() {
+		data, err := spec_json_schemas.Get("3.0.0")
+		if err != nil || data == nil {
+			definitionsErr = fmt.Errorf("failed to load binding definitions: %v", err)
+			return
+		}
+
+		var bundle struct {
+			Definitions map[string]json.RawMessage `json:"definitions"`
+		}
+		if err := json.Unmarshal(data, &bundle); err != nil {
+			definitionsErr = fmt.Errorf("failed to parse binding definitions: %w", err)
+			return
+		}
+
+		definitions = make(map[string]json.RawMessage)
+		for id, raw := range bundle.Definitions {
+			// Binding schemas themselves are loaded from the embedded files
+			if strings.HasPrefix(id, "http://asyncapi.com/bindings/") {
+				continue
+			}
+			definitions[id] = raw
+		}
+	})
+	return definitions, definitionsErr
+}
+
+func bindingDir(protocol string) string {
+	if dir, ok := bindingDirs[protocol]; ok {
+		return dir
+	}
+	return protocol
+}
+
+func bindingSchemaPath(dir, version string, kind BindingKind) string {
+	return path.Join("bindings", dir, version, string(kind)+".json")
+}
+
+// contextPointer converts a gojsonschema context such as (root).exchange.name
+// to a JSON pointer such as /exchange/name
+func contextPointer(ctx *gojsonschema.JsonContext) string {
+	if ctx == nil {
+		return ""
+	}
+	segments := strings.Split(ctx.String("\x00"), "\x00")
+	if len(segments) > 0 && segments[0] == gojsonschema.STRING_CONTEXT_ROOT {
+		segments = segments[1:]
+	}
+	return spec.JSONPointer(segments...)
+}
+
+func withoutKey(m map[string]any, key string) map[string]any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		if k != key {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
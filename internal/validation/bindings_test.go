@@ -0,0 +1,121 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindingVersions(t *testing.T) {
+	assert.Equal(t, []string{"0.3.0", "0.4.0", "0.5.0"}, BindingVersions("kafka", ChannelBinding))
+	assert.Equal(t, []string{"0.1.0", "0.3.0", "0.4.0", "0.5.0"}, BindingVersions("kafka", MessageBinding))
+	assert.Equal(t, []string{"0.2.0", "0.3.0"}, BindingVersions("amqp", ChannelBinding))
+	assert.Equal(t, []string{"0.1.0"}, BindingVersions("ws", ChannelBinding))
+	assert.Empty(t, BindingVersions("ipoac", ChannelBinding))
+	assert.Equal(t, "0.5.0", LatestBindingVersion("kafka", ServerBinding))
+}
+
+func TestValidateBinding(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol string
+		kind     BindingKind
+		binding  any
+		expected spec.Diagnostics
+	}{
+		{
+			name:     "valid kafka channel",
+			protocol: "kafka",
+			kind:     ChannelBinding,
+			binding: map[string]any{
+				"topic":          "my-topic",
+				"partitions":     20,
+				"bindingVersion": "0.5.0",
+			},
+		},
+		{
+			name:     "wrong type",
+			protocol: "kafka",
+			kind:     ChannelBinding,
+			binding: map[string]any{
+				"partitions":     "twenty",
+				"bindingVersion": "0.5.0",
+			},
+			expected: spec.Diagnostics{{
+				Code:     CodeBindingSchema,
+				Severity: spec.SeverityError,
+				Path:     "/bindings/kafka/partitions",
+				Message:  "kafka channel binding 0.5.0: Invalid type. Expected: integer, given: string",
+			}},
+		},
+		{
+			name:     "unknown field",
+			protocol: "kafka",
+			kind:     OperationBinding,
+			binding: map[string]any{
+				"groupID": map[string]any{"type": "string"},
+			},
+			expected: spec.Diagnostics{{
+				Code:     CodeBindingSchema,
+				Severity: spec.SeverityError,
+				Path:     "/bindings/kafka",
+				Message:  "kafka operation binding 0.5.0: Additional property groupID is not allowed",
+			}},
+		},
+		{
+			name:     "declared version is used",
+			protocol: "amqp",
+			kind:     ChannelBinding,
+			binding: map[string]any{
+				"is":             "routingKey",
+				"exchange":       map[string]any{"name": "events", "vhost": "/"},
+				"bindingVersion": "0.2.0",
+			},
+		},
+		{
+			name:     "latest version",
+			protocol: "sqs",
+			kind:     ChannelBinding,
+			binding: map[string]any{
+				"queue":          map[string]any{"name": "events", "fifoQueue": false},
+				"bindingVersion": "latest",
+			},
+		},
+		{
+			name:     "unknown version",
+			protocol: "kafka",
+			kind:     ChannelBinding,
+			binding: map[string]any{
+				"bindingVersion": "9.9.9",
+			},
+			expected: spec.Diagnostics{{
+				Code:     CodeBindingVersionUnknown,
+				Severity: spec.SeverityWarning,
+				Path:     "/bindings/kafka/bindingVersion",
+				Message:  "no schema for kafka channel binding version 9.9.9, known versions are 0.3.0, 0.4.0, 0.5.0",
+			}},
+		},
+		{
+			name:     "custom binding is skipped",
+			protocol: "ipoac",
+			kind:     ChannelBinding,
+			binding:  map[string]any{"carrier": "pigeon"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := ValidateBinding("/bindings/"+tt.protocol, tt.protocol, tt.kind, tt.binding)
+			assert.Equal(t, tt.expected, diags)
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, -1, CompareVersions("0.2.0", "0.10.0"))
+	assert.Equal(t, 0, CompareVersions("0.3.0", "0.3.0"))
+	assert.Equal(t, 1, CompareVersions("1.0", "0.9.9"))
+	require.Equal(t, -1, CompareVersions("0.0.1", "0.1.0"))
+}
@@ -1,56 +1,49 @@
 package validation
 
 import (
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/asyncapi/spec-json-schemas/v6"
+	"github.com/charlie-haley/asyncapi-go/spec"
 	"github.com/xeipuuv/gojsonschema"
 )
 
 // ErrUnsupportedVersion indicates that the AsyncAPI version is not supported
 var ErrUnsupportedVersion = fmt.Errorf("unsupported AsyncAPI version")
 
-// ValidateDocument validates an AsyncAPI document against its schema
-func ValidateDocument(doc interface{}) error {
-	// Get version from document
-	version := getVersionFromDoc(doc)
-	if version == "" {
-		return fmt.Errorf("could not determine AsyncAPI version from document")
-	}
+// CodeDocumentSchema is the code of diagnostics reported by DocumentDiagnostics
+const CodeDocumentSchema = "document-schema"
 
-	// Get schema from spec-json-schemas
-	schema, err := spec_json_schemas.Get(version)
-	if err != nil {
-		return fmt.Errorf("failed to get schema for version %s: %w", version, err)
-	}
+var (
+	// metaSchemaCache holds compiled meta-schemas keyed by AsyncAPI version
+	// for the official schemas, or by content hash for custom ones
+	metaSchemaCache sync.Map
 
-	// Marshal document to JSON for validation
-	docBytes, err := json.Marshal(doc)
-	if err != nil {
-		return fmt.Errorf("failed to marshal document for validation: %w", err)
-	}
-
-	// Setup schema validation
-	schemaLoader := gojsonschema.NewBytesLoader(schema)
-	docLoader := gojsonschema.NewBytesLoader(docBytes)
+	// specSchemasMu guards spec_json_schemas.Get, which loads schemas into a
+	// package level map on first use
+	specSchemasMu sync.Mutex
+)
 
-	// Validate
-	result, err := gojsonschema.Validate(schemaLoader, docLoader)
+// ValidateDocument validates an AsyncAPI document against the official
+// schema for its version
+func ValidateDocument(doc interface{}) error {
+	diags, err := DocumentDiagnostics(doc, nil)
 	if err != nil {
-		return fmt.Errorf("schema validation failed: %w", err)
+		return err
 	}
-
-	if !result.Valid() {
-		var errMsgs []string
-		for _, err := range result.Errors() {
-			errMsgs = append(errMsgs, fmt.Sprintf("- %s: %s", err.Field(), err.Description()))
-		}
-		return fmt.Errorf("validation errors:\n%s", strings.Join(errMsgs, "\n"))
+	if len(diags) == 0 {
+		return nil
 	}
 
-	return nil
+	var errMsgs []string
+	for _, diag := range diags {
+		errMsgs = append(errMsgs, fmt.Sprintf("- %s: %s", diag.Path, diag.Message))
+	}
+	return fmt.Errorf("validation errors:\n%s", strings.Join(errMsgs, "\n"))
 }
 
 // getVersionFromDoc extracts the AsyncAPI version from a document
@@ -69,3 +62,87 @@ func getVersionFromDoc(doc interface{}) string {
 
 	return ""
 }
+
+// MetaSchema returns the official AsyncAPI JSON Schema for a version
+func MetaSchema(version string) ([]byte, error) {
+	specSchemasMu.Lock()
+	defer specSchemasMu.Unlock()
+
+	schema, err := spec_json_schemas.Get(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema for version %s: %w", version, err)
+	}
+	if schema == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedVersion, version)
+	}
+	return schema, nil
+}
+
+// DocumentDiagnostics validates a document against a meta-schema, reporting
+// each violation as a diagnostic. The official schema for the document's
+// version is used when metaSchema is nil. An error is returned only if the
+// meta-schema can't be loaded or compiled.
+func DocumentDiagnostics(doc interface{}, metaSchema []byte) (spec.Diagnostics, error) {
+	return diagnose(getVersionFromDoc(doc), metaSchema, gojsonschema.NewGoLoader(doc))
+}
+
+func diagnose(version string, metaSchema []byte, document gojsonschema.JSONLoader) (spec.Diagnostics, error) {
+	if metaSchema == nil && version == "" {
+		return spec.Diagnostics{{
+			Code:     CodeDocumentSchema,
+			Severity: spec.SeverityError,
+			Path:     spec.JSONPointer("asyncapi"),
+			Message:  "could not determine AsyncAPI version from document",
+		}}, nil
+	}
+
+	schema, err := compiledMetaSchema(version, metaSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := schema.Validate(document)
+	if err != nil {
+		return nil, fmt.Errorf("schema validation failed: %w", err)
+	}
+
+	var diags spec.Diagnostics
+	for _, resultErr := range result.Errors() {
+		diags = append(diags, spec.Diagnostic{
+			Code:     CodeDocumentSchema,
+			Severity: spec.SeverityError,
+			Path:     contextPointer(resultErr.Context()),
+			Message:  resultErr.Description(),
+		})
+	}
+	return diags, nil
+}
+
+// compiledMetaSchema compiles and caches a custom meta-schema, or the
+// official schema for a version when custom is nil
+func compiledMetaSchema(version string, custom []byte) (*gojsonschema.Schema, error) {
+	key := "version:" + version
+	if custom != nil {
+		sum := sha256.Sum256(custom)
+		key = "sha256:" + hex.EncodeToString(sum[:])
+	}
+	if cached, ok := metaSchemaCache.Load(key); ok {
+		return cached.(*gojsonschema.Schema), nil
+	}
+
+	data := custom
+	if data == nil {
+		var err error
+		if data, err = MetaSchema(version); err != nil {
+			return nil, err
+		}
+	}
+
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile meta-schema: %w", err)
+	}
+
+	actual, _ := metaSchemaCache.LoadOrStore(key, schema)
+	return actual.(*gojsonschema.Schema), nil
+}
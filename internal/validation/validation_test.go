@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompiledMetaSchema(t *testing.T) {
+	first, err := compiledMetaSchema("2.6.0", nil)
+	require.NoError(t, err)
+	second, err := compiledMetaSchema("2.6.0", nil)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+
+	custom := []byte(`{"type": "object"}`)
+	first, err = compiledMetaSchema("2.6.0", custom)
+	require.NoError(t, err)
+	second, err = compiledMetaSchema("2.6.0", []byte(`{"type": "object"}`))
+	require.NoError(t, err)
+	assert.Same(t, first, second, "custom schemas are cached by content")
+
+	_, err = compiledMetaSchema("0.9.0", nil)
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+func TestDocumentDiagnostics(t *testing.T) {
+	doc := map[string]any{
+		"asyncapi": "2.6.0",
+		"info":     map[string]any{"title": "Users"},
+		"channels": map[string]any{},
+	}
+
+	diags, err := DocumentDiagnostics(doc, nil)
+	require.NoError(t, err)
+	assert.Equal(t, spec.Diagnostics{{
+		Code:     CodeDocumentSchema,
+		Severity: spec.SeverityError,
+		Path:     "/info",
+		Message:  "version is required",
+	}}, diags)
+
+	diags, err = DocumentDiagnostics(map[string]any{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "/asyncapi", diags[0].Path)
+}
+
+func TestValidateDocument(t *testing.T) {
+	doc := map[string]any{
+		"asyncapi": "2.6.0",
+		"info":     map[string]any{"title": "Users", "version": "1.0.0"},
+		"channels": map[string]any{},
+	}
+	assert.NoError(t, ValidateDocument(doc))
+
+	delete(doc["info"].(map[string]any), "version")
+	assert.EqualError(t, ValidateDocument(doc), "validation errors:\n- /info: version is required")
+}
+
+func BenchmarkValidateDocument(b *testing.B) {
+	doc := map[string]any{
+		"asyncapi": "2.6.0",
+		"info":     map[string]any{"title": "Users", "version": "1.0.0"},
+		"channels": map[string]any{
+			"user/signedup": map[string]any{
+				"subscribe": map[string]any{
+					"message": map[string]any{
+						"payload": map[string]any{"type": "object"},
+					},
+				},
+			},
+		},
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := ValidateDocument(doc); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Package jsonnumber decodes JSON with numbers held as json.Number rather
+// than float64, so that integers beyond 2^53 keep their exact value and
+// every number marshals back as it was written.
+package jsonnumber
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Unmarshal decodes data into v like json.Unmarshal, except that numbers
+// decoded into interface values are json.Numbers
+func Unmarshal(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid data after top-level value at offset %d", dec.InputOffset())
+	}
+	return nil
+}
+
+// Float64 returns a number decoded from JSON as a float64, accepting the
+// json.Numbers decoded by Unmarshal and the float64s decoded by
+// json.Unmarshal. It reports false for other values.
+func Float64(v any) (float64, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// UseNumber sets a decoder to decode numbers as json.Numbers. It can be
+// passed as an option to sigs.k8s.io/yaml's Unmarshal.
+func UseNumber(dec *json.Decoder) *json.Decoder {
+	dec.UseNumber()
+	return dec
+}
@@ -0,0 +1,44 @@
+package jsonnumber
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshal(t *testing.T) {
+	var v any
+	require.NoError(t, Unmarshal([]byte(`{"big": 9007199254740993, "float": 1.50}`), &v))
+	assert.Equal(t, map[string]any{"big": json.Number("9007199254740993"), "float": json.Number("1.50")}, v)
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"big": 9007199254740993, "float": 1.50}`, string(data))
+	assert.Contains(t, string(data), "9007199254740993")
+
+	assert.Error(t, Unmarshal([]byte(`{} {}`), &v))
+	assert.Error(t, Unmarshal([]byte(``), &v))
+	var syntaxErr *json.SyntaxError
+	assert.ErrorAs(t, Unmarshal([]byte(`{"a": }`), &v), &syntaxErr)
+}
+
+func TestFloat64(t *testing.T) {
+	for _, tt := range []struct {
+		value any
+		want  float64
+		ok    bool
+	}{
+		{json.Number("3"), 3, true},
+		{json.Number("3.5"), 3.5, true},
+		{float64(3.5), 3.5, true},
+		{7, 7, true},
+		{"3", 0, false},
+		{nil, 0, false},
+	} {
+		got, ok := Float64(tt.value)
+		assert.Equal(t, tt.ok, ok, "%#v", tt.value)
+		assert.Equal(t, tt.want, got, "%#v", tt.value)
+	}
+}
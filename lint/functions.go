@@ -0,0 +1,259 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+)
+
+// Function checks a single target value and returns a message for each
+// problem found. Options come from the rule's functionOptions.
+type Function func(target any, options map[string]any, ctx *Context) ([]string, error)
+
+// Context describes where a function is being applied
+type Context struct {
+	// Document is the whole document as generic JSON
+	Document any
+	// Path is the location of the target within Document
+	Path []string
+	// Defined is false when the rule's field does not exist on the given node
+	Defined bool
+}
+
+var (
+	functionsMu sync.RWMutex
+	functions   = map[string]Function{
+		"truthy":             truthy,
+		"falsy":              falsy,
+		"defined":            defined,
+		"undefined":          undefined,
+		"pattern":            pattern,
+		"casing":             casing,
+		"enumeration":        enumeration,
+		"length":             length,
+		"messageContentType": messageContentType,
+	}
+)
+
+// RegisterFunction makes a custom function available to rulesets by name.
+// Registering an existing name replaces it.
+func RegisterFunction(name string, fn Function) {
+	functionsMu.Lock()
+	defer functionsMu.Unlock()
+	functions[name] = fn
+}
+
+func lookupFunction(name string) (Function, bool) {
+	functionsMu.RLock()
+	defer functionsMu.RUnlock()
+	fn, ok := functions[name]
+	return fn, ok
+}
+
+func truthy(target any, _ map[string]any, ctx *Context) ([]string, error) {
+	if !ctx.Defined || !isTruthy(target) {
+		return []string{fmt.Sprintf("%s must be truthy", propertyName(ctx))}, nil
+	}
+	return nil, nil
+}
+
+func falsy(target any, _ map[string]any, ctx *Context) ([]string, error) {
+	if ctx.Defined && isTruthy(target) {
+		return []string{fmt.Sprintf("%s must be falsy", propertyName(ctx))}, nil
+	}
+	return nil, nil
+}
+
+func defined(_ any, _ map[string]any, ctx *Context) ([]string, error) {
+	if !ctx.Defined {
+		return []string{fmt.Sprintf("%s must be defined", propertyName(ctx))}, nil
+	}
+	return nil, nil
+}
+
+func undefined(_ any, _ map[string]any, ctx *Context) ([]string, error) {
+	if ctx.Defined {
+		return []string{fmt.Sprintf("%s must be undefined", propertyName(ctx))}, nil
+	}
+	return nil, nil
+}
+
+func pattern(target any, options map[string]any, ctx *Context) ([]string, error) {
+	if !ctx.Defined {
+		return nil, nil
+	}
+	s, ok := target.(string)
+	if !ok {
+		return []string{fmt.Sprintf("%s must be a string", propertyName(ctx))}, nil
+	}
+
+	var msgs []string
+	if expr, ok := options["match"].(string); ok {
+		re, err := compilePattern(expr)
+		if err != nil {
+			return nil, err
+		}
+		if !re.MatchString(s) {
+			msgs = append(msgs, fmt.Sprintf("%q must match the pattern %q", s, expr))
+		}
+	}
+	if expr, ok := options["notMatch"].(string); ok {
+		re, err := compilePattern(expr)
+		if err != nil {
+			return nil, err
+		}
+		if re.MatchString(s) {
+			msgs = append(msgs, fmt.Sprintf("%q must not match the pattern %q", s, expr))
+		}
+	}
+	return msgs, nil
+}
+
+var casingPatterns = map[string]*regexp.Regexp{
+	"flat":   regexp.MustCompile(`^[a-z][a-z0-9]*$`),
+	"camel":  regexp.MustCompile(`^[a-z][a-z0-9]*(?:[A-Z0-9][a-z0-9]*)*$`),
+	"pascal": regexp.MustCompile(`^[A-Z][a-z0-9]*(?:[A-Z0-9][a-z0-9]*)*$`),
+	"kebab":  regexp.MustCompile(`^[a-z][a-z0-9]*(?:-[a-z0-9]+)*$`),
+	"cobol":  regexp.MustCompile(`^[A-Z][A-Z0-9]*(?:-[A-Z0-9]+)*$`),
+	"snake":  regexp.MustCompile(`^[a-z][a-z0-9]*(?:_[a-z0-9]+)*$`),
+	"macro":  regexp.MustCompile(`^[A-Z][A-Z0-9]*(?:_[A-Z0-9]+)*$`),
+}
+
+// casing checks that a string follows a naming convention. The options are
+// type (flat, camel, pascal, kebab, cobol, snake or macro), separator, which
+// splits the value into segments that are checked individually, and
+// ignoreParameters, which skips segments wrapped in braces such as {userId}.
+func casing(target any, options map[string]any, ctx *Context) ([]string, error) {
+	if !ctx.Defined {
+		return nil, nil
+	}
+	s, ok := target.(string)
+	if !ok {
+		return []string{fmt.Sprintf("%s must be a string", propertyName(ctx))}, nil
+	}
+
+	kind, _ := options["type"].(string)
+	re, ok := casingPatterns[kind]
+	if !ok {
+		return nil, fmt.Errorf("casing: unknown type %q", kind)
+	}
+
+	segments := []string{s}
+	if sep, ok := options["separator"].(string); ok && sep != "" {
+		segments = strings.Split(strings.Trim(s, sep), sep)
+	}
+	ignoreParameters, _ := options["ignoreParameters"].(bool)
+
+	for _, segment := range segments {
+		if ignoreParameters && strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			continue
+		}
+		if !re.MatchString(segment) {
+			return []string{fmt.Sprintf("%q must be %s case", s, kind)}, nil
+		}
+	}
+	return nil, nil
+}
+
+func enumeration(target any, options map[string]any, ctx *Context) ([]string, error) {
+	if !ctx.Defined {
+		return nil, nil
+	}
+	values, ok := options["values"].([]any)
+	if !ok {
+		return nil, fmt.Errorf("enumeration: values option must be a list")
+	}
+	for _, v := range values {
+		if fmt.Sprint(v) == fmt.Sprint(target) {
+			return nil, nil
+		}
+	}
+	return []string{fmt.Sprintf("%v must be one of %v", target, values)}, nil
+}
+
+func length(target any, options map[string]any, ctx *Context) ([]string, error) {
+	if !ctx.Defined {
+		return nil, nil
+	}
+
+	var n int
+	switch v := target.(type) {
+	case string:
+		n = len([]rune(v))
+	case []any:
+		n = len(v)
+	case map[string]any:
+		n = len(v)
+	case json.Number, float64:
+		f, _ := jsonnumber.Float64(v)
+		n = int(f)
+	default:
+		return nil, nil
+	}
+
+	var msgs []string
+	if min, ok := jsonnumber.Float64(options["min"]); ok && n < int(min) {
+		msgs = append(msgs, fmt.Sprintf("%s must not be shorter than %d", propertyName(ctx), int(min)))
+	}
+	if max, ok := jsonnumber.Float64(options["max"]); ok && n > int(max) {
+		msgs = append(msgs, fmt.Sprintf("%s must not be longer than %d", propertyName(ctx), int(max)))
+	}
+	return msgs, nil
+}
+
+// messageContentType checks that a message declares a contentType, either
+// itself or through the document's defaultContentType
+func messageContentType(target any, _ map[string]any, ctx *Context) ([]string, error) {
+	message, ok := target.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	if isTruthy(message["contentType"]) {
+		return nil, nil
+	}
+	if doc, ok := ctx.Document.(map[string]any); ok && isTruthy(doc["defaultContentType"]) {
+		return nil, nil
+	}
+	return []string{"message must have a contentType or the document must set defaultContentType"}, nil
+}
+
+var patternCache sync.Map
+
+func compilePattern(expr string) (*regexp.Regexp, error) {
+	if re, ok := patternCache.Load(expr); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("pattern: %w", err)
+	}
+	patternCache.Store(expr, re)
+	return re, nil
+}
+
+func isTruthy(v any) bool {
+	switch val := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return val
+	case string:
+		return val != ""
+	case json.Number, float64:
+		f, _ := jsonnumber.Float64(val)
+		return f != 0
+	default:
+		return true
+	}
+}
+
+func propertyName(ctx *Context) string {
+	if len(ctx.Path) == 0 {
+		return "document"
+	}
+	return fmt.Sprintf("%q", ctx.Path[len(ctx.Path)-1])
+}
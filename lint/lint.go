@@ -0,0 +1,210 @@
+// Package lint provides a configurable rules engine that checks AsyncAPI
+// documents for style and best practices beyond schema validity.
+//
+// Rules select parts of a document with a JSON-path-like selector and apply
+// a function to each match, in the same shape as Spectral rulesets:
+//
+//	rules:
+//	  channel-description:
+//	    description: Channels should have a description
+//	    severity: warn
+//	    given: $.channels[*]
+//	    then:
+//	      field: description
+//	      function: truthy
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+	"github.com/charlie-haley/asyncapi-go/spec"
+)
+
+// Rule is a single lint check
+type Rule struct {
+	// Description explains what the rule checks
+	Description string
+	// Message overrides the message reported by the rule's functions. It may
+	// contain the placeholders {{error}}, {{property}}, {{path}}, {{value}}
+	// and {{description}}.
+	Message string
+	// Severity of the findings reported by the rule
+	Severity spec.Severity
+	// Disabled turns the rule off
+	Disabled bool
+	// Given holds the selectors for the nodes the rule applies to
+	Given []string
+	// Then holds the checks applied to each selected node
+	Then []Then
+
+	// severitySet records whether a ruleset entry set the severity explicitly
+	severitySet bool
+}
+
+// Then is a single check applied to the nodes selected by a rule
+type Then struct {
+	// Field is a dot separated path below the selected node to check, or
+	// @key to check the node's key. When empty the node itself is checked.
+	Field string `json:"field,omitempty"`
+	// Function is the name of the function to apply
+	Function string `json:"function"`
+	// FunctionOptions are passed to the function
+	FunctionOptions map[string]any `json:"functionOptions,omitempty"`
+}
+
+// Linter runs a ruleset over documents
+type Linter struct {
+	ruleset *Ruleset
+}
+
+// New creates a Linter for the given ruleset. A nil ruleset uses the
+// recommended rules.
+func New(ruleset *Ruleset) *Linter {
+	if ruleset == nil {
+		ruleset = Recommended()
+	}
+	return &Linter{ruleset: ruleset}
+}
+
+// Lint runs every enabled rule over the document. filePath is the path of
+// the document's source file, used to apply per-file overrides, and may be
+// empty. Parsed documents are linted as they were written, so rules can
+// select fields the model doesn't hold such as specification extensions,
+// while documents built in code are linted from their marshalled JSON.
+func (l *Linter) Lint(doc spec.Document, filePath string) (spec.Diagnostics, error) {
+	root, err := lintRoot(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := l.ruleset.rulesFor(filePath)
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var diags spec.Diagnostics
+	for _, name := range names {
+		rule := rules[name]
+		if rule.Disabled {
+			continue
+		}
+		found, err := rule.run(name, root)
+		if err != nil {
+			return nil, err
+		}
+		diags = append(diags, found...)
+	}
+
+	sort.SliceStable(diags, func(i, j int) bool {
+		return diags[i].Path < diags[j].Path
+	})
+	return diags, nil
+}
+
+// lintRoot returns the JSON the rules run over, the source of a parsed
+// document or the document marshalled
+func lintRoot(doc spec.Document) (any, error) {
+	if parsed, ok := doc.(interface{ Source() map[string]any }); ok {
+		if source := parsed.Source(); source != nil {
+			return source, nil
+		}
+	}
+
+	data, err := doc.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal document for linting: %w", err)
+	}
+	var root any
+	if err := jsonnumber.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal document for linting: %w", err)
+	}
+	return root, nil
+}
+
+// run applies the rule to the document
+func (r *Rule) run(name string, root any) (spec.Diagnostics, error) {
+	var diags spec.Diagnostics
+	for _, given := range r.Given {
+		sel, err := ParseSelector(given)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", name, err)
+		}
+
+		for _, n := range sel.find(root) {
+			for _, then := range r.Then {
+				fn, ok := lookupFunction(then.Function)
+				if !ok {
+					return nil, fmt.Errorf("rule %s: unknown function %q", name, then.Function)
+				}
+
+				target, path, defined := resolveField(n, then.Field)
+				ctx := &Context{Document: root, Path: path, Defined: defined}
+				msgs, err := fn(target, then.FunctionOptions, ctx)
+				if err != nil {
+					return nil, fmt.Errorf("rule %s: %w", name, err)
+				}
+
+				for _, msg := range msgs {
+					diags = append(diags, spec.Diagnostic{
+						Code:     name,
+						Severity: r.Severity,
+						Path:     spec.JSONPointer(path...),
+						Message:  r.message(msg, target, ctx),
+					})
+				}
+			}
+		}
+	}
+	return diags, nil
+}
+
+// message renders the rule's message template for a single finding
+func (r *Rule) message(msg string, target any, ctx *Context) string {
+	if r.Message == "" {
+		return msg
+	}
+	var property string
+	if len(ctx.Path) > 0 {
+		property = ctx.Path[len(ctx.Path)-1]
+	}
+	return strings.NewReplacer(
+		"{{error}}", msg,
+		"{{property}}", property,
+		"{{path}}", spec.JSONPointer(ctx.Path...),
+		"{{value}}", fmt.Sprint(target),
+		"{{description}}", r.Description,
+	).Replace(r.Message)
+}
+
+// resolveField returns the value of field below the node, its path and
+// whether it exists
+func resolveField(n node, field string) (any, []string, bool) {
+	switch field {
+	case "":
+		return n.value, n.path, true
+	case "@key":
+		if len(n.path) == 0 {
+			return nil, n.path, false
+		}
+		return n.path[len(n.path)-1], n.path, true
+	}
+
+	value := n.value
+	path := n.path
+	for _, segment := range strings.Split(field, ".") {
+		path = appendPath(path, segment)
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil, path, false
+		}
+		if value, ok = m[segment]; !ok {
+			return nil, path, false
+		}
+	}
+	return value, path, true
+}
@@ -0,0 +1,179 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go"
+	"github.com/charlie-haley/asyncapi-go/asyncapi2"
+	"github.com/charlie-haley/asyncapi-go/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDocument() *asyncapi2.Document {
+	return asyncapi2.NewDocument().
+		WithInfo(asyncapi2.NewInfo().
+			WithTitle("Account Service").
+			WithVersion("1.0.0").
+			WithDescription("Manages user accounts").
+			WithContact(asyncapi2.NewContact().WithEmail("team@example.com"))).
+		WithChannel("user/{userId}/signed-up", asyncapi2.NewChannel().
+			WithDescription("A user signed up").
+			WithParameter("userId", asyncapi2.NewParameter()).
+			WithPublish(asyncapi2.NewOperation().
+				WithOperationID("userSignedUp").
+				WithDescription("Publishes sign ups").
+				WithMessage(asyncapi2.NewMessage().WithContentType("application/json"))))
+}
+
+func TestLint_Recommended_Clean(t *testing.T) {
+	diags, err := New(nil).Lint(newTestDocument(), "")
+	require.NoError(t, err)
+	assert.Empty(t, diags)
+}
+
+func TestLint_Recommended(t *testing.T) {
+	doc := asyncapi2.NewDocument().
+		WithInfo(asyncapi2.NewInfo().WithTitle("Account Service").WithVersion("1.0.0")).
+		WithChannel("User/SignedUp", asyncapi2.NewChannel().
+			WithSubscribe(asyncapi2.NewOperation().
+				WithMessage(asyncapi2.NewMessage())))
+
+	diags, err := New(nil).Lint(doc, "")
+	require.NoError(t, err)
+
+	expected := spec.Diagnostics{
+		{Code: RuleChannelDescription, Severity: spec.SeverityWarning, Path: "/channels/User~1SignedUp/description", Message: `"description" must be truthy`},
+		{Code: RuleChannelKebabCase, Severity: spec.SeverityWarning, Path: "/channels/User~1SignedUp", Message: `"User/SignedUp" must be kebab case`},
+		{Code: RuleMessageContentType, Severity: spec.SeverityWarning, Path: "/channels/User~1SignedUp/subscribe/message", Message: "message must have a contentType or the document must set defaultContentType"},
+		{Code: RuleOperationDescription, Severity: spec.SeverityWarning, Path: "/channels/User~1SignedUp/subscribe/description", Message: `"description" must be truthy`},
+		{Code: RuleOperationOperationID, Severity: spec.SeverityError, Path: "/channels/User~1SignedUp/subscribe/operationId", Message: `"operationId" must be truthy`},
+		{Code: RuleInfoContact, Severity: spec.SeverityWarning, Path: "/info/contact", Message: `"contact" must be truthy`},
+		{Code: RuleInfoDescription, Severity: spec.SeverityWarning, Path: "/info/description", Message: `"description" must be truthy`},
+	}
+	assert.ElementsMatch(t, expected, diags)
+}
+
+func TestLint_DefaultContentType(t *testing.T) {
+	doc := newTestDocument().WithDefaultContentType("application/json")
+	channel, _ := doc.Channels.Get("user/{userId}/signed-up")
+	channel.Publish.Message.ContentType = ""
+
+	diags, err := New(nil).Lint(doc, "")
+	require.NoError(t, err)
+	assert.Empty(t, diags)
+}
+
+func TestLint_CustomFunction(t *testing.T) {
+	RegisterFunction("startsWithUpper", func(target any, _ map[string]any, _ *Context) ([]string, error) {
+		if s, ok := target.(string); ok && s != "" && s[0] >= 'A' && s[0] <= 'Z' {
+			return nil, nil
+		}
+		return []string{"must start with an upper case letter"}, nil
+	})
+
+	ruleset, err := LoadRuleset([]byte(`
+rules:
+  info-title-upper:
+    severity: error
+    message: "Title {{value}} {{error}}"
+    given: $.info
+    then:
+      field: title
+      function: startsWithUpper
+`))
+	require.NoError(t, err)
+
+	doc := newTestDocument()
+	doc.Info.Title = "account service"
+
+	diags, err := New(ruleset).Lint(doc, "")
+	require.NoError(t, err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "info-title-upper", diags[0].Code)
+	assert.Equal(t, spec.SeverityError, diags[0].Severity)
+	assert.Equal(t, "/info/title", diags[0].Path)
+	assert.Equal(t, "Title account service must start with an upper case letter", diags[0].Message)
+}
+
+func TestLint_Source(t *testing.T) {
+	ruleset, err := LoadRuleset([]byte(`
+rules:
+  info-no-license:
+    severity: error
+    given: $.info
+    then:
+      - field: license
+        function: falsy
+      - field: x-owner
+        function: falsy
+`))
+	require.NoError(t, err)
+
+	doc, err := asyncapi.Parse([]byte(`
+asyncapi: 2.6.0
+info:
+  title: Account Service
+  version: 1.0.0
+  license:
+    name: Apache 2.0
+  x-owner: accounts
+channels: {}
+`))
+	require.NoError(t, err)
+
+	// license and x-owner aren't held by the model, only by the source
+	diags, err := New(ruleset).Lint(doc, "")
+	require.NoError(t, err)
+	var paths []string
+	for _, diag := range diags {
+		if diag.Code == "info-no-license" {
+			paths = append(paths, diag.Path)
+		}
+	}
+	assert.Equal(t, []string{"/info/license", "/info/x-owner"}, paths)
+}
+
+func TestFunctions(t *testing.T) {
+	tests := []struct {
+		name     string
+		function Function
+		target   any
+		options  map[string]any
+		defined  bool
+		failures int
+	}{
+		{"truthy", truthy, "x", nil, true, 0},
+		{"truthy empty", truthy, "", nil, true, 1},
+		{"truthy undefined", truthy, nil, nil, false, 1},
+		{"falsy", falsy, false, nil, true, 0},
+		{"falsy true", falsy, true, nil, true, 1},
+		{"defined", defined, nil, nil, true, 0},
+		{"defined missing", defined, nil, nil, false, 1},
+		{"undefined", undefined, nil, nil, false, 0},
+		{"undefined present", undefined, "x", nil, true, 1},
+		{"pattern match", pattern, "v1.2.0", map[string]any{"match": `^v\d`}, true, 0},
+		{"pattern no match", pattern, "1.2.0", map[string]any{"match": `^v\d`}, true, 1},
+		{"pattern notMatch", pattern, "TODO: fix", map[string]any{"notMatch": "TODO"}, true, 1},
+		{"casing camel", casing, "userSignedUp", map[string]any{"type": "camel"}, true, 0},
+		{"casing pascal", casing, "userSignedUp", map[string]any{"type": "pascal"}, true, 1},
+		{"casing snake", casing, "user_signed_up", map[string]any{"type": "snake"}, true, 0},
+		{"casing separator", casing, "user/{userId}/signed-up", map[string]any{"type": "kebab", "separator": "/", "ignoreParameters": true}, true, 0},
+		{"casing parameter", casing, "user/{userId}", map[string]any{"type": "kebab", "separator": "/"}, true, 1},
+		{"enumeration", enumeration, "kafka", map[string]any{"values": []any{"kafka", "amqp"}}, true, 0},
+		{"enumeration miss", enumeration, "mqtt", map[string]any{"values": []any{"kafka", "amqp"}}, true, 1},
+		{"length", length, "abc", map[string]any{"min": float64(1), "max": float64(3)}, true, 0},
+		{"length too long", length, []any{1, 2, 3, 4}, map[string]any{"max": float64(3)}, true, 1},
+		{"length number", length, json.Number("5"), map[string]any{"max": json.Number("3")}, true, 1},
+		{"truthy number", truthy, json.Number("0"), nil, true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgs, err := tt.function(tt.target, tt.options, &Context{Path: []string{"field"}, Defined: tt.defined})
+			require.NoError(t, err)
+			assert.Len(t, msgs, tt.failures, "messages: %v", msgs)
+		})
+	}
+}
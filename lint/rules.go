@@ -0,0 +1,73 @@
+package lint
+
+import "github.com/charlie-haley/asyncapi-go/spec"
+
+// Names of the built-in rules
+const (
+	RuleOperationOperationID = "operation-operationId"
+	RuleOperationDescription = "operation-description"
+	RuleChannelDescription   = "channel-description"
+	RuleInfoDescription      = "info-description"
+	RuleInfoContact          = "info-contact"
+	RuleChannelKebabCase     = "channel-kebab-case"
+	RuleMessageContentType   = "message-contentType"
+)
+
+// operationSelector selects every operation in the document
+const operationSelector = "$.channels[*][publish,subscribe]"
+
+// Recommended returns a ruleset containing the built-in rules
+func Recommended() *Ruleset {
+	return &Ruleset{Rules: map[string]*Rule{
+		RuleOperationOperationID: {
+			Description: "Operations must have an operationId",
+			Severity:    spec.SeverityError,
+			Given:       []string{operationSelector},
+			Then:        []Then{{Field: "operationId", Function: "truthy"}},
+		},
+		RuleOperationDescription: {
+			Description: "Operations should have a description",
+			Severity:    spec.SeverityWarning,
+			Given:       []string{operationSelector},
+			Then:        []Then{{Field: "description", Function: "truthy"}},
+		},
+		RuleChannelDescription: {
+			Description: "Channels should have a description",
+			Severity:    spec.SeverityWarning,
+			Given:       []string{"$.channels[*]"},
+			Then:        []Then{{Field: "description", Function: "truthy"}},
+		},
+		RuleInfoDescription: {
+			Description: "Info should have a description",
+			Severity:    spec.SeverityWarning,
+			Given:       []string{"$.info"},
+			Then:        []Then{{Field: "description", Function: "truthy"}},
+		},
+		RuleInfoContact: {
+			Description: "Info should have contact details",
+			Severity:    spec.SeverityWarning,
+			Given:       []string{"$.info"},
+			Then:        []Then{{Field: "contact", Function: "truthy"}},
+		},
+		RuleChannelKebabCase: {
+			Description: "Channel names should be kebab case",
+			Severity:    spec.SeverityWarning,
+			Given:       []string{"$.channels[*]"},
+			Then: []Then{{
+				Field:    "@key",
+				Function: "casing",
+				FunctionOptions: map[string]any{
+					"type":             "kebab",
+					"separator":        "/",
+					"ignoreParameters": true,
+				},
+			}},
+		},
+		RuleMessageContentType: {
+			Description: "Messages should have a contentType",
+			Severity:    spec.SeverityWarning,
+			Given:       []string{operationSelector + ".message", "$.components.messages[*]"},
+			Then:        []Then{{Function: "messageContentType"}},
+		},
+	}}
+}
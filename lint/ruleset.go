@@ -0,0 +1,326 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+	"github.com/charlie-haley/asyncapi-go/spec"
+	"sigs.k8s.io/yaml"
+)
+
+// severityOff is the severity value that disables a rule
+const severityOff = "off"
+
+// Ruleset is a set of named rules with optional per-file overrides
+type Ruleset struct {
+	Rules     map[string]*Rule
+	Overrides []Override
+}
+
+// Override changes rules for documents whose file path matches one of Files.
+// Patterns use path.Match syntax with the addition of ** to match any number
+// of directories. Patterns without a slash match against the base name.
+type Override struct {
+	Files []string
+	Rules map[string]*Rule
+}
+
+// LoadRulesetFile reads a YAML or JSON ruleset from disk
+func LoadRulesetFile(filePath string) (*Ruleset, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ruleset: %w", err)
+	}
+	return LoadRuleset(data)
+}
+
+// LoadRuleset parses a YAML or JSON ruleset. Its rules are merged over the
+// recommended rules, so built-in rules can be reconfigured or disabled by
+// name and new rules added alongside them:
+//
+//	rules:
+//	  info-contact: off
+//	  channel-description: error
+//	  message-name:
+//	    severity: warn
+//	    given: $.components.messages[*]
+//	    then:
+//	      field: name
+//	      function: truthy
+//	overrides:
+//	  - files: ["legacy/**"]
+//	    rules:
+//	      channel-kebab-case: off
+func LoadRuleset(data []byte) (*Ruleset, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert ruleset to JSON: %w", err)
+	}
+
+	var raw struct {
+		Rules     map[string]*Rule `json:"rules"`
+		Overrides []struct {
+			Files []string         `json:"files"`
+			Rules map[string]*Rule `json:"rules"`
+		} `json:"overrides"`
+	}
+	if err := jsonnumber.Unmarshal(jsonData, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse ruleset: %w", err)
+	}
+
+	ruleset := Recommended()
+	if err := ruleset.apply(raw.Rules); err != nil {
+		return nil, err
+	}
+
+	for i, o := range raw.Overrides {
+		if len(o.Files) == 0 {
+			return nil, fmt.Errorf("override %d: files is required", i)
+		}
+		for _, pattern := range o.Files {
+			if err := validateGlob(pattern); err != nil {
+				return nil, fmt.Errorf("override %d: %w", i, err)
+			}
+		}
+		ruleset.Overrides = append(ruleset.Overrides, Override{Files: o.Files, Rules: o.Rules})
+	}
+
+	// Catch references to unknown rules up front rather than per file
+	for i, o := range ruleset.Overrides {
+		probe := ruleset.clone()
+		if err := probe.apply(o.Rules); err != nil {
+			return nil, fmt.Errorf("override %d: %w", i, err)
+		}
+	}
+
+	return ruleset, nil
+}
+
+// apply merges rules into the ruleset. Entries that only set a severity
+// reconfigure an existing rule.
+func (r *Ruleset) apply(rules map[string]*Rule) error {
+	for name, rule := range rules {
+		if rule.isDefinition() {
+			if err := rule.check(name); err != nil {
+				return err
+			}
+			r.Rules[name] = rule
+			continue
+		}
+
+		existing, ok := r.Rules[name]
+		if !ok {
+			return fmt.Errorf("rule %s: unknown rule, a new rule needs given and then", name)
+		}
+		updated := *existing
+		updated.Disabled = rule.Disabled
+		if rule.severitySet {
+			updated.Severity = rule.Severity
+		}
+		r.Rules[name] = &updated
+	}
+	return nil
+}
+
+// rulesFor returns the rules that apply to a document at filePath
+func (r *Ruleset) rulesFor(filePath string) map[string]*Rule {
+	if filePath == "" || len(r.Overrides) == 0 {
+		return r.Rules
+	}
+
+	resolved := r.clone()
+	name := filepath.ToSlash(filepath.Clean(filePath))
+	for _, o := range r.Overrides {
+		for _, pattern := range o.Files {
+			if ok, _ := matchGlob(pattern, name); ok {
+				// Unknown rules are rejected when the ruleset is loaded
+				_ = resolved.apply(o.Rules)
+				break
+			}
+		}
+	}
+	return resolved.Rules
+}
+
+func (r *Ruleset) clone() *Ruleset {
+	rules := make(map[string]*Rule, len(r.Rules))
+	for name, rule := range r.Rules {
+		rules[name] = rule
+	}
+	return &Ruleset{Rules: rules, Overrides: r.Overrides}
+}
+
+// isDefinition reports whether the rule defines a check rather than only
+// reconfiguring an existing rule
+func (r *Rule) isDefinition() bool {
+	return len(r.Given) > 0 || len(r.Then) > 0
+}
+
+// check verifies a rule definition is complete and its selectors compile
+func (r *Rule) check(name string) error {
+	if len(r.Given) == 0 {
+		return fmt.Errorf("rule %s: given is required", name)
+	}
+	if len(r.Then) == 0 {
+		return fmt.Errorf("rule %s: then is required", name)
+	}
+	for _, given := range r.Given {
+		if _, err := ParseSelector(given); err != nil {
+			return fmt.Errorf("rule %s: %w", name, err)
+		}
+	}
+	for _, then := range r.Then {
+		if _, ok := lookupFunction(then.Function); !ok {
+			return fmt.Errorf("rule %s: unknown function %q", name, then.Function)
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON accepts a full rule definition, a severity string such as
+// "warn" or "off", or a boolean to enable or disable a rule.
+func (r *Rule) UnmarshalJSON(data []byte) error {
+	var enabled bool
+	if err := json.Unmarshal(data, &enabled); err == nil {
+		*r = Rule{Disabled: !enabled}
+		return nil
+	}
+
+	var severity string
+	if err := json.Unmarshal(data, &severity); err == nil {
+		return r.setSeverity(severity)
+	}
+
+	var raw struct {
+		Description string          `json:"description"`
+		Message     string          `json:"message"`
+		Severity    json.RawMessage `json:"severity"`
+		Given       json.RawMessage `json:"given"`
+		Then        json.RawMessage `json:"then"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*r = Rule{Description: raw.Description, Message: raw.Message}
+	if err := r.unmarshalSeverity(raw.Severity); err != nil {
+		return err
+	}
+
+	if len(raw.Given) > 0 {
+		if err := unmarshalOneOrMany(raw.Given, &r.Given); err != nil {
+			return fmt.Errorf("invalid given: %w", err)
+		}
+	}
+	if len(raw.Then) > 0 {
+		if err := unmarshalOneOrMany(raw.Then, &r.Then); err != nil {
+			return fmt.Errorf("invalid then: %w", err)
+		}
+	}
+	return nil
+}
+
+// unmarshalSeverity decodes the severity of a rule definition. YAML 1.1
+// decodes an unquoted off as false, so a boolean is accepted as well as the
+// severity strings, false disabling the rule.
+func (r *Rule) unmarshalSeverity(data json.RawMessage) error {
+	if len(data) == 0 || string(data) == "null" {
+		return r.setSeverity("warn")
+	}
+
+	var enabled bool
+	if err := json.Unmarshal(data, &enabled); err == nil {
+		if !enabled {
+			return r.setSeverity(severityOff)
+		}
+		return r.setSeverity("warn")
+	}
+
+	var severity string
+	if err := json.Unmarshal(data, &severity); err != nil {
+		return fmt.Errorf("invalid severity: %w", err)
+	}
+	if severity == "" {
+		severity = "warn"
+	}
+	return r.setSeverity(severity)
+}
+
+func (r *Rule) setSeverity(severity string) error {
+	switch strings.ToLower(severity) {
+	case severityOff:
+		r.Disabled = true
+		return nil
+	case "error":
+		r.Severity = spec.SeverityError
+	case "warn", "warning":
+		r.Severity = spec.SeverityWarning
+	case "info":
+		r.Severity = spec.SeverityInfo
+	case "hint":
+		r.Severity = spec.SeverityHint
+	default:
+		return fmt.Errorf("unknown severity %q", severity)
+	}
+	r.severitySet = true
+	return nil
+}
+
+// unmarshalOneOrMany decodes either a single value or a list into out
+func unmarshalOneOrMany[T any](data json.RawMessage, out *[]T) error {
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+		return jsonnumber.Unmarshal(data, out)
+	}
+	var single T
+	if err := jsonnumber.Unmarshal(data, &single); err != nil {
+		return err
+	}
+	*out = []T{single}
+	return nil
+}
+
+// matchGlob reports whether name matches pattern, where ** matches any number
+// of path segments
+func matchGlob(pattern, name string) (bool, error) {
+	if !strings.Contains(pattern, "/") {
+		return path.Match(pattern, path.Base(name))
+	}
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+// validateGlob checks every segment of pattern is well formed
+func validateGlob(pattern string) error {
+	for _, segment := range strings.Split(pattern, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("invalid file pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+func matchSegments(pattern, name []string) (bool, error) {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if ok, err := matchSegments(pattern[1:], name[i:]); ok || err != nil {
+					return ok, err
+				}
+			}
+			return false, nil
+		}
+		if len(name) == 0 {
+			return false, nil
+		}
+		ok, err := path.Match(pattern[0], name[0])
+		if !ok || err != nil {
+			return false, err
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0, nil
+}
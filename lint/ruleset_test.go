@@ -0,0 +1,153 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRuleset(t *testing.T) {
+	ruleset, err := LoadRuleset([]byte(`
+rules:
+  info-contact: off
+  channel-description: error
+  operation-description: false
+  message-name:
+    description: Messages must have a name
+    given:
+      - $.channels[*][publish,subscribe].message
+      - $.components.messages[*]
+    then:
+      field: name
+      function: truthy
+overrides:
+  - files: ["legacy/**/*.yaml"]
+    rules:
+      channel-kebab-case: off
+      message-name: hint
+`))
+	require.NoError(t, err)
+
+	assert.True(t, ruleset.Rules[RuleInfoContact].Disabled)
+	assert.True(t, ruleset.Rules[RuleOperationDescription].Disabled)
+	assert.Equal(t, spec.SeverityError, ruleset.Rules[RuleChannelDescription].Severity)
+	assert.False(t, ruleset.Rules[RuleChannelKebabCase].Disabled)
+
+	custom := ruleset.Rules["message-name"]
+	require.NotNil(t, custom)
+	assert.Equal(t, spec.SeverityWarning, custom.Severity)
+	assert.Len(t, custom.Given, 2)
+	assert.Equal(t, []Then{{Field: "name", Function: "truthy"}}, custom.Then)
+
+	// Built-in rules are not modified by loading a ruleset
+	assert.False(t, Recommended().Rules[RuleInfoContact].Disabled)
+
+	legacy := ruleset.rulesFor("legacy/billing/asyncapi.yaml")
+	assert.True(t, legacy[RuleChannelKebabCase].Disabled)
+	assert.Equal(t, spec.SeverityHint, legacy["message-name"].Severity)
+
+	current := ruleset.rulesFor("services/billing/asyncapi.yaml")
+	assert.False(t, current[RuleChannelKebabCase].Disabled)
+	assert.Equal(t, spec.SeverityWarning, current["message-name"].Severity)
+}
+
+func TestLoadRuleset_DefinitionSeverityOff(t *testing.T) {
+	// YAML 1.1 decodes an unquoted off as false
+	ruleset, err := LoadRuleset([]byte(`
+rules:
+  message-name:
+    severity: off
+    given: $.components.messages[*]
+    then:
+      field: name
+      function: truthy
+  message-title:
+    severity: "off"
+    given: $.components.messages[*]
+    then:
+      field: title
+      function: truthy
+`))
+	require.NoError(t, err)
+
+	assert.True(t, ruleset.Rules["message-name"].Disabled)
+	assert.Equal(t, []Then{{Field: "name", Function: "truthy"}}, ruleset.Rules["message-name"].Then)
+	assert.True(t, ruleset.Rules["message-title"].Disabled)
+}
+
+func TestLoadRuleset_PerFile(t *testing.T) {
+	ruleset, err := LoadRuleset([]byte(`
+overrides:
+  - files: ["legacy.yaml"]
+    rules:
+      channel-kebab-case: off
+`))
+	require.NoError(t, err)
+
+	doc := newTestDocument()
+	channel, _ := doc.Channels.Get("user/{userId}/signed-up")
+	doc.Channels.Set("LegacyChannel", channel)
+	doc.Channels.Delete("user/{userId}/signed-up")
+
+	linter := New(ruleset)
+	diags, err := linter.Lint(doc, "specs/current.yaml")
+	require.NoError(t, err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, RuleChannelKebabCase, diags[0].Code)
+
+	diags, err = linter.Lint(doc, "specs/legacy.yaml")
+	require.NoError(t, err)
+	assert.Empty(t, diags)
+}
+
+func TestLoadRuleset_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		ruleset  string
+		expected string
+	}{
+		{"unknown rule", "rules:\n  not-a-rule: warn\n", "rule not-a-rule: unknown rule"},
+		{"unknown severity", "rules:\n  info-contact: fatal\n", `unknown severity "fatal"`},
+		{"invalid definition severity", "rules:\n  custom:\n    severity: 1\n    given: $.info\n    then:\n      function: truthy\n", "invalid severity"},
+		{"missing then", "rules:\n  custom:\n    given: $.info\n", "rule custom: then is required"},
+		{"bad selector", "rules:\n  custom:\n    given: info\n    then:\n      function: truthy\n", "must start with $"},
+		{"unknown function", "rules:\n  custom:\n    given: $.info\n    then:\n      function: nope\n", `unknown function "nope"`},
+		{"override without files", "overrides:\n  - rules:\n      info-contact: off\n", "files is required"},
+		{"override unknown rule", "overrides:\n  - files: [a.yaml]\n    rules:\n      nope: off\n", "rule nope: unknown rule"},
+		{"bad glob", "overrides:\n  - files: ['[a']\n    rules:\n      info-contact: off\n", "invalid file pattern"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRuleset([]byte(tt.ruleset))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{"*.yaml", "specs/billing/asyncapi.yaml", true},
+		{"*.json", "specs/billing/asyncapi.yaml", false},
+		{"specs/*/asyncapi.yaml", "specs/billing/asyncapi.yaml", true},
+		{"specs/*.yaml", "specs/billing/asyncapi.yaml", false},
+		{"specs/**", "specs/billing/asyncapi.yaml", true},
+		{"**/billing/*", "specs/billing/asyncapi.yaml", true},
+		{"**/asyncapi.yaml", "asyncapi.yaml", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.name, func(t *testing.T) {
+			ok, err := matchGlob(tt.pattern, tt.name)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ok)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Selector is a compiled JSON-path-like expression used by a rule's given.
+//
+// The supported syntax is a subset of JSONPath:
+//
+//	$                 the document root
+//	.name             a child by name
+//	['name']          a child by name, for names containing special characters
+//	.* or [*]         every child of an object or array
+//	[0]               an array element by index
+//	[a,'b']           a union of children
+//	..name or ..*     recursive descent
+type Selector struct {
+	expr  string
+	steps []step
+}
+
+type step struct {
+	recursive bool
+	wildcard  bool
+	names     []string
+}
+
+// node is a value found by a selector along with its location in the document
+type node struct {
+	path  []string
+	value any
+}
+
+// ParseSelector compiles a selector expression
+func ParseSelector(expr string) (*Selector, error) {
+	s := strings.TrimSpace(expr)
+	if !strings.HasPrefix(s, "$") {
+		return nil, fmt.Errorf("invalid selector %q: must start with $", expr)
+	}
+	s = s[1:]
+
+	sel := &Selector{expr: expr}
+	for len(s) > 0 {
+		var st step
+		switch {
+		case strings.HasPrefix(s, ".."):
+			st.recursive = true
+			s = s[2:]
+			if strings.HasPrefix(s, "[") {
+				break
+			}
+			name, rest := readName(s)
+			if name == "" {
+				return nil, fmt.Errorf("invalid selector %q: expected name after ..", expr)
+			}
+			st.wildcard = name == "*"
+			if !st.wildcard {
+				st.names = []string{name}
+			}
+			s = rest
+			sel.steps = append(sel.steps, st)
+			continue
+		case strings.HasPrefix(s, "."):
+			name, rest := readName(s[1:])
+			if name == "" {
+				return nil, fmt.Errorf("invalid selector %q: expected name after .", expr)
+			}
+			if strings.ContainsAny(name, " \t'\"]") {
+				return nil, fmt.Errorf("invalid selector %q: use bracket notation for the name %q", expr, name)
+			}
+			st.wildcard = name == "*"
+			if !st.wildcard {
+				st.names = []string{name}
+			}
+			s = rest
+			sel.steps = append(sel.steps, st)
+			continue
+		case !strings.HasPrefix(s, "["):
+			return nil, fmt.Errorf("invalid selector %q: unexpected %q", expr, s)
+		}
+
+		end := closingBracket(s)
+		if end < 0 {
+			return nil, fmt.Errorf("invalid selector %q: unterminated [", expr)
+		}
+		inner := strings.TrimSpace(s[1:end])
+		s = s[end+1:]
+		if inner == "*" {
+			st.wildcard = true
+		} else {
+			names, err := splitUnion(inner)
+			if err != nil {
+				return nil, fmt.Errorf("invalid selector %q: %w", expr, err)
+			}
+			st.names = names
+		}
+		sel.steps = append(sel.steps, st)
+	}
+
+	return sel, nil
+}
+
+// String returns the source expression of the selector
+func (s *Selector) String() string {
+	return s.expr
+}
+
+// find returns every node in root matched by the selector, in document order
+func (s *Selector) find(root any) []node {
+	nodes := []node{{path: nil, value: root}}
+	for _, st := range s.steps {
+		var next []node
+		for _, n := range nodes {
+			if st.recursive {
+				for _, d := range descendants(n) {
+					next = append(next, st.apply(d)...)
+				}
+				continue
+			}
+			next = append(next, st.apply(n)...)
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// apply returns the children of n selected by the step
+func (st step) apply(n node) []node {
+	if st.wildcard {
+		return children(n)
+	}
+
+	var out []node
+	for _, name := range st.names {
+		switch v := n.value.(type) {
+		case map[string]any:
+			if child, ok := v[name]; ok {
+				out = append(out, node{path: appendPath(n.path, name), value: child})
+			}
+		case []any:
+			i, err := strconv.Atoi(name)
+			if err == nil && i >= 0 && i < len(v) {
+				out = append(out, node{path: appendPath(n.path, name), value: v[i]})
+			}
+		}
+	}
+	return out
+}
+
+// children returns the direct children of n in a stable order
+func children(n node) []node {
+	var out []node
+	switch v := n.value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			out = append(out, node{path: appendPath(n.path, k), value: v[k]})
+		}
+	case []any:
+		for i, child := range v {
+			out = append(out, node{path: appendPath(n.path, strconv.Itoa(i)), value: child})
+		}
+	}
+	return out
+}
+
+// descendants returns n and every node below it, depth first
+func descendants(n node) []node {
+	out := []node{n}
+	for _, child := range children(n) {
+		out = append(out, descendants(child)...)
+	}
+	return out
+}
+
+func appendPath(path []string, segment string) []string {
+	out := make([]string, len(path), len(path)+1)
+	copy(out, path)
+	return append(out, segment)
+}
+
+// readName reads a dot-notation name, stopping at the next . or [
+func readName(s string) (string, string) {
+	i := strings.IndexAny(s, ".[")
+	if i < 0 {
+		return s, ""
+	}
+	return s[:i], s[i:]
+}
+
+// closingBracket returns the index of the ] matching the [ at the start of s,
+// ignoring brackets inside quoted names
+func closingBracket(s string) int {
+	var quote byte
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+// splitUnion splits the contents of a bracket expression into names
+func splitUnion(s string) ([]string, error) {
+	var names []string
+	for len(s) > 0 {
+		s = strings.TrimSpace(s)
+		var name string
+		if s[0] == '\'' || s[0] == '"' {
+			end := strings.IndexByte(s[1:], s[0])
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quote in [%s]", s)
+			}
+			name = s[1 : end+1]
+			s = strings.TrimSpace(s[end+2:])
+		} else {
+			end := strings.IndexByte(s, ',')
+			if end < 0 {
+				end = len(s)
+			}
+			name = strings.TrimSpace(s[:end])
+			s = s[end:]
+		}
+		if name == "" {
+			return nil, fmt.Errorf("empty name in bracket expression")
+		}
+		names = append(names, name)
+
+		if len(s) > 0 {
+			if s[0] != ',' {
+				return nil, fmt.Errorf("expected , in bracket expression, got %q", s)
+			}
+			s = s[1:]
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,76 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelector_Find(t *testing.T) {
+	doc := map[string]any{
+		"info": map[string]any{"title": "Test"},
+		"channels": map[string]any{
+			"user/signedup": map[string]any{
+				"publish":   map[string]any{"operationId": "a"},
+				"subscribe": map[string]any{"operationId": "b"},
+			},
+			"user/deleted": map[string]any{
+				"publish": map[string]any{"operationId": "c"},
+			},
+		},
+		"tags": []any{
+			map[string]any{"name": "first"},
+			map[string]any{"name": "second"},
+		},
+	}
+
+	tests := []struct {
+		selector string
+		expected [][]string
+	}{
+		{"$", [][]string{nil}},
+		{"$.info", [][]string{{"info"}}},
+		{"$['info'].title", [][]string{{"info", "title"}}},
+		{"$.channels[*]", [][]string{{"channels", "user/deleted"}, {"channels", "user/signedup"}}},
+		{"$.channels['user/signedup'].*", [][]string{
+			{"channels", "user/signedup", "publish"},
+			{"channels", "user/signedup", "subscribe"},
+		}},
+		{"$.channels[*][publish,subscribe]", [][]string{
+			{"channels", "user/deleted", "publish"},
+			{"channels", "user/signedup", "publish"},
+			{"channels", "user/signedup", "subscribe"},
+		}},
+		{"$.tags[1].name", [][]string{{"tags", "1", "name"}}},
+		{"$..operationId", [][]string{
+			{"channels", "user/deleted", "publish", "operationId"},
+			{"channels", "user/signedup", "publish", "operationId"},
+			{"channels", "user/signedup", "subscribe", "operationId"},
+		}},
+		{"$..['name']", [][]string{{"tags", "0", "name"}, {"tags", "1", "name"}}},
+		{"$.missing[*]", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			sel, err := ParseSelector(tt.selector)
+			require.NoError(t, err)
+
+			var paths [][]string
+			for _, n := range sel.find(doc) {
+				paths = append(paths, n.path)
+			}
+			assert.Equal(t, tt.expected, paths)
+		})
+	}
+}
+
+func TestParseSelector_Invalid(t *testing.T) {
+	for _, expr := range []string{"channels", "$.", "$[", "$['a'", "$[a,,b]", "$.a b"} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := ParseSelector(expr)
+			assert.Error(t, err)
+		})
+	}
+}
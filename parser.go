@@ -1,16 +1,25 @@
 package asyncapi
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/charlie-haley/asyncapi-go/asyncapi2"
+	"github.com/charlie-haley/asyncapi-go/jsonnumber"
+	"github.com/charlie-haley/asyncapi-go/internal/ordered"
 	"github.com/charlie-haley/asyncapi-go/internal/refresolver"
+	"github.com/charlie-haley/asyncapi-go/internal/validation"
 	"github.com/charlie-haley/asyncapi-go/spec"
-	"sigs.k8s.io/yaml"
 )
 
 // BindingUnmarshaler represents a binding that can unmarshal itself
@@ -19,10 +28,73 @@ type BindingUnmarshaler interface {
 	UnmarshalYAML(func(interface{}) error) error
 }
 
+// CodeRefSibling is the code of diagnostics for properties next to a $ref
+const CodeRefSibling = "ref-sibling"
+
+// ValidationMode specifies how validation findings affect a parse
+type ValidationMode int
+
+const (
+	// ValidationStrict fails the parse if validation reports any errors
+	ValidationStrict ValidationMode = iota
+	// ValidationWarn validates the document but never fails the parse,
+	// findings are only passed to ParseOptions.OnDiagnostics
+	ValidationWarn
+	// ValidationOff skips validation entirely
+	ValidationOff
+)
+
+// MetaSchemaFunc returns the meta-schema to validate a document against.
+// It's given the document's AsyncAPI version and the official JSON Schema
+// for that version, which is nil if the version is unknown, and may return
+// a patched copy or a different schema altogether.
+type MetaSchemaFunc func(version string, schema []byte) ([]byte, error)
+
 // ParseOptions contains options for parsing AsyncAPI documents
 type ParseOptions struct {
 	// FilePath is the path to the file being parsed. This is used to resolve relative refs.
 	FilePath string
+	// Validation specifies whether validation errors fail the parse, defaulting to ValidationStrict
+	Validation ValidationMode
+	// MetaSchema supplies a custom meta-schema in place of the official AsyncAPI JSON Schema
+	MetaSchema MetaSchemaFunc
+	// AllowPartial returns the parsed document along with the error when
+	// validation fails or a value has the wrong type, rather than a nil document
+	AllowPartial bool
+	// OnDiagnostics is called with every validation finding, including
+	// warnings about unknown, ignored and deprecated fields, unless
+	// validation is off or there are none
+	OnDiagnostics func(spec.Diagnostics)
+	// RefCache shares referenced files and remote documents between parses
+	RefCache *RefCache
+	// OnRef is called with the path or URL of every file or remote document
+	// the document references, directly or through other referenced files.
+	// Paths are slash separated paths within the fs.FS for ParseFS.
+	OnRef func(location string)
+}
+
+// RefCache holds the referenced files and remote documents read while
+// parsing, so that documents sharing them only read them once. A RefCache is
+// safe for concurrent use, but should only be shared between parses of the
+// same file system.
+type RefCache struct {
+	shared *refresolver.SharedCache
+}
+
+// NewRefCache creates an empty RefCache
+func NewRefCache() *RefCache {
+	return &RefCache{shared: refresolver.NewSharedCache()}
+}
+
+// ValidationError is returned when a document fails validation
+type ValidationError struct {
+	// Diagnostics holds the findings with error severity
+	Diagnostics spec.Diagnostics
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("validation failed: %s", e.Diagnostics.Error())
 }
 
 // ParseBindings processes bindings for a given channel/operation/message
@@ -34,7 +106,7 @@ func ParseBindings[T any](rawBindings map[string]interface{}, bindingType string
 			return nil, fmt.Errorf("failed to marshal %s binding: %w", bindingType, err)
 		}
 
-		if err := json.Unmarshal(data, &binding); err != nil {
+		if err := jsonnumber.Unmarshal(data, &binding); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal %s binding: %w", bindingType, err)
 		}
 		return &binding, nil
@@ -45,63 +117,253 @@ func ParseBindings[T any](rawBindings map[string]interface{}, bindingType string
 
 // ParseFromJSON parses an AsyncAPI document from JSON
 func ParseFromJSON(data []byte, opts ...ParseOptions) (spec.Document, error) {
-	var jsonDoc interface{}
-	if err := json.Unmarshal(data, &jsonDoc); err != nil {
+	return parseJSON(context.Background(), data, nil, parseOptions(opts))
+}
+
+// parseJSON parses a JSON document, reading file refs from fsys if it's set
+// or the OS file system otherwise
+func parseJSON(ctx context.Context, data []byte, fsys fs.FS, opt ParseOptions) (spec.Document, error) {
+	// Go maps don't keep the order of keys, so it's recorded to marshal the
+	// resolved document in source order
+	jsonDoc, keys, err := ordered.DecodeJSON(data)
+	if err != nil {
 		return nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
 
-	basePath := "."
-	if len(opts) > 0 && opts[0].FilePath != "" {
-		basePath = filepath.Dir(opts[0].FilePath)
+	var resolver *refresolver.RefResolver
+	if fsys != nil {
+		resolver = refresolver.NewFS(fsys, path.Dir(opt.FilePath))
+	} else {
+		basePath := "."
+		if opt.FilePath != "" {
+			basePath = filepath.Dir(opt.FilePath)
+		}
+		resolver = refresolver.New(basePath)
 	}
-
-	resolver := refresolver.New(basePath)
 	resolver.Cache["#"] = jsonDoc
+	var sourceDiags spec.Diagnostics
+	if opt.Validation != ValidationOff {
+		sourceDiags = refSiblings(jsonDoc, nil)
+	}
+	resolver.OnLoad = opt.OnRef
+	if opt.RefCache != nil {
+		resolver.Shared = opt.RefCache.shared
+	}
 
-	resolvedDoc, err := resolver.ResolveRefs(jsonDoc)
+	resolvedDoc, err := resolver.ResolveRefs(ctx, jsonDoc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve references: %w", err)
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse document: %w", err)
+	}
 
-	resolvedData, err := json.Marshal(resolvedDoc)
+	resolvedData, err := keys.Marshal(resolvedDoc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal resolved document: %w", err)
 	}
 
-	var versionDoc struct {
-		Version string `json:"asyncapi"`
-	}
-	if err := json.Unmarshal(resolvedData, &versionDoc); err != nil {
-		return nil, fmt.Errorf("failed to parse document version: %w", err)
+	root, ok := resolvedDoc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("failed to parse document version: expected an object, got %T", resolvedDoc)
 	}
+	version, _ := root["asyncapi"].(string)
 
 	switch {
-	case strings.HasPrefix(versionDoc.Version, "2."):
-		doc, err := asyncapi2.ParseFromJSON(resolvedData)
-		if err != nil {
-			return nil, err
+	case strings.HasPrefix(version, "2."):
+		var doc asyncapi2.Document
+		if err := jsonnumber.Unmarshal(resolvedData, &doc); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if opt.AllowPartial && errors.As(err, &typeErr) {
+				return &doc, fmt.Errorf("failed to parse JSON: %w", err)
+			}
+			return nil, fmt.Errorf("failed to parse JSON: %w", err)
 		}
-		return doc, nil
+		doc.WithSource(root)
+		if opt.Validation != ValidationOff {
+			sourceDiags = append(sourceDiags, asyncapi2.DiagnoseSource(root)...)
+		}
+		return validateDocument(&doc, sourceDiags, opt)
 	default:
-		return nil, fmt.Errorf("unsupported AsyncAPI version: %s", versionDoc.Version)
+		return nil, fmt.Errorf("unsupported AsyncAPI version: %s", version)
+	}
+}
+
+// validateDocument validates a parsed document as specified by the options,
+// reporting sourceDiags, the findings from its source, alongside
+func validateDocument(doc *asyncapi2.Document, sourceDiags spec.Diagnostics, opt ParseOptions) (spec.Document, error) {
+	if opt.Validation == ValidationOff {
+		return doc, nil
+	}
+
+	var metaSchema []byte
+	if opt.MetaSchema != nil {
+		// The official schema is nil for unknown versions
+		official, _ := validation.MetaSchema(doc.AsyncAPI)
+		var err error
+		if metaSchema, err = opt.MetaSchema(doc.AsyncAPI, official); err != nil {
+			return nil, fmt.Errorf("failed to load meta-schema: %w", err)
+		}
+	}
+
+	diags, err := doc.Diagnose(metaSchema)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	diags = append(diags, sourceDiags...)
+	if opt.OnDiagnostics != nil && len(diags) > 0 {
+		opt.OnDiagnostics(diags)
+	}
+	if opt.Validation == ValidationWarn || !diags.HasErrors() {
+		return doc, nil
 	}
+
+	validationErr := &ValidationError{Diagnostics: diags.Errors()}
+	if opt.AllowPartial {
+		return doc, validationErr
+	}
+	return nil, validationErr
+}
+
+// refSiblings reports the properties next to a $ref, which are ignored as
+// the ref replaces the object it's in. Only the document itself is checked,
+// not the files it references.
+func refSiblings(value interface{}, path []string) spec.Diagnostics {
+	var diags spec.Diagnostics
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		if ref, ok := v["$ref"].(string); ok && len(keys) > 1 {
+			var siblings []string
+			for _, key := range keys {
+				if key != "$ref" {
+					siblings = append(siblings, key)
+				}
+			}
+			verb := "is"
+			if len(siblings) > 1 {
+				verb = "are"
+			}
+			diags = append(diags, spec.Diagnostic{
+				Code:     CodeRefSibling,
+				Severity: spec.SeverityWarning,
+				Path:     spec.JSONPointer(path...),
+				Message:  fmt.Sprintf("%s next to $ref %q %s ignored", describeKeys(siblings), ref, verb),
+			})
+			return diags
+		}
+
+		for _, key := range keys {
+			diags = append(diags, refSiblings(v[key], append(path[:len(path):len(path)], key))...)
+		}
+	case []interface{}:
+		for i, item := range v {
+			diags = append(diags, refSiblings(item, append(path[:len(path):len(path)], strconv.Itoa(i)))...)
+		}
+	}
+	return diags
+}
+
+// describeKeys lists keys for a diagnostic message
+func describeKeys(keys []string) string {
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = strconv.Quote(key)
+	}
+	if len(keys) == 1 {
+		return "property " + quoted[0]
+	}
+	return "properties " + strings.Join(quoted, ", ")
+}
+
+// ParseWithDiagnostics parses a JSON or YAML document like Parse, also
+// returning the findings that don't fail the parse: warnings about unknown
+// fields, with suggestions for likely misspellings, deprecated channels and
+// messages, and values that are ignored. Errors are only returned in the
+// diagnostics when opts allow them, with ValidationWarn; otherwise they fail
+// the parse with a *ValidationError. Nothing is reported when validation is
+// off.
+func ParseWithDiagnostics(data []byte, opts ...ParseOptions) (spec.Document, spec.Diagnostics, error) {
+	opt := parseOptions(opts)
+	var diags spec.Diagnostics
+	onDiagnostics := opt.OnDiagnostics
+	opt.OnDiagnostics = func(d spec.Diagnostics) {
+		diags = d
+		if onDiagnostics != nil {
+			onDiagnostics(d)
+		}
+	}
+
+	doc, err := parse(context.Background(), data, nil, opt)
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		diags = diags.NonErrors()
+	}
+	return doc, diags, err
 }
 
 // ParseFromYAML parses an AsyncAPI document from YAML
 func ParseFromYAML(data []byte, opts ...ParseOptions) (spec.Document, error) {
-	jsonData, err := yaml.YAMLToJSON(data)
+	return parseYAML(context.Background(), data, nil, parseOptions(opts))
+}
+
+func parseYAML(ctx context.Context, data []byte, fsys fs.FS, opt ParseOptions) (spec.Document, error) {
+	jsonData, err := ordered.YAMLToJSON(data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert YAML to JSON: %w", err)
 	}
-	return ParseFromJSON(jsonData, opts...)
+	return parseJSON(ctx, jsonData, fsys, opt)
 }
 
 // Parse detects format and parses accordingly
 func Parse(data []byte, opts ...ParseOptions) (spec.Document, error) {
+	return parse(context.Background(), data, nil, parseOptions(opts))
+}
+
+func parse(ctx context.Context, data []byte, fsys fs.FS, opt ParseOptions) (spec.Document, error) {
 	if isYAML(data) {
-		return ParseFromYAML(data, opts...)
+		return parseYAML(ctx, data, fsys, opt)
+	}
+	return parseJSON(ctx, data, fsys, opt)
+}
+
+// ParseReader reads a JSON or YAML document from r and parses it. ctx
+// applies to the whole parse, including fetching remote refs. Relative file
+// refs are resolved from the directory of opts.FilePath, if set, or the
+// working directory.
+func ParseReader(ctx context.Context, r io.Reader, opts ...ParseOptions) (spec.Document, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document: %w", err)
+	}
+	return parse(ctx, data, nil, parseOptions(opts))
+}
+
+// ParseFS reads and parses the document at name in fsys, resolving relative
+// file refs within fsys. ctx applies to the whole parse, including fetching
+// remote refs. Any FilePath in opts is replaced by name.
+func ParseFS(ctx context.Context, fsys fs.FS, name string, opts ...ParseOptions) (spec.Document, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	opt := parseOptions(opts)
+	opt.FilePath = name
+	return parse(ctx, data, fsys, opt)
+}
+
+// parseOptions returns the first of opts, or the defaults if there are none
+func parseOptions(opts []ParseOptions) ParseOptions {
+	if len(opts) > 0 {
+		return opts[0]
 	}
-	return ParseFromJSON(data, opts...)
+	return ParseOptions{}
 }
 
 // isYAML determines if the input appears to be YAML
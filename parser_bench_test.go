@@ -0,0 +1,50 @@
+package asyncapi
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// BenchmarkParse parses each valid document in testdata, reporting
+// allocations so that regressions in the parse pipeline show up
+func BenchmarkParse(b *testing.B) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "valid_*"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			b.Fatal(err)
+		}
+		name := strings.TrimPrefix(filepath.Base(path), "valid_")
+
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				if _, err := Parse(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkParse_NoValidation isolates decoding and ref resolution from validation
+func BenchmarkParse_NoValidation(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "valid_2_6_0_kafka_avro.yaml"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse(data, ParseOptions{Validation: ValidationOff}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
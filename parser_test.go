@@ -1,15 +1,33 @@
 package asyncapi
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
+	"testing/fstest"
+	"testing/iotest"
+	"time"
 
 	"github.com/charlie-haley/asyncapi-go/asyncapi2"
 	"github.com/charlie-haley/asyncapi-go/bindings/amqp"
+	"github.com/charlie-haley/asyncapi-go/bindings/googlepubsub"
+	httpbinding "github.com/charlie-haley/asyncapi-go/bindings/http"
+	"github.com/charlie-haley/asyncapi-go/bindings/ibmmq"
 	"github.com/charlie-haley/asyncapi-go/bindings/kafka"
+	"github.com/charlie-haley/asyncapi-go/bindings/mqtt"
+	"github.com/charlie-haley/asyncapi-go/bindings/solace"
+	"github.com/charlie-haley/asyncapi-go/bindings/websockets"
+	"github.com/charlie-haley/asyncapi-go/internal/validation"
+	"github.com/charlie-haley/asyncapi-go/spec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -83,7 +101,7 @@ func TestParseFileWithRelativeRefs(t *testing.T) {
 	require.True(t, ok, "document should be v2")
 
 	// Verify channel exists
-	channel, ok := v2Doc.Channels["customer/status"]
+	channel, ok := v2Doc.Channels.Get("customer/status")
 	require.True(t, ok, "channel should exist")
 	require.NotNil(t, channel.Publish, "publish operation should exist")
 	require.NotNil(t, channel.Publish.Message, "message should exist")
@@ -192,7 +210,7 @@ func TestParseNestedRefs(t *testing.T) {
 	require.True(t, ok, "document should be v2")
 
 	// Verify message and schema were properly resolved
-	channel, ok := v2Doc.Channels["user-signedup"]
+	channel, ok := v2Doc.Channels.Get("user-signedup")
 	require.True(t, ok, "channel should exist")
 	require.NotNil(t, channel)
 
@@ -271,7 +289,7 @@ func TestParseWithLocalRefs(t *testing.T) {
 	require.True(t, ok, "document should be v2")
 
 	// Verify message and schema were properly resolved
-	channel, ok := v2Doc.Channels["user-signedup"]
+	channel, ok := v2Doc.Channels.Get("user-signedup")
 	require.True(t, ok, "channel should exist")
 	require.NotNil(t, channel)
 
@@ -427,6 +445,460 @@ func TestParseFromTestData(t *testing.T) {
 	assert.NoError(t, err, "Error walking testdata directory")
 }
 
+// The invalid_*_binding fixtures are the valid_* fixtures as they were before
+// bindings were validated against the official binding schemas
+func TestParseFromTestData_InvalidBindings(t *testing.T) {
+	tests := map[string][]string{
+		"invalid_2_0_0_amqp_binding.json": {
+			"/channels/user~1signup/bindings/amqp: amqp channel binding 0.2.0: Must validate one and only one schema (oneOf)",
+			"/channels/user~1signup/bindings/amqp: amqp channel binding 0.2.0: Must not validate the schema (not)",
+		},
+		"invalid_2_1_0_kafka_binding.yaml": {
+			"/channels/user-signedup/bindings/kafka: kafka channel binding 0.4.0: Additional property clientId is not allowed",
+			"/channels/user-signedup/bindings/kafka: kafka channel binding 0.4.0: Additional property groupId is not allowed",
+		},
+		"invalid_2_4_0_websockets_binding.json": {
+			"/channels/chat/bindings/ws: ws channel binding 0.1.0: Additional property type is not allowed",
+		},
+		"invalid_2_5_0_mqtt_binding.yaml": {
+			"/channels/sensor~1data/publish/message/bindings/mqtt: mqtt message binding 0.2.0: Additional property qos is not allowed",
+			"/channels/sensor~1data/publish/message/bindings/mqtt: mqtt message binding 0.2.0: Additional property retain is not allowed",
+		},
+	}
+	for name, expected := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("testdata", name))
+			require.NoError(t, err)
+
+			_, err = Parse(data)
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			var messages []string
+			for _, diag := range validationErr.Diagnostics {
+				assert.Equal(t, validation.CodeBindingSchema, diag.Code)
+				messages = append(messages, diag.String())
+			}
+			assert.ElementsMatch(t, expected, messages)
+		})
+	}
+}
+
+// invalidInfoSpec has an invalid contact email and uses an undefined server
+const invalidInfoSpec = `
+asyncapi: 2.6.0
+info:
+  title: Users
+  version: 1.0.0
+  contact:
+    email: not-an-email
+servers:
+  production:
+    url: broker.example.com
+    protocol: kafka
+channels:
+  user/signedup:
+    servers: [staging]
+    subscribe:
+      message:
+        payload:
+          type: string
+`
+
+func TestParseOptions_Validation(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        ParseOptions
+		expectDoc   bool
+		expectError bool
+	}{
+		{name: "strict", opts: ParseOptions{}, expectError: true},
+		{name: "strict partial", opts: ParseOptions{AllowPartial: true}, expectDoc: true, expectError: true},
+		{name: "warn", opts: ParseOptions{Validation: ValidationWarn}, expectDoc: true},
+		{name: "off", opts: ParseOptions{Validation: ValidationOff}, expectDoc: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reported spec.Diagnostics
+			tt.opts.OnDiagnostics = func(diags spec.Diagnostics) {
+				reported = diags
+			}
+
+			doc, err := Parse([]byte(invalidInfoSpec), tt.opts)
+			if tt.expectError {
+				var validationErr *ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, spec.Diagnostics{
+					{Code: "document-schema", Severity: spec.SeverityError, Path: "/info/contact/email", Message: "Does not match format 'email'"},
+					{Code: asyncapi2.CodeChannelServerUndefined, Severity: spec.SeverityError, Path: "/channels/user~1signedup/servers/0", Message: `server "staging" is not defined in servers`},
+				}, validationErr.Diagnostics)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if !tt.expectDoc {
+				assert.Nil(t, doc)
+				return
+			}
+			require.NotNil(t, doc)
+			assert.Equal(t, "1.0.0", doc.(*asyncapi2.Document).Info.Version)
+			if tt.opts.Validation == ValidationOff {
+				assert.Nil(t, reported)
+			} else {
+				assert.Len(t, reported, 2)
+			}
+		})
+	}
+}
+
+func TestParseOptions_AllowPartialWrongType(t *testing.T) {
+	data := []byte(`{"asyncapi": "2.6.0", "info": {"title": "Users", "version": 1}, "channels": {}}`)
+
+	doc, err := ParseFromJSON(data)
+	assert.Error(t, err)
+	assert.Nil(t, doc)
+
+	doc, err = ParseFromJSON(data, ParseOptions{AllowPartial: true})
+	assert.ErrorContains(t, err, "failed to parse JSON")
+	require.NotNil(t, doc)
+	assert.Equal(t, "Users", doc.(*asyncapi2.Document).Info.Title)
+}
+
+func TestParseOptions_MetaSchema(t *testing.T) {
+	// Patch the official schema so contact emails can be any string
+	patch := func(version string, schema []byte) ([]byte, error) {
+		assert.Equal(t, "2.6.0", version)
+		var root map[string]any
+		if err := json.Unmarshal(schema, &root); err != nil {
+			return nil, err
+		}
+		contact := root["definitions"].(map[string]any)["http://asyncapi.com/definitions/2.6.0/contact.json"].(map[string]any)
+		delete(contact["properties"].(map[string]any)["email"].(map[string]any), "format")
+		return json.Marshal(root)
+	}
+
+	var reported spec.Diagnostics
+	_, err := Parse([]byte(invalidInfoSpec), ParseOptions{
+		MetaSchema:    patch,
+		OnDiagnostics: func(diags spec.Diagnostics) { reported = diags },
+	})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, reported, 1)
+	assert.Equal(t, asyncapi2.CodeChannelServerUndefined, reported[0].Code)
+
+	// A custom schema replaces the official one entirely
+	custom := func(version string, schema []byte) ([]byte, error) {
+		return []byte(`{"type": "object", "required": ["x-owner"]}`), nil
+	}
+	_, err = ParseFromJSON([]byte(`{"asyncapi": "2.6.0", "info": {"title": "Users", "version": "1.0.0"}, "channels": {}}`), ParseOptions{MetaSchema: custom})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "x-owner is required", validationErr.Diagnostics[0].Message)
+
+	failing := func(version string, schema []byte) ([]byte, error) {
+		return nil, errors.New("registry unavailable")
+	}
+	_, err = Parse([]byte(invalidInfoSpec), ParseOptions{MetaSchema: failing})
+	assert.ErrorContains(t, err, "failed to load meta-schema: registry unavailable")
+}
+
+func TestParseWithDiagnostics(t *testing.T) {
+	data := []byte(`
+asyncapi: 2.6.0
+info:
+  title: Users
+  version: 1.0.0
+channels:
+  user/signedup:
+    subcribe:
+      message:
+        $ref: '#/components/messages/UserSignedUp'
+    publish:
+      message:
+        $ref: '#/components/messages/UserSignedUp'
+        description: Overridden
+components:
+  messages:
+    UserSignedUp:
+      deprecated: true
+      payload:
+        type: string
+`)
+
+	expected := spec.Diagnostics{
+		{Code: CodeRefSibling, Severity: spec.SeverityWarning, Path: "/channels/user~1signedup/publish/message", Message: `property "description" next to $ref "#/components/messages/UserSignedUp" is ignored`},
+		{Code: asyncapi2.CodeDeprecated, Severity: spec.SeverityWarning, Path: "/channels/user~1signedup/publish/message", Message: "message is deprecated"},
+		{Code: asyncapi2.CodeUnknownField, Severity: spec.SeverityWarning, Path: "/channels/user~1signedup/subcribe", Message: `unknown field "subcribe" is ignored, did you mean "subscribe"?`},
+		{Code: asyncapi2.CodeDeprecated, Severity: spec.SeverityWarning, Path: "/components/messages/UserSignedUp", Message: "message is deprecated"},
+	}
+
+	var reported spec.Diagnostics
+	doc, diags, err := ParseWithDiagnostics(data, ParseOptions{
+		OnDiagnostics: func(d spec.Diagnostics) { reported = d },
+	})
+	require.NoError(t, err)
+	require.NotNil(t, doc)
+	assert.Equal(t, expected, diags)
+	assert.Equal(t, expected, reported)
+
+	_, diags, err = ParseWithDiagnostics(data, ParseOptions{Validation: ValidationOff})
+	require.NoError(t, err)
+	assert.Empty(t, diags)
+}
+
+func TestParseWithDiagnostics_Errors(t *testing.T) {
+	data := []byte(invalidInfoSpec + "    x-extra: true\n    servrs: []\n")
+
+	// Errors fail the parse and are only returned in the error
+	doc, diags, err := ParseWithDiagnostics(data)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Nil(t, doc)
+	assert.Len(t, validationErr.Diagnostics, 2)
+	require.Len(t, diags, 1)
+	assert.Equal(t, `unknown field "servrs" is ignored, did you mean "servers"?`, diags[0].Message)
+
+	// Unless they're allowed
+	doc, diags, err = ParseWithDiagnostics(data, ParseOptions{Validation: ValidationWarn})
+	require.NoError(t, err)
+	assert.NotNil(t, doc)
+	assert.Len(t, diags.Errors(), 2)
+	assert.Len(t, diags.Warnings(), 1)
+}
+
+func TestParseWithDiagnostics_SourceSchema(t *testing.T) {
+	// license isn't held by the model, but is still checked against the
+	// schema, while the unknown field is only a warning
+	data := []byte(`
+asyncapi: 2.6.0
+info:
+  title: Account Service
+  version: 1.0.0
+  license:
+    url: https://www.apache.org/licenses/LICENSE-2.0
+  lisence: Apache 2.0
+channels: {}
+`)
+	_, diags, err := ParseWithDiagnostics(data, ParseOptions{Validation: ValidationWarn})
+	require.NoError(t, err)
+	assert.Equal(t, spec.Diagnostics{{
+		Code:     validation.CodeDocumentSchema,
+		Severity: spec.SeverityError,
+		Path:     "/info/license",
+		Message:  "name is required",
+	}}, diags.Errors())
+	require.Len(t, diags.Warnings(), 1)
+	assert.Equal(t, "/info/lisence", diags.Warnings()[0].Path)
+}
+
+func TestParse_KeyOrder(t *testing.T) {
+	yamlData := []byte(`
+asyncapi: 2.6.0
+info:
+  title: Users
+  version: 1.0.0
+servers:
+  staging: {url: staging.example.com, protocol: kafka}
+  production: {url: broker.example.com, protocol: kafka}
+channels:
+  user/signedup:
+    subscribe:
+      message:
+        $ref: '#/components/messages/UserSignedUp'
+  user/deleted:
+    publish:
+      message:
+        payload: {type: string}
+components:
+  messages:
+    UserSignedUp:
+      payload: {type: string}
+    UserDeleted:
+      payload: {type: string}
+`)
+	jsonData := []byte(`{
+  "asyncapi": "2.6.0",
+  "info": {"title": "Users", "version": "1.0.0"},
+  "servers": {
+    "staging": {"url": "staging.example.com", "protocol": "kafka"},
+    "production": {"url": "broker.example.com", "protocol": "kafka"}
+  },
+  "channels": {
+    "user/signedup": {"subscribe": {"message": {"$ref": "#/components/messages/UserSignedUp"}}},
+    "user/deleted": {"publish": {"message": {"payload": {"type": "string"}}}}
+  },
+  "components": {
+    "messages": {
+      "UserSignedUp": {"payload": {"type": "string"}},
+      "UserDeleted": {"payload": {"type": "string"}}
+    }
+  }
+}`)
+
+	for name, data := range map[string][]byte{"yaml": yamlData, "json": jsonData} {
+		t.Run(name, func(t *testing.T) {
+			doc, err := Parse(data)
+			require.NoError(t, err)
+			v2Doc := doc.(*asyncapi2.Document)
+			assert.Equal(t, []string{"staging", "production"}, v2Doc.Servers.Keys())
+			assert.Equal(t, []string{"user/signedup", "user/deleted"}, v2Doc.Channels.Keys())
+			assert.Equal(t, []string{"UserSignedUp", "UserDeleted"}, v2Doc.Components.Messages.Keys())
+
+			out, err := json.Marshal(doc)
+			require.NoError(t, err)
+			assert.Less(t, strings.Index(string(out), `"staging"`), strings.Index(string(out), `"production"`))
+		})
+	}
+}
+
+func TestParse_LargeNumbers(t *testing.T) {
+	yamlData := []byte(`
+asyncapi: 2.6.0
+info:
+  title: Users
+  version: 1.0.0
+channels:
+  users:
+    bindings:
+      kafka:
+        topicConfiguration:
+          retention.bytes: 9007199254740993
+          local.retention.bytes: 12345678901234567890
+    subscribe:
+      message:
+        payload:
+          $ref: '#/components/schemas/Id'
+components:
+  schemas:
+    Id:
+      type: integer
+      enum: [9007199254740993, 1000000000000]
+      const: 1000000000000
+`)
+	jsonData := []byte(`{
+  "asyncapi": "2.6.0",
+  "info": {"title": "Users", "version": "1.0.0"},
+  "channels": {
+    "users": {
+      "bindings": {"kafka": {"topicConfiguration": {"retention.bytes": 9007199254740993, "local.retention.bytes": 12345678901234567890}}},
+      "subscribe": {"message": {"payload": {"$ref": "#/components/schemas/Id"}}}
+    }
+  },
+  "components": {
+    "schemas": {
+      "Id": {"type": "integer", "enum": [9007199254740993, 1000000000000], "const": 1000000000000}
+    }
+  }
+}`)
+
+	for name, data := range map[string][]byte{"yaml": yamlData, "json": jsonData} {
+		t.Run(name, func(t *testing.T) {
+			doc, err := Parse(data)
+			require.NoError(t, err)
+			v2Doc := doc.(*asyncapi2.Document)
+
+			channel, _ := v2Doc.Channels.Get("users")
+			binding, err := ParseBindings[kafka.ChannelBinding](channel.Bindings, "kafka")
+			require.NoError(t, err)
+			assert.Equal(t, int64(9007199254740993), binding.TopicConfiguration.RetentionBytes)
+			assert.Equal(t, json.Number("12345678901234567890"), binding.TopicConfiguration.AdditionalProperties["local.retention.bytes"])
+
+			out, err := json.Marshal(doc)
+			require.NoError(t, err)
+			assert.Contains(t, string(out), `"enum":[9007199254740993,1000000000000]`)
+			assert.Contains(t, string(out), `"const":1000000000000`)
+			assert.Contains(t, string(out), `"local.retention.bytes":12345678901234567890`)
+			assert.NotContains(t, string(out), "e+")
+		})
+	}
+}
+
+func TestParseReader(t *testing.T) {
+	data := []byte(`
+asyncapi: 2.6.0
+info:
+  title: Users
+  version: 1.0.0
+channels: {}
+`)
+
+	doc, err := ParseReader(context.Background(), bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, "2.6.0", doc.GetVersion())
+
+	_, err = ParseReader(context.Background(), iotest.ErrReader(errors.New("connection reset")))
+	assert.ErrorContains(t, err, "failed to read document: connection reset")
+}
+
+func TestParseReader_Cancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	data := []byte(`{
+		"asyncapi": "2.6.0",
+		"info": {"title": "Users", "version": "1.0.0"},
+		"channels": {"users": {"publish": {"message": {"$ref": "` + server.URL + `/user.json"}}}}
+	}`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := ParseReader(ctx, bytes.NewReader(data))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = ParseReader(ctx, bytes.NewReader(data))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestParseFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"services/users/asyncapi.yaml": {Data: []byte(`
+asyncapi: 2.6.0
+info:
+  title: Users
+  version: 1.0.0
+channels:
+  user/signedup:
+    subscribe:
+      message:
+        $ref: ../../common/events.yaml
+`)},
+		"common/events.yaml": {Data: []byte(`
+name: UserSignedUp
+payload:
+  $ref: schemas/user.json
+`)},
+		"common/schemas/user.json": {Data: []byte(`{"type": "object", "properties": {"id": {"type": "string"}}}`)},
+		"escape.yaml": {Data: []byte(`
+asyncapi: 2.6.0
+info:
+  title: Escape
+  version: 1.0.0
+channels:
+  secrets:
+    subscribe:
+      message:
+        $ref: ../secrets.yaml
+`)},
+	}
+
+	doc, err := ParseFS(context.Background(), fsys, "services/users/asyncapi.yaml")
+	require.NoError(t, err)
+	channel, _ := doc.(*asyncapi2.Document).Channels.Get("user/signedup")
+	message := channel.Subscribe.Message
+	assert.Equal(t, "UserSignedUp", message.Name)
+	assert.Equal(t, "object", message.Payload.(map[string]any)["type"])
+
+	_, err = ParseFS(context.Background(), fsys, "escape.yaml")
+	assert.ErrorContains(t, err, "reference is outside the file system")
+
+	_, err = ParseFS(context.Background(), fsys, "missing.yaml")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
 // Test isYAML function
 func TestIsYAML(t *testing.T) {
 	tests := []struct {
@@ -524,7 +996,164 @@ func TestParseBindings_Kafka(t *testing.T) {
 	assert.True(t, reflect.DeepEqual(expected, binding), "Expected: %+v, Actual: %+v", expected, binding)
 }
 
+func TestParseBindings_MQTT(t *testing.T) {
+	doc, err := ParseFile(filepath.Join("testdata", "valid_2_5_0_mqtt.yaml"))
+	require.NoError(t, err)
+	v2Doc := doc.(*asyncapi2.Document)
+
+	server, _ := v2Doc.Servers.Get("mqtt")
+	serverBinding, err := ParseBindings[mqtt.ServerBinding](server.Bindings, "mqtt")
+	require.NoError(t, err)
+	cleanSession := false
+	qos := mqtt.QoSAtLeastOnce
+	assert.Equal(t, &mqtt.ServerBinding{
+		ClientID:     "sensor-gateway",
+		CleanSession: &cleanSession,
+		LastWill: &mqtt.LastWill{
+			Topic:   "sensor/disconnect",
+			QoS:     &qos,
+			Message: "Sensor disconnected",
+			Retain:  true,
+		},
+		KeepAlive:      60,
+		BindingVersion: "0.2.0",
+	}, serverBinding)
+
+	channel, _ := v2Doc.Channels.Get("sensor/data")
+	operationBinding, err := ParseBindings[mqtt.OperationBinding](channel.Publish.Bindings, "mqtt")
+	require.NoError(t, err)
+	assert.Equal(t, &mqtt.OperationBinding{QoS: &qos, BindingVersion: "0.2.0"}, operationBinding)
+
+	messageBinding, err := ParseBindings[mqtt.MessageBinding](channel.Publish.Message.Bindings, "mqtt")
+	require.NoError(t, err)
+	assert.Equal(t, &mqtt.MessageBinding{ContentType: "application/json", BindingVersion: "0.2.0"}, messageBinding)
+}
+
+func TestParseBindings_WebSockets(t *testing.T) {
+	doc, err := ParseFile(filepath.Join("testdata", "valid_2_4_0_websockets.json"))
+	require.NoError(t, err)
+	v2Doc := doc.(*asyncapi2.Document)
+
+	channel, _ := v2Doc.Channels.Get("chat")
+	binding, err := ParseBindings[websockets.ChannelBinding](channel.Bindings, "ws")
+	require.NoError(t, err)
+	assert.Equal(t, websockets.ChannelMethodGet, binding.Method)
+	assert.Equal(t, "0.1.0", binding.BindingVersion)
+
+	v, err := websockets.NewRequestValidator(binding)
+	require.NoError(t, err)
+	r := httptest.NewRequest(http.MethodGet, "/ws?token=abc", nil)
+	r.Header.Set("Authorization", "Bearer abc")
+	assert.NoError(t, v.Validate(r))
+}
+
+func TestParseBindings_GooglePubSub(t *testing.T) {
+	doc, err := ParseFile(filepath.Join("testdata", "valid_2_6_0_googlepubsub.yaml"))
+	require.NoError(t, err)
+	v2Doc := doc.(*asyncapi2.Document)
+
+	channel, _ := v2Doc.Channels.Get("orders")
+	channelBinding, err := ParseBindings[googlepubsub.ChannelBinding](channel.Bindings, "googlepubsub")
+	require.NoError(t, err)
+	assert.Equal(t, &googlepubsub.SchemaSettings{Encoding: "json", Name: "projects/my-project/schemas/order"}, channelBinding.SchemaSettings)
+	assert.Equal(t, []string{"us-central1"}, channelBinding.MessageStoragePolicy.AllowedPersistenceRegions)
+
+	message := channel.Publish.Message
+	messageBinding, err := ParseBindings[googlepubsub.MessageBinding](message.Bindings, "googlepubsub")
+	require.NoError(t, err)
+	assert.Equal(t, "customer-42", messageBinding.OrderingKey)
+
+	err = googlepubsub.CheckMessageSchema(channelBinding.SchemaSettings, messageBinding, message.EffectiveSchemaFormat(), message.EffectiveContentType())
+	assert.NoError(t, err)
+}
+
+func TestParseBindings_HTTP(t *testing.T) {
+	doc, err := Parse([]byte(`
+asyncapi: 2.6.0
+info:
+  title: Webhooks
+  version: 1.0.0
+channels:
+  /hooks/order-created:
+    subscribe:
+      bindings:
+        http:
+          method: POST
+          bindingVersion: 0.3.0
+      message:
+        payload: {type: object}
+        bindings:
+          http:
+            headers:
+              type: object
+              properties:
+                X-Signature: {type: string}
+            statusCode: 202
+            bindingVersion: 0.3.0
+`))
+	require.NoError(t, err)
+	v2Doc := doc.(*asyncapi2.Document)
+
+	channel, _ := v2Doc.Channels.Get("/hooks/order-created")
+	operationBinding, err := ParseBindings[httpbinding.OperationBinding](channel.Subscribe.Bindings, "http")
+	require.NoError(t, err)
+	assert.Equal(t, &httpbinding.OperationBinding{Method: http.MethodPost, BindingVersion: "0.3.0"}, operationBinding)
+
+	messageBinding, err := ParseBindings[httpbinding.MessageBinding](channel.Subscribe.Message.Bindings, "http")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, messageBinding.StatusCode)
+	assert.Equal(t, map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"X-Signature": map[string]any{"type": "string"}},
+	}, messageBinding.Headers)
+}
+
 // Test ParseBindings - Not Found
+func TestParseBindings_Solace(t *testing.T) {
+	doc, err := ParseFile(filepath.Join("testdata", "valid_2_6_0_enterprise.yaml"))
+	require.NoError(t, err)
+	v2Doc := doc.(*asyncapi2.Document)
+
+	server, _ := v2Doc.Servers.Get("solace")
+	serverBinding, err := ParseBindings[solace.ServerBinding](server.Bindings, "solace")
+	require.NoError(t, err)
+	assert.Equal(t, &solace.ServerBinding{MsgVPN: "ProdVPN", BindingVersion: "0.4.0"}, serverBinding)
+
+	channel, _ := v2Doc.Channels.Get("person/{personId}/created")
+	operationBinding, err := ParseBindings[solace.OperationBinding](channel.Subscribe.Bindings, "solace")
+	require.NoError(t, err)
+	assert.Equal(t, []solace.Destination{{
+		DestinationType: solace.DestinationTypeQueue,
+		DeliveryMode:    solace.DeliveryModePersistent,
+		Queue: &solace.Queue{
+			Name:               "CreatedHREvents",
+			TopicSubscriptions: []string{"person/*/created"},
+			AccessType:         solace.AccessTypeExclusive,
+		},
+	}}, operationBinding.Destinations)
+}
+
+func TestParseBindings_IBMMQ(t *testing.T) {
+	doc, err := ParseFile(filepath.Join("testdata", "valid_2_6_0_enterprise.yaml"))
+	require.NoError(t, err)
+	v2Doc := doc.(*asyncapi2.Document)
+
+	server, _ := v2Doc.Servers.Get("ibmmq")
+	serverBinding, err := ParseBindings[ibmmq.ServerBinding](server.Bindings, "ibmmq")
+	require.NoError(t, err)
+	assert.Equal(t, "PRODCLSTR1", serverBinding.GroupID)
+
+	channel, _ := v2Doc.Channels.Get("product/data")
+	channelBinding, err := ParseBindings[ibmmq.ChannelBinding](channel.Bindings, "ibmmq")
+	require.NoError(t, err)
+	assert.Equal(t, ibmmq.DestinationTypeQueue, channelBinding.DestinationType)
+	assert.Equal(t, &ibmmq.Queue{ObjectName: "PRODUCT.DATA", Exclusive: true}, channelBinding.Queue)
+
+	messageBinding, err := ParseBindings[ibmmq.MessageBinding](channel.Publish.Message.Bindings, "ibmmq")
+	require.NoError(t, err)
+	assert.Equal(t, &ibmmq.MessageBinding{Type: ibmmq.MessageTypeJMS, Expiry: 60000, BindingVersion: "0.1.0"}, messageBinding)
+}
+
 func TestParseBindings_NotFound(t *testing.T) {
 	rawBindings := map[string]interface{}{
 		"amqp": map[string]interface{}{
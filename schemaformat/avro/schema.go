@@ -0,0 +1,488 @@
+// Package avro parses and validates Apache Avro schemas declared as message
+// payloads with an application/vnd.apache.avro schemaFormat, and validates
+// JSON and binary encoded Avro data against them.
+package avro
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// Type is the type of an Avro schema
+type Type string
+
+const (
+	Null    Type = "null"
+	Boolean Type = "boolean"
+	Int     Type = "int"
+	Long    Type = "long"
+	Float   Type = "float"
+	Double  Type = "double"
+	Bytes   Type = "bytes"
+	String  Type = "string"
+	Record  Type = "record"
+	Error   Type = "error"
+	Enum    Type = "enum"
+	Array   Type = "array"
+	Map     Type = "map"
+	Union   Type = "union"
+	Fixed   Type = "fixed"
+)
+
+// Logical types defined by the Avro specification
+const (
+	LogicalDecimal              = "decimal"
+	LogicalUUID                 = "uuid"
+	LogicalDate                 = "date"
+	LogicalTimeMillis           = "time-millis"
+	LogicalTimeMicros           = "time-micros"
+	LogicalTimestampMillis      = "timestamp-millis"
+	LogicalTimestampMicros      = "timestamp-micros"
+	LogicalLocalTimestampMillis = "local-timestamp-millis"
+	LogicalLocalTimestampMicros = "local-timestamp-micros"
+	LogicalDuration             = "duration"
+)
+
+// Schema is a parsed Avro schema. Named types referenced more than once, and
+// recursive records, share the same *Schema.
+type Schema struct {
+	Type Type
+	// Name is the full name of a record, error, enum or fixed type, including its namespace
+	Name      string
+	Namespace string
+	Doc       string
+	Aliases   []string
+	// Fields of a record or error
+	Fields []*Field
+	// Symbols of an enum
+	Symbols []string
+	// Default symbol of an enum
+	Default string
+	// Items of an array
+	Items *Schema
+	// Values of a map
+	Values *Schema
+	// Branches of a union
+	Branches []*Schema
+	// Size of a fixed type in bytes
+	Size int
+	// LogicalType annotates the underlying type. Invalid logical types are
+	// ignored as required by the specification.
+	LogicalType string
+	// Precision and Scale of a decimal logical type
+	Precision int
+	Scale     int
+}
+
+// Field is a single field of a record
+type Field struct {
+	Name       string
+	Doc        string
+	Type       *Schema
+	Default    any
+	HasDefault bool
+	Order      string
+	Aliases    []string
+}
+
+// Named reports whether the schema is a named type
+func (s *Schema) Named() bool {
+	switch s.Type {
+	case Record, Error, Enum, Fixed:
+		return true
+	}
+	return false
+}
+
+// TypeName returns the name used to identify the schema in a union, which
+// is the full name for named types and the type otherwise
+func (s *Schema) TypeName() string {
+	if s.Named() {
+		return s.Name
+	}
+	return string(s.Type)
+}
+
+// Field returns the record field with the given name, or nil
+func (s *Schema) Field(name string) *Field {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+var (
+	namePattern    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	primitiveTypes = map[string]Type{
+		"null": Null, "boolean": Boolean, "int": Int, "long": Long,
+		"float": Float, "double": Double, "bytes": Bytes, "string": String,
+	}
+)
+
+// ParseJSON parses an Avro schema from its JSON representation
+func ParseJSON(data []byte) (*Schema, error) {
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse Avro schema JSON: %w", err)
+	}
+	return Parse(raw)
+}
+
+// Parse parses and validates an Avro schema from decoded JSON, such as a
+// message payload taken from an AsyncAPI document. It checks names, field
+// defaults, enum symbols and union branches, and resolves references to
+// named types.
+func Parse(raw any) (*Schema, error) {
+	p := &parser{named: make(map[string]*Schema)}
+	return p.parse(raw, "", "")
+}
+
+type parser struct {
+	named map[string]*Schema
+}
+
+func (p *parser) parse(raw any, namespace, path string) (*Schema, error) {
+	switch v := raw.(type) {
+	case string:
+		return p.reference(v, namespace, path)
+	case []any:
+		return p.union(v, namespace, path)
+	case map[string]any:
+		return p.object(v, namespace, path)
+	default:
+		return nil, schemaError(path, "schema must be a string, array or object, got %T", raw)
+	}
+}
+
+// reference resolves a primitive type name or a previously defined named type
+func (p *parser) reference(name, namespace, path string) (*Schema, error) {
+	if t, ok := primitiveTypes[name]; ok {
+		return &Schema{Type: t}, nil
+	}
+	if !strings.Contains(name, ".") && namespace != "" {
+		if s, ok := p.named[namespace+"."+name]; ok {
+			return s, nil
+		}
+	}
+	if s, ok := p.named[name]; ok {
+		return s, nil
+	}
+	return nil, schemaError(path, "unknown type %q", name)
+}
+
+func (p *parser) union(branches []any, namespace, path string) (*Schema, error) {
+	s := &Schema{Type: Union}
+	seen := make(map[string]bool)
+	for i, raw := range branches {
+		branchPath := fmt.Sprintf("%s/%d", path, i)
+		branch, err := p.parse(raw, namespace, branchPath)
+		if err != nil {
+			return nil, err
+		}
+		if branch.Type == Union {
+			return nil, schemaError(branchPath, "unions may not immediately contain other unions")
+		}
+		name := branch.TypeName()
+		if seen[name] {
+			return nil, schemaError(branchPath, "union contains %s more than once", name)
+		}
+		seen[name] = true
+		s.Branches = append(s.Branches, branch)
+	}
+	return s, nil
+}
+
+func (p *parser) object(obj map[string]any, namespace, path string) (*Schema, error) {
+	rawType, ok := obj["type"]
+	if !ok {
+		return nil, schemaError(path, "missing type")
+	}
+	typeName, ok := rawType.(string)
+	if !ok {
+		// {"type": {...}} and {"type": [...]} wrap another schema
+		return p.parse(rawType, namespace, path+"/type")
+	}
+
+	var (
+		s   *Schema
+		err error
+	)
+	switch Type(typeName) {
+	case Record, Error:
+		s, err = p.record(obj, Type(typeName), namespace, path)
+	case Enum:
+		s, err = p.enum(obj, namespace, path)
+	case Fixed:
+		s, err = p.fixed(obj, namespace, path)
+	case Array:
+		items, ok := obj["items"]
+		if !ok {
+			return nil, schemaError(path, "array is missing items")
+		}
+		s = &Schema{Type: Array}
+		s.Items, err = p.parse(items, namespace, path+"/items")
+	case Map:
+		values, ok := obj["values"]
+		if !ok {
+			return nil, schemaError(path, "map is missing values")
+		}
+		s = &Schema{Type: Map}
+		s.Values, err = p.parse(values, namespace, path+"/values")
+	default:
+		if t, ok := primitiveTypes[typeName]; ok {
+			s = &Schema{Type: t}
+		} else {
+			// Named types may also be referenced through the type attribute
+			s, err = p.reference(typeName, namespace, path)
+			return s, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.Doc, _ = obj["doc"].(string)
+	if logicalType, ok := obj["logicalType"].(string); ok {
+		applyLogicalType(s, logicalType, obj)
+	}
+	return s, nil
+}
+
+func (p *parser) record(obj map[string]any, t Type, namespace, path string) (*Schema, error) {
+	s, err := p.define(obj, t, namespace, path)
+	if err != nil {
+		return nil, err
+	}
+
+	rawFields, ok := obj["fields"].([]any)
+	if !ok {
+		return nil, schemaError(path, "record %s must have a fields array", s.Name)
+	}
+
+	seen := make(map[string]bool)
+	for i, rawField := range rawFields {
+		fieldPath := fmt.Sprintf("%s/fields/%d", path, i)
+		fieldObj, ok := rawField.(map[string]any)
+		if !ok {
+			return nil, schemaError(fieldPath, "field must be an object")
+		}
+
+		name, _ := fieldObj["name"].(string)
+		if !namePattern.MatchString(name) {
+			return nil, schemaError(fieldPath, "invalid field name %q", name)
+		}
+		if seen[name] {
+			return nil, schemaError(fieldPath, "duplicate field %q in record %s", name, s.Name)
+		}
+		seen[name] = true
+
+		rawType, ok := fieldObj["type"]
+		if !ok {
+			return nil, schemaError(fieldPath, "field %q is missing type", name)
+		}
+		fieldType, err := p.parse(rawType, s.Namespace, fieldPath+"/type")
+		if err != nil {
+			return nil, err
+		}
+
+		field := &Field{Name: name, Type: fieldType}
+		field.Doc, _ = fieldObj["doc"].(string)
+		field.Aliases = stringSlice(fieldObj["aliases"])
+		if order, ok := fieldObj["order"]; ok {
+			field.Order, _ = order.(string)
+			switch field.Order {
+			case "ascending", "descending", "ignore":
+			default:
+				return nil, schemaError(fieldPath, "invalid order %v for field %q", order, name)
+			}
+		}
+		if def, ok := fieldObj["default"]; ok {
+			if err := checkDefault(fieldType, def, fieldPath+"/default"); err != nil {
+				return nil, err
+			}
+			field.Default = def
+			field.HasDefault = true
+		}
+		s.Fields = append(s.Fields, field)
+	}
+	return s, nil
+}
+
+func (p *parser) enum(obj map[string]any, namespace, path string) (*Schema, error) {
+	s, err := p.define(obj, Enum, namespace, path)
+	if err != nil {
+		return nil, err
+	}
+
+	rawSymbols, ok := obj["symbols"].([]any)
+	if !ok {
+		return nil, schemaError(path, "enum %s must have a symbols array", s.Name)
+	}
+	seen := make(map[string]bool)
+	for _, raw := range rawSymbols {
+		symbol, _ := raw.(string)
+		if !namePattern.MatchString(symbol) {
+			return nil, schemaError(path, "invalid symbol %v in enum %s", raw, s.Name)
+		}
+		if seen[symbol] {
+			return nil, schemaError(path, "duplicate symbol %q in enum %s", symbol, s.Name)
+		}
+		seen[symbol] = true
+		s.Symbols = append(s.Symbols, symbol)
+	}
+
+	if def, ok := obj["default"]; ok {
+		symbol, _ := def.(string)
+		if !seen[symbol] {
+			return nil, schemaError(path, "default %v is not a symbol of enum %s", def, s.Name)
+		}
+		s.Default = symbol
+	}
+	return s, nil
+}
+
+func (p *parser) fixed(obj map[string]any, namespace, path string) (*Schema, error) {
+	s, err := p.define(obj, Fixed, namespace, path)
+	if err != nil {
+		return nil, err
+	}
+	size, ok := integer(obj["size"])
+	if !ok || size < 0 {
+		return nil, schemaError(path, "fixed %s must have a non-negative integer size", s.Name)
+	}
+	s.Size = int(size)
+	return s, nil
+}
+
+// define creates and registers a named type so that it can be referenced,
+// including recursively from its own fields
+func (p *parser) define(obj map[string]any, t Type, namespace, path string) (*Schema, error) {
+	name, _ := obj["name"].(string)
+	if name == "" {
+		return nil, schemaError(path, "%s is missing a name", t)
+	}
+	if ns, ok := obj["namespace"].(string); ok && !strings.Contains(name, ".") {
+		namespace = ns
+	}
+
+	fullName := name
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		namespace = name[:i]
+	} else if namespace != "" {
+		fullName = namespace + "." + name
+	}
+
+	for _, part := range strings.Split(fullName, ".") {
+		if !namePattern.MatchString(part) {
+			return nil, schemaError(path, "invalid name %q", fullName)
+		}
+	}
+	if _, ok := primitiveTypes[name]; ok {
+		return nil, schemaError(path, "%q is a primitive type and can't be used as a name", name)
+	}
+	if _, ok := p.named[fullName]; ok {
+		return nil, schemaError(path, "type %s is defined more than once", fullName)
+	}
+
+	s := &Schema{
+		Type:      t,
+		Name:      fullName,
+		Namespace: namespace,
+		Aliases:   stringSlice(obj["aliases"]),
+	}
+	p.named[fullName] = s
+	return s, nil
+}
+
+// applyLogicalType sets the logical type if it is valid for the underlying
+// type, and otherwise leaves the schema as its underlying type
+func applyLogicalType(s *Schema, logicalType string, obj map[string]any) {
+	valid := false
+	switch logicalType {
+	case LogicalDecimal:
+		precision, ok := integer(obj["precision"])
+		scale, hasScale := integer(obj["scale"])
+		if !hasScale {
+			scale = 0
+		}
+		valid = (s.Type == Bytes || s.Type == Fixed) && ok && precision > 0 && scale >= 0 && scale <= precision
+		if s.Type == Fixed && valid {
+			// The precision must fit in the fixed size
+			valid = precision <= maxDecimalPrecision(s.Size)
+		}
+		if valid {
+			s.Precision, s.Scale = int(precision), int(scale)
+		}
+	case LogicalUUID:
+		valid = s.Type == String
+	case LogicalDate, LogicalTimeMillis:
+		valid = s.Type == Int
+	case LogicalTimeMicros, LogicalTimestampMillis, LogicalTimestampMicros,
+		LogicalLocalTimestampMillis, LogicalLocalTimestampMicros:
+		valid = s.Type == Long
+	case LogicalDuration:
+		valid = s.Type == Fixed && s.Size == 12
+	}
+	if valid {
+		s.LogicalType = logicalType
+	}
+}
+
+// maxDecimalPrecision returns the number of base 10 digits that fit in a
+// two's complement number of size bytes
+func maxDecimalPrecision(size int) int64 {
+	if size <= 0 {
+		return 0
+	}
+	return int64(math.Floor(float64(8*size-1) * math.Log10(2)))
+}
+
+// checkDefault validates a field default against its type. Defaults for
+// unions must match the first branch.
+func checkDefault(s *Schema, def any, path string) error {
+	if s.Type == Union {
+		if len(s.Branches) == 0 {
+			return schemaError(path, "default given for an empty union")
+		}
+		s = s.Branches[0]
+	}
+	if errs := validateJSON(s, def, "", false); len(errs) > 0 {
+		return schemaError(path, "invalid default: %s", errs[0].Message)
+	}
+	return nil
+}
+
+// SchemaError reports an invalid Avro schema
+type SchemaError struct {
+	// Path is a JSON pointer to the problem within the schema, e.g. /fields/2/type
+	Path    string
+	Message string
+}
+
+// Error implements error.
+func (e *SchemaError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("invalid Avro schema: %s", e.Message)
+	}
+	return fmt.Sprintf("invalid Avro schema at %s: %s", e.Path, e.Message)
+}
+
+func schemaError(path, format string, args ...any) error {
+	return &SchemaError{Path: path, Message: fmt.Sprintf(format, args...)}
+}
+
+func stringSlice(raw any) []string {
+	items, _ := raw.([]any)
+	var out []string
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
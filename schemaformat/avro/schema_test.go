@@ -0,0 +1,125 @@
+package avro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const userSchema = `{
+	"type": "record",
+	"name": "User",
+	"namespace": "com.example",
+	"fields": [
+		{"name": "id", "type": {"type": "string", "logicalType": "uuid"}},
+		{"name": "age", "type": "int"},
+		{"name": "email", "type": ["null", "string"], "default": null},
+		{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["ACTIVE", "DISABLED"]}},
+		{"name": "createdAt", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+		{"name": "tags", "type": {"type": "array", "items": "string"}, "default": []},
+		{"name": "previous", "type": ["null", "Status"], "default": null},
+		{"name": "manager", "type": ["null", "User"], "default": null}
+	]
+}`
+
+func TestParse(t *testing.T) {
+	schema, err := ParseJSON([]byte(userSchema))
+	require.NoError(t, err)
+
+	assert.Equal(t, Record, schema.Type)
+	assert.Equal(t, "com.example.User", schema.Name)
+	require.Len(t, schema.Fields, 8)
+
+	assert.Equal(t, LogicalUUID, schema.Field("id").Type.LogicalType)
+	assert.Equal(t, LogicalTimestampMillis, schema.Field("createdAt").Type.LogicalType)
+
+	status := schema.Field("status").Type
+	assert.Equal(t, "com.example.Status", status.Name)
+	assert.Equal(t, []string{"ACTIVE", "DISABLED"}, status.Symbols)
+
+	// Named references resolve to the same schema, including recursive ones
+	assert.Same(t, status, schema.Field("previous").Type.Branches[1])
+	assert.Same(t, schema, schema.Field("manager").Type.Branches[1])
+}
+
+func TestParse_LogicalTypes(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   string
+	}{
+		{"decimal bytes", `{"type":"bytes","logicalType":"decimal","precision":10,"scale":2}`, LogicalDecimal},
+		{"decimal fixed", `{"type":"fixed","name":"Amount","size":8,"logicalType":"decimal","precision":18}`, LogicalDecimal},
+		{"date", `{"type":"int","logicalType":"date"}`, LogicalDate},
+		{"duration", `{"type":"fixed","name":"D","size":12,"logicalType":"duration"}`, LogicalDuration},
+		// Invalid logical types fall back to the underlying type
+		{"scale above precision", `{"type":"bytes","logicalType":"decimal","precision":2,"scale":3}`, ""},
+		{"precision too large for fixed", `{"type":"fixed","name":"Small","size":2,"logicalType":"decimal","precision":10}`, ""},
+		{"date on string", `{"type":"string","logicalType":"date"}`, ""},
+		{"unknown", `{"type":"string","logicalType":"colour"}`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := ParseJSON([]byte(tt.schema))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, schema.LogicalType)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		wantErr string
+	}{
+		{"unknown type", `"uuid"`, `unknown type "uuid"`},
+		{"missing type", `{"name":"x"}`, "missing type"},
+		{"record without name", `{"type":"record","fields":[]}`, "record is missing a name"},
+		{"invalid name", `{"type":"record","name":"my-record","fields":[]}`, `invalid name "my-record"`},
+		{"duplicate field", `{"type":"record","name":"R","fields":[{"name":"a","type":"int"},{"name":"a","type":"string"}]}`, `duplicate field "a"`},
+		{"duplicate type", `{"type":"record","name":"R","fields":[{"name":"a","type":{"type":"enum","name":"R","symbols":["A"]}}]}`, "type R is defined more than once"},
+		{"forward reference", `{"type":"record","name":"R","fields":[{"name":"a","type":"Later"}]}`, `unknown type "Later"`},
+		{"duplicate symbol", `{"type":"enum","name":"E","symbols":["A","A"]}`, `duplicate symbol "A"`},
+		{"invalid enum default", `{"type":"enum","name":"E","symbols":["A"],"default":"B"}`, "default B is not a symbol"},
+		{"duplicate union branch", `["null","string","null"]`, "union contains null more than once"},
+		{"nested union", `["null",["string"]]`, "unions may not immediately contain other unions"},
+		{"fixed without size", `{"type":"fixed","name":"F"}`, "must have a non-negative integer size"},
+		{"array without items", `{"type":"array"}`, "array is missing items"},
+		{"invalid default", `{"type":"record","name":"R","fields":[{"name":"a","type":"int","default":"one"}]}`, "invalid default: expected int, got string"},
+		{"union default not first branch", `{"type":"record","name":"R","fields":[{"name":"a","type":["null","string"],"default":"x"}]}`, "invalid default: expected null"},
+		{"invalid order", `{"type":"record","name":"R","fields":[{"name":"a","type":"int","order":"up"}]}`, "invalid order up"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseJSON([]byte(tt.schema))
+			require.Error(t, err)
+			var schemaErr *SchemaError
+			assert.ErrorAs(t, err, &schemaErr)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestParse_Namespaces(t *testing.T) {
+	schema, err := ParseJSON([]byte(`{
+		"type": "record",
+		"name": "Outer",
+		"namespace": "a",
+		"fields": [
+			{"name": "inner", "type": {"type": "fixed", "name": "Inner", "size": 4}},
+			{"name": "other", "type": {"type": "fixed", "name": "b.Other", "size": 4}},
+			{"name": "innerRef", "type": "Inner"},
+			{"name": "otherRef", "type": "b.Other"}
+		]
+	}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "a.Inner", schema.Field("inner").Type.Name)
+	assert.Equal(t, "b.Other", schema.Field("other").Type.Name)
+	assert.Same(t, schema.Field("inner").Type, schema.Field("innerRef").Type)
+	assert.Same(t, schema.Field("other").Type, schema.Field("otherRef").Type)
+}
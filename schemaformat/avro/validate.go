@@ -0,0 +1,519 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charlie-haley/asyncapi-go/spec"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// FieldError describes a single value that doesn't match the schema
+type FieldError struct {
+	// Path is a JSON pointer to the offending value
+	Path string `json:"path"`
+	// Message is a human readable description of the violation
+	Message string `json:"message"`
+}
+
+// String formats the error as "path: message"
+func (e FieldError) String() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidationError is returned when data does not match an Avro schema
+type ValidationError struct {
+	Errors []FieldError `json:"errors"`
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, fieldErr := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("- %s", fieldErr))
+	}
+	return fmt.Sprintf("avro validation failed:\n%s", strings.Join(msgs, "\n"))
+}
+
+// ValidateJSON validates a payload in the Avro JSON encoding, where union
+// values other than null are wrapped in an object keyed by the name of the
+// branch, e.g. {"string": "hello"}. Record fields with a default may be
+// omitted. It returns a *ValidationError listing every violation.
+func (s *Schema) ValidateJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return &ValidationError{Errors: []FieldError{{Message: fmt.Sprintf("invalid JSON: %s", err)}}}
+	}
+	if dec.More() {
+		return &ValidationError{Errors: []FieldError{{Message: "invalid JSON: unexpected data after value"}}}
+	}
+
+	if errs := validateJSON(s, value, "", true); len(errs) > 0 {
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// validateJSON checks a decoded JSON value against a schema. When encoded is
+// true, unions use the Avro JSON encoding; otherwise, as in field defaults,
+// union values are unwrapped.
+func validateJSON(s *Schema, value any, path string, encoded bool) []FieldError {
+	fail := func(format string, args ...any) []FieldError {
+		return []FieldError{{Path: path, Message: fmt.Sprintf(format, args...)}}
+	}
+
+	switch s.Type {
+	case Null:
+		if value != nil {
+			return fail("expected null, got %s", describe(value))
+		}
+	case Boolean:
+		if _, ok := value.(bool); !ok {
+			return fail("expected boolean, got %s", describe(value))
+		}
+	case Int, Long:
+		n, ok := integer(value)
+		if !ok {
+			return fail("expected %s, got %s", s.Type, describe(value))
+		}
+		if s.Type == Int && (n < math.MinInt32 || n > math.MaxInt32) {
+			return fail("%d is out of range for int", n)
+		}
+	case Float, Double:
+		if !isNumber(value) {
+			return fail("expected %s, got %s", s.Type, describe(value))
+		}
+	case String:
+		str, ok := value.(string)
+		if !ok {
+			return fail("expected string, got %s", describe(value))
+		}
+		if s.LogicalType == LogicalUUID && !uuidPattern.MatchString(str) {
+			return fail("%q is not a valid uuid", str)
+		}
+	case Bytes, Fixed:
+		str, ok := value.(string)
+		if !ok {
+			return fail("expected %s encoded as a string, got %s", s.Type, describe(value))
+		}
+		// Bytes are encoded as a string of code points 0-255
+		length := 0
+		for _, r := range str {
+			if r > 0xFF {
+				return fail("invalid %s: code point %U is greater than U+00FF", s.Type, r)
+			}
+			length++
+		}
+		if s.Type == Fixed && length != s.Size {
+			return fail("expected %d bytes for fixed %s, got %d", s.Size, s.Name, length)
+		}
+	case Enum:
+		symbol, ok := value.(string)
+		if !ok {
+			return fail("expected enum %s, got %s", s.Name, describe(value))
+		}
+		for _, candidate := range s.Symbols {
+			if candidate == symbol {
+				return nil
+			}
+		}
+		return fail("%q is not a symbol of enum %s", symbol, s.Name)
+	case Array:
+		items, ok := value.([]any)
+		if !ok {
+			return fail("expected array, got %s", describe(value))
+		}
+		var errs []FieldError
+		for i, item := range items {
+			errs = append(errs, validateJSON(s.Items, item, childPath(path, strconv.Itoa(i)), encoded)...)
+		}
+		return errs
+	case Map:
+		entries, ok := value.(map[string]any)
+		if !ok {
+			return fail("expected map, got %s", describe(value))
+		}
+		var errs []FieldError
+		for _, key := range sortedKeys(entries) {
+			errs = append(errs, validateJSON(s.Values, entries[key], childPath(path, key), encoded)...)
+		}
+		return errs
+	case Record, Error:
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return fail("expected record %s, got %s", s.Name, describe(value))
+		}
+		var errs []FieldError
+		for _, field := range s.Fields {
+			fieldValue, ok := obj[field.Name]
+			if !ok {
+				if !field.HasDefault {
+					errs = append(errs, FieldError{Path: childPath(path, field.Name), Message: "missing required field"})
+				}
+				continue
+			}
+			errs = append(errs, validateJSON(field.Type, fieldValue, childPath(path, field.Name), encoded)...)
+		}
+		for _, key := range sortedKeys(obj) {
+			if s.Field(key) == nil {
+				errs = append(errs, FieldError{Path: childPath(path, key), Message: fmt.Sprintf("unknown field in record %s", s.Name)})
+			}
+		}
+		return errs
+	case Union:
+		return validateUnion(s, value, path, encoded)
+	}
+	return nil
+}
+
+// validateUnion checks a union value. Encoded values other than null must be
+// an object with a single key naming the branch.
+func validateUnion(s *Schema, value any, path string, encoded bool) []FieldError {
+	if value == nil {
+		for _, branch := range s.Branches {
+			if branch.Type == Null {
+				return nil
+			}
+		}
+		return []FieldError{{Path: path, Message: "null is not allowed by the union"}}
+	}
+
+	if !encoded {
+		for _, branch := range s.Branches {
+			if len(validateJSON(branch, value, path, false)) == 0 {
+				return nil
+			}
+		}
+		return []FieldError{{Path: path, Message: fmt.Sprintf("%s does not match any branch of the union", describe(value))}}
+	}
+
+	wrapped, ok := value.(map[string]any)
+	if !ok || len(wrapped) != 1 {
+		return []FieldError{{Path: path, Message: fmt.Sprintf("expected a union value wrapped in an object with a single type name key, got %s", describe(value))}}
+	}
+	for name, inner := range wrapped {
+		for _, branch := range s.Branches {
+			if branch.TypeName() == name && branch.Type != Null {
+				return validateJSON(branch, inner, childPath(path, name), true)
+			}
+		}
+		return []FieldError{{Path: path, Message: fmt.Sprintf("%q is not a branch of the union", name)}}
+	}
+	return nil
+}
+
+// integer returns a JSON number as an int64 if it is integral
+func integer(value any) (int64, bool) {
+	switch n := value.(type) {
+	case json.Number:
+		if i, err := n.Int64(); err == nil {
+			return i, true
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return 0, false
+		}
+		return integer(f)
+	case float64:
+		if n != math.Trunc(n) || n < math.MinInt64 || n >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(n), true
+	case int:
+		return int64(n), true
+	case int64:
+		return n, true
+	}
+	return 0, false
+}
+
+func isNumber(value any) bool {
+	switch n := value.(type) {
+	case json.Number:
+		_, err := n.Float64()
+		return err == nil
+	case float64, float32, int, int64:
+		return true
+	}
+	return false
+}
+
+// describe names the JSON type of a value for error messages
+func describe(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number, float64, float32, int, int64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func childPath(path, segment string) string {
+	return path + spec.JSONPointer(segment)
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ValidateBinary validates a payload in the Avro binary encoding. The payload
+// must hold exactly one value. Payloads framed with the Confluent Schema
+// Registry wire format must be unwrapped with SplitConfluentHeader first.
+func (s *Schema) ValidateBinary(data []byte) error {
+	_, err := s.DecodeBinary(data)
+	return err
+}
+
+// DecodeBinary decodes a payload in the Avro binary encoding. Records and
+// maps decode to map[string]any, arrays to []any, enums to their symbol and
+// unions to the value of the selected branch. It returns a *ValidationError
+// if the data doesn't match the schema.
+func (s *Schema) DecodeBinary(data []byte) (any, error) {
+	d := &decoder{data: data}
+	value, err := d.decode(s, "")
+	if err != nil {
+		return nil, err
+	}
+	if d.pos != len(d.data) {
+		return nil, d.fail("", "%d unexpected bytes after value", len(d.data)-d.pos)
+	}
+	return value, nil
+}
+
+// SplitConfluentHeader removes the Confluent Schema Registry wire format
+// header, a zero magic byte followed by a 4 byte big endian schema ID, from a
+// Kafka message
+func SplitConfluentHeader(data []byte) (uint32, []byte, error) {
+	if len(data) < 5 || data[0] != 0 {
+		return 0, nil, fmt.Errorf("payload is not in the Confluent wire format")
+	}
+	id := uint32(data[1])<<24 | uint32(data[2])<<16 | uint32(data[3])<<8 | uint32(data[4])
+	return id, data[5:], nil
+}
+
+// maxZeroWidthItems bounds collections of items that occupy no bytes, such as
+// nulls, so that a corrupt block count can't cause an unbounded loop
+const maxZeroWidthItems = 1 << 16
+
+type decoder struct {
+	data []byte
+	pos  int
+}
+
+func (d *decoder) fail(path, format string, args ...any) error {
+	msg := fmt.Sprintf(format, args...)
+	return &ValidationError{Errors: []FieldError{{Path: path, Message: fmt.Sprintf("%s at byte %d", msg, d.pos)}}}
+}
+
+func (d *decoder) decode(s *Schema, path string) (any, error) {
+	switch s.Type {
+	case Null:
+		return nil, nil
+	case Boolean:
+		b, err := d.read(1, path)
+		if err != nil {
+			return nil, err
+		}
+		if b[0] > 1 {
+			return nil, d.fail(path, "invalid boolean byte %#x", b[0])
+		}
+		return b[0] == 1, nil
+	case Int:
+		n, err := d.varint(path, 5)
+		if err != nil {
+			return nil, err
+		}
+		if n < math.MinInt32 || n > math.MaxInt32 {
+			return nil, d.fail(path, "%d is out of range for int", n)
+		}
+		return int32(n), nil
+	case Long:
+		return d.varint(path, 10)
+	case Float:
+		b, err := d.read(4, path)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float32frombits(uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24), nil
+	case Double:
+		b, err := d.read(8, path)
+		if err != nil {
+			return nil, err
+		}
+		var bits uint64
+		for i := 7; i >= 0; i-- {
+			bits = bits<<8 | uint64(b[i])
+		}
+		return math.Float64frombits(bits), nil
+	case Bytes:
+		return d.bytes(path)
+	case String:
+		b, err := d.bytes(path)
+		if err != nil {
+			return nil, err
+		}
+		if !utf8.Valid(b) {
+			return nil, d.fail(path, "string is not valid UTF-8")
+		}
+		str := string(b)
+		if s.LogicalType == LogicalUUID && !uuidPattern.MatchString(str) {
+			return nil, d.fail(path, "%q is not a valid uuid", str)
+		}
+		return str, nil
+	case Fixed:
+		b, err := d.read(s.Size, path)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(nil), b...), nil
+	case Enum:
+		i, err := d.varint(path, 5)
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || i >= int64(len(s.Symbols)) {
+			return nil, d.fail(path, "enum index %d is out of range for %s", i, s.Name)
+		}
+		return s.Symbols[i], nil
+	case Array:
+		var items []any
+		err := d.blocks(path, func() error {
+			item, err := d.decode(s.Items, childPath(path, strconv.Itoa(len(items))))
+			items = append(items, item)
+			return err
+		})
+		return items, err
+	case Map:
+		entries := make(map[string]any)
+		err := d.blocks(path, func() error {
+			key, err := d.bytes(path)
+			if err != nil {
+				return err
+			}
+			value, err := d.decode(s.Values, childPath(path, string(key)))
+			entries[string(key)] = value
+			return err
+		})
+		return entries, err
+	case Record, Error:
+		record := make(map[string]any, len(s.Fields))
+		for _, field := range s.Fields {
+			value, err := d.decode(field.Type, childPath(path, field.Name))
+			if err != nil {
+				return nil, err
+			}
+			record[field.Name] = value
+		}
+		return record, nil
+	case Union:
+		i, err := d.varint(path, 10)
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || i >= int64(len(s.Branches)) {
+			return nil, d.fail(path, "union index %d is out of range", i)
+		}
+		return d.decode(s.Branches[i], path)
+	}
+	return nil, d.fail(path, "unsupported type %s", s.Type)
+}
+
+// blocks reads the blocks of an array or map, calling item once per item
+func (d *decoder) blocks(path string, item func() error) error {
+	for {
+		count, err := d.varint(path, 10)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			// A negative count is followed by the size of the block in bytes
+			count = -count
+			if _, err := d.varint(path, 10); err != nil {
+				return err
+			}
+		}
+		if count > int64(len(d.data)-d.pos) && count > maxZeroWidthItems {
+			return d.fail(path, "block count %d exceeds the remaining data", count)
+		}
+		for ; count > 0; count-- {
+			if err := item(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (d *decoder) read(n int, path string) ([]byte, error) {
+	if n < 0 || len(d.data)-d.pos < n {
+		return nil, d.fail(path, "unexpected end of data")
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *decoder) bytes(path string) ([]byte, error) {
+	n, err := d.varint(path, 10)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, d.fail(path, "negative length %d", n)
+	}
+	if n > int64(len(d.data)-d.pos) {
+		return nil, d.fail(path, "unexpected end of data")
+	}
+	return d.read(int(n), path)
+}
+
+// varint reads a zig-zag encoded variable length integer of at most maxBytes
+func (d *decoder) varint(path string, maxBytes int) (int64, error) {
+	var (
+		u     uint64
+		shift uint
+	)
+	for i := 0; i < maxBytes; i++ {
+		if d.pos >= len(d.data) {
+			return 0, d.fail(path, "unexpected end of data")
+		}
+		b := d.data[d.pos]
+		d.pos++
+		u |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return int64(u>>1) ^ -int64(u&1), nil
+		}
+		shift += 7
+	}
+	return 0, d.fail(path, "variable length integer is too long")
+}
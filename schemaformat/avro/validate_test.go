@@ -0,0 +1,152 @@
+package avro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateJSON(t *testing.T) {
+	schema, err := ParseJSON([]byte(userSchema))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		payload string
+		want    []FieldError
+	}{
+		{
+			name:    "valid",
+			payload: `{"id":"5f0c3f6e-3b5c-4c3a-9d1f-2f4e0b1a7c9d","age":42,"email":{"string":"a@example.com"},"status":"ACTIVE","createdAt":1700000000000,"tags":["x"]}`,
+		},
+		{
+			name:    "valid recursive union",
+			payload: `{"id":"5f0c3f6e-3b5c-4c3a-9d1f-2f4e0b1a7c9d","age":42,"status":"ACTIVE","createdAt":1,"manager":{"com.example.User":{"id":"5f0c3f6e-3b5c-4c3a-9d1f-2f4e0b1a7c9d","age":50,"status":"DISABLED","createdAt":1}}}`,
+		},
+		{
+			name:    "type mismatches",
+			payload: `{"id":"not-a-uuid","age":3000000000,"status":"GONE","createdAt":1.5}`,
+			want: []FieldError{
+				{Path: "/id", Message: `"not-a-uuid" is not a valid uuid`},
+				{Path: "/age", Message: "3000000000 is out of range for int"},
+				{Path: "/status", Message: `"GONE" is not a symbol of enum com.example.Status`},
+				{Path: "/createdAt", Message: "expected long, got number"},
+			},
+		},
+		{
+			name:    "unwrapped union",
+			payload: `{"id":"5f0c3f6e-3b5c-4c3a-9d1f-2f4e0b1a7c9d","age":1,"status":"ACTIVE","createdAt":1,"email":"a@example.com"}`,
+			want: []FieldError{
+				{Path: "/email", Message: "expected a union value wrapped in an object with a single type name key, got string"},
+			},
+		},
+		{
+			name:    "missing and unknown fields",
+			payload: `{"id":"5f0c3f6e-3b5c-4c3a-9d1f-2f4e0b1a7c9d","status":"ACTIVE","createdAt":1,"nickname":"bob"}`,
+			want: []FieldError{
+				{Path: "/age", Message: "missing required field"},
+				{Path: "/nickname", Message: "unknown field in record com.example.User"},
+			},
+		},
+		{
+			name:    "invalid JSON",
+			payload: `{"id":`,
+			want:    []FieldError{{Message: "invalid JSON: unexpected EOF"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.ValidateJSON([]byte(tt.payload))
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.want, validationErr.Errors)
+		})
+	}
+}
+
+func TestDecodeBinary(t *testing.T) {
+	schema, err := ParseJSON([]byte(`{
+		"type": "record",
+		"name": "Event",
+		"fields": [
+			{"name": "id", "type": "long"},
+			{"name": "name", "type": "string"},
+			{"name": "note", "type": ["null", "string"]},
+			{"name": "kind", "type": {"type": "enum", "name": "Kind", "symbols": ["A", "B"]}},
+			{"name": "scores", "type": {"type": "array", "items": "int"}},
+			{"name": "attrs", "type": {"type": "map", "values": "boolean"}},
+			{"name": "ratio", "type": "double"}
+		]
+	}`))
+	require.NoError(t, err)
+
+	payload := []byte{
+		0x54,                // id: 42
+		0x06, 'b', 'o', 'b', // name: "bob"
+		0x02, 0x04, 'h', 'i', // note: union branch 1, "hi"
+		0x02,                   // kind: B
+		0x03, 0x02, 0x04, 0x06, // scores: block of -2 items, 2 bytes
+		0x00,                        // end of scores
+		0x02, 0x02, 'k', 0x01, 0x00, // attrs: {"k": true}
+		0, 0, 0, 0, 0, 0, 0xf8, 0x3f, // ratio: 1.5
+	}
+
+	value, err := schema.DecodeBinary(payload)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"id":     int64(42),
+		"name":   "bob",
+		"note":   "hi",
+		"kind":   "B",
+		"scores": []any{int32(2), int32(3)},
+		"attrs":  map[string]any{"k": true},
+		"ratio":  1.5,
+	}, value)
+}
+
+func TestValidateBinary_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		payload []byte
+		wantErr string
+	}{
+		{"truncated string", `"string"`, []byte{0x06, 'b'}, "unexpected end of data at byte 1"},
+		{"invalid utf8", `"string"`, []byte{0x02, 0xff}, "string is not valid UTF-8"},
+		{"trailing bytes", `"int"`, []byte{0x02, 0x00}, "1 unexpected bytes after value"},
+		{"union index", `["null","int"]`, []byte{0x04}, "union index 2 is out of range"},
+		{"enum index", `{"type":"enum","name":"E","symbols":["A"]}`, []byte{0x02}, "enum index 1 is out of range for E"},
+		{"int overflow", `"int"`, []byte{0x80, 0x80, 0x80, 0x80, 0x10}, "is out of range for int"},
+		{"boolean", `"boolean"`, []byte{0x02}, "invalid boolean byte 0x2"},
+		{"huge block", `{"type":"array","items":"null"}`, []byte{0xfe, 0xff, 0xff, 0xff, 0x0f}, "block count 2147483647 exceeds the remaining data"},
+		{"record field path", `{"type":"record","name":"R","fields":[{"name":"a","type":"long"}]}`, []byte{0x80}, "/a: unexpected end of data"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := ParseJSON([]byte(tt.schema))
+			require.NoError(t, err)
+
+			err = schema.ValidateBinary(tt.payload)
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestSplitConfluentHeader(t *testing.T) {
+	id, payload, err := SplitConfluentHeader([]byte{0x00, 0x00, 0x00, 0x01, 0x02, 0x54})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(258), id)
+	assert.Equal(t, []byte{0x54}, payload)
+
+	_, _, err = SplitConfluentHeader([]byte{0x54})
+	assert.Error(t, err)
+}
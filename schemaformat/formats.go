@@ -0,0 +1,110 @@
+package schemaformat
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/charlie-haley/asyncapi-go/schemaformat/avro"
+	"github.com/charlie-haley/asyncapi-go/schemaformat/protobuf"
+)
+
+// AvroSchema is a parsed Avro schema
+type AvroSchema struct {
+	Schema *avro.Schema
+}
+
+// Validate implements Schema. Instances use the Avro JSON encoding for JSON
+// content types and the binary encoding otherwise.
+func (s *AvroSchema) Validate(data []byte, contentType string) error {
+	var err error
+	if IsJSONContentType(contentType) {
+		err = s.Schema.ValidateJSON(data)
+	} else {
+		err = s.Schema.ValidateBinary(data)
+	}
+
+	var avroErr *avro.ValidationError
+	if !errors.As(err, &avroErr) {
+		return err
+	}
+	fieldErrs := make([]FieldError, 0, len(avroErr.Errors))
+	for _, e := range avroErr.Errors {
+		fieldErrs = append(fieldErrs, FieldError{Path: e.Path, Message: e.Message})
+	}
+	return &ValidationError{Errors: fieldErrs}
+}
+
+type avroHandler struct{}
+
+// Parse implements Handler.
+func (avroHandler) Parse(req ParseRequest) (Schema, error) {
+	schema, err := avro.Parse(req.Schema)
+	if err != nil {
+		var schemaErr *avro.SchemaError
+		if errors.As(err, &schemaErr) {
+			return nil, &SchemaError{Path: schemaErr.Path, Message: "invalid Avro schema: " + schemaErr.Message}
+		}
+		return nil, &SchemaError{Message: err.Error()}
+	}
+	return &AvroSchema{Schema: schema}, nil
+}
+
+// ProtobufSchema is a parsed proto3 definition
+type ProtobufSchema struct {
+	File *protobuf.File
+	// MessageType is the message type instances are validated against: the
+	// one named by the message's name, or the only message type in the file
+	// when the message has no name. It is nil when the message has no name
+	// and the file has several message types.
+	MessageType *protobuf.Message
+}
+
+// Validate implements Schema. Instances use the proto3 JSON mapping for JSON
+// content types and the binary wire format otherwise.
+func (s *ProtobufSchema) Validate(data []byte, contentType string) error {
+	if s.MessageType == nil {
+		return fmt.Errorf("the proto definition has %d message types, set the message name to the one to validate against", len(s.File.Messages))
+	}
+
+	var err error
+	if IsJSONContentType(contentType) {
+		err = s.MessageType.ValidateJSON(data)
+	} else {
+		err = s.MessageType.ValidateBinary(data)
+	}
+
+	var protoErr *protobuf.ValidationError
+	if !errors.As(err, &protoErr) {
+		return err
+	}
+	fieldErrs := make([]FieldError, 0, len(protoErr.Errors))
+	for _, e := range protoErr.Errors {
+		fieldErrs = append(fieldErrs, FieldError{Path: e.Path, Message: e.Message})
+	}
+	return &ValidationError{Errors: fieldErrs}
+}
+
+type protobufHandler struct{}
+
+// Parse implements Handler.
+func (protobufHandler) Parse(req ParseRequest) (Schema, error) {
+	source, ok := req.Schema.(string)
+	if !ok {
+		return nil, &SchemaError{Message: "protobuf payloads must be .proto source, inline or referenced with $ref"}
+	}
+	file, err := protobuf.Parse(source)
+	if err != nil {
+		return nil, &SchemaError{Message: err.Error()}
+	}
+
+	schema := &ProtobufSchema{File: file}
+	switch {
+	case req.MessageName != "":
+		if schema.MessageType = file.FindMessage(req.MessageName); schema.MessageType == nil {
+			return nil, &SchemaError{Message: fmt.Sprintf("message type %q isn't defined by the proto definition", req.MessageName)}
+		}
+	case len(file.Messages) == 1:
+		schema.MessageType = file.Messages[0]
+	}
+	return schema, nil
+}
@@ -0,0 +1,169 @@
+package schemaformat
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charlie-haley/asyncapi-go/spec"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// JSONSchemaDocument is a parsed JSON Schema, AsyncAPI Schema Object or
+// OpenAPI 3.0 Schema Object
+type JSONSchemaDocument struct {
+	// Schema is the schema as JSON Schema. OpenAPI schemas are converted, e.g.
+	// nullable becomes a null type.
+	Schema   any
+	compiled *gojsonschema.Schema
+}
+
+// Validate implements Schema. Instances must be JSON encoded, whatever the
+// content type.
+func (s *JSONSchemaDocument) Validate(data []byte, contentType string) error {
+	return s.validate(gojsonschema.NewBytesLoader(data))
+}
+
+// ValidateValue checks an already decoded value, such as a map of headers
+func (s *JSONSchemaDocument) ValidateValue(value any) error {
+	return s.validate(gojsonschema.NewGoLoader(value))
+}
+
+func (s *JSONSchemaDocument) validate(document gojsonschema.JSONLoader) error {
+	result, err := s.compiled.Validate(document)
+	if err != nil {
+		// The schema is already compiled, so errors here come from decoding the document
+		return &ValidationError{Errors: []FieldError{{Message: fmt.Sprintf("invalid JSON: %s", err)}}}
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	fieldErrs := make([]FieldError, 0, len(result.Errors()))
+	for _, resultErr := range result.Errors() {
+		fieldErrs = append(fieldErrs, FieldError{
+			Path:    contextPointer(resultErr.Context()),
+			Message: resultErr.Description(),
+		})
+	}
+	return &ValidationError{Errors: fieldErrs}
+}
+
+// CompileJSONSchema compiles a JSON Schema with the given draft
+func CompileJSONSchema(schema any, draft gojsonschema.Draft) (*JSONSchemaDocument, error) {
+	loader := gojsonschema.NewSchemaLoader()
+	loader.Draft = draft
+	loader.AutoDetect = draft == gojsonschema.Hybrid
+	compiled, err := loader.Compile(gojsonschema.NewGoLoader(schema))
+	if err != nil {
+		return nil, &SchemaError{Message: fmt.Sprintf("invalid JSON Schema: %s", err)}
+	}
+	return &JSONSchemaDocument{Schema: schema, compiled: compiled}, nil
+}
+
+// jsonSchemaHandler handles AsyncAPI Schema Objects, which are a superset of
+// JSON Schema draft 07, JSON Schema and OpenAPI 3.0 Schema Objects
+type jsonSchemaHandler struct {
+	openAPI bool
+}
+
+// Parse implements Handler.
+func (h *jsonSchemaHandler) Parse(req ParseRequest) (Schema, error) {
+	schema := req.Schema
+	draft := gojsonschema.Draft7
+	switch {
+	case h.openAPI:
+		schema = openAPIToJSONSchema(schema)
+	case strings.HasPrefix(MediaType(req.SchemaFormat), "application/schema+"):
+		draft = jsonSchemaDraft(Parameter(req.SchemaFormat, "version"))
+	}
+	return CompileJSONSchema(schema, draft)
+}
+
+// jsonSchemaDraft maps the version parameter of a JSON Schema media type to
+// a draft, detecting the draft from $schema for versions gojsonschema doesn't
+// know
+func jsonSchemaDraft(version string) gojsonschema.Draft {
+	switch strings.TrimPrefix(strings.ToLower(version), "draft-") {
+	case "04", "4":
+		return gojsonschema.Draft4
+	case "06", "6":
+		return gojsonschema.Draft6
+	case "07", "7", "":
+		return gojsonschema.Draft7
+	}
+	return gojsonschema.Hybrid
+}
+
+// openAPIToJSONSchema converts an OpenAPI 3.0 Schema Object to JSON Schema
+// by replacing nullable with a null type and boolean exclusiveMinimum and
+// exclusiveMaximum with their numeric forms
+func openAPIToJSONSchema(schema any) any {
+	switch v := schema.(type) {
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = openAPIToJSONSchema(item)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, value := range v {
+			switch key {
+			case "properties", "patternProperties":
+				// Keys of these maps are property names, not keywords
+				if props, ok := value.(map[string]any); ok {
+					converted := make(map[string]any, len(props))
+					for name, prop := range props {
+						converted[name] = openAPIToJSONSchema(prop)
+					}
+					out[key] = converted
+					continue
+				}
+			case "example", "enum", "default":
+				out[key] = value
+				continue
+			}
+			out[key] = openAPIToJSONSchema(value)
+		}
+
+		if nullable, _ := out["nullable"].(bool); nullable {
+			if t, ok := out["type"].(string); ok {
+				out["type"] = []any{t, "null"}
+			}
+			if enum, ok := out["enum"].([]any); ok {
+				out["enum"] = append(append([]any{}, enum...), nil)
+			}
+		}
+		delete(out, "nullable")
+
+		for _, bound := range []string{"Minimum", "Maximum"} {
+			exclusive, ok := out["exclusive"+bound].(bool)
+			if !ok {
+				continue
+			}
+			limit := strings.ToLower(bound)
+			if value, ok := out[limit]; ok && exclusive {
+				out["exclusive"+bound] = value
+				delete(out, limit)
+			} else {
+				delete(out, "exclusive"+bound)
+			}
+		}
+		return out
+	default:
+		return schema
+	}
+}
+
+// contextPointer converts a gojsonschema context such as (root).user.id to a
+// JSON pointer such as /user/id
+func contextPointer(ctx *gojsonschema.JsonContext) string {
+	if ctx == nil {
+		return ""
+	}
+	segments := strings.Split(ctx.String("\x00"), "\x00")
+	if len(segments) > 0 && segments[0] == gojsonschema.STRING_CONTEXT_ROOT {
+		segments = segments[1:]
+	}
+	return spec.JSONPointer(segments...)
+}
@@ -0,0 +1,170 @@
+package protobuf
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenInt
+	tokenFloat
+	tokenString
+	tokenSymbol
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+	col  int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of file"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// lex splits proto source into tokens, dropping whitespace and comments
+func lex(source string) ([]token, error) {
+	var (
+		tokens []token
+		line   = 1
+		col    = 1
+		runes  = []rune(source)
+	)
+
+	advance := func(n int) {
+		for i := 0; i < n; i++ {
+			if runes[0] == '\n' {
+				line++
+				col = 1
+			} else {
+				col++
+			}
+			runes = runes[1:]
+		}
+	}
+
+	for len(runes) > 0 {
+		r := runes[0]
+		startLine, startCol := line, col
+
+		switch {
+		case unicode.IsSpace(r):
+			advance(1)
+		case r == '/' && len(runes) > 1 && runes[1] == '/':
+			for len(runes) > 0 && runes[0] != '\n' {
+				advance(1)
+			}
+		case r == '/' && len(runes) > 1 && runes[1] == '*':
+			end := strings.Index(string(runes[2:]), "*/")
+			if end < 0 {
+				return nil, &SyntaxError{Line: startLine, Column: startCol, Message: "unterminated comment"}
+			}
+			advance(len([]rune(string(runes[2:])[:end])) + 4)
+		case isIdentStart(r) || (r == '.' && len(runes) > 1 && isIdentStart(runes[1])):
+			// Identifiers include dotted and fully qualified names such as
+			// .google.protobuf.Timestamp
+			n := 1
+			for n < len(runes) && (isIdentStart(runes[n]) || runes[n] == '.' || unicode.IsDigit(runes[n])) {
+				n++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[:n]), line: startLine, col: startCol})
+			advance(n)
+		case unicode.IsDigit(r) || (r == '.' && len(runes) > 1 && unicode.IsDigit(runes[1])):
+			n, isFloat := readNumber(runes)
+			kind := tokenInt
+			if isFloat {
+				kind = tokenFloat
+			}
+			tokens = append(tokens, token{kind: kind, text: string(runes[:n]), line: startLine, col: startCol})
+			advance(n)
+		case r == '"' || r == '\'':
+			value, n, err := readString(runes)
+			if err != nil {
+				return nil, &SyntaxError{Line: startLine, Column: startCol, Message: err.Error()}
+			}
+			tokens = append(tokens, token{kind: tokenString, text: value, line: startLine, col: startCol})
+			advance(n)
+		case strings.ContainsRune("{}[]()<>=;,:-+", r):
+			tokens = append(tokens, token{kind: tokenSymbol, text: string(r), line: startLine, col: startCol})
+			advance(1)
+		default:
+			return nil, &SyntaxError{Line: startLine, Column: startCol, Message: fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, line: line, col: col}), nil
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || (r < unicode.MaxASCII && unicode.IsLetter(r))
+}
+
+// readNumber returns the length of the numeric literal at the start of runes
+// and whether it is a floating point literal
+func readNumber(runes []rune) (int, bool) {
+	hex := len(runes) > 1 && runes[0] == '0' && (runes[1] == 'x' || runes[1] == 'X')
+	isFloat := false
+	n := 0
+	for ; n < len(runes); n++ {
+		r := runes[n]
+		switch {
+		case unicode.IsDigit(r) || (hex && unicode.Is(unicode.ASCII_Hex_Digit, r)):
+		case r == 'x' || r == 'X':
+			if !hex || n != 1 {
+				return n, isFloat
+			}
+		case !hex && (r == '.' || r == 'e' || r == 'E'):
+			isFloat = true
+		case !hex && (r == '+' || r == '-') && (runes[n-1] == 'e' || runes[n-1] == 'E'):
+		case unicode.IsLetter(r):
+			// Let the parser reject literals such as 12abc
+		default:
+			return n, isFloat
+		}
+	}
+	return n, isFloat
+}
+
+// readString reads a quoted string literal, returning its unescaped value
+// and the number of runes consumed
+func readString(runes []rune) (string, int, error) {
+	quote := runes[0]
+	var sb strings.Builder
+	for i := 1; i < len(runes); i++ {
+		switch r := runes[i]; r {
+		case quote:
+			return sb.String(), i + 1, nil
+		case '\n':
+			return "", 0, fmt.Errorf("unterminated string")
+		case '\\':
+			i++
+			if i >= len(runes) {
+				return "", 0, fmt.Errorf("unterminated string")
+			}
+			switch runes[i] {
+			case 'n':
+				sb.WriteRune('\n')
+			case 't':
+				sb.WriteRune('\t')
+			case 'r':
+				sb.WriteRune('\r')
+			case '0':
+				sb.WriteRune(0)
+			default:
+				sb.WriteRune(runes[i])
+			}
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
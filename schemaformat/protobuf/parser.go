@@ -0,0 +1,896 @@
+package protobuf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxFieldNumber      = 536870911
+	reservedRangeStart  = 19000
+	reservedRangeEnd    = 19999
+	wellKnownTypePrefix = "google/protobuf/"
+)
+
+// Parser parses proto3 files. Imports of the well-known types under
+// google/protobuf/ are built in; other imports must be registered with
+// WithImport.
+type Parser struct {
+	imports map[string]string
+}
+
+// NewParser creates a Parser with no additional imports
+func NewParser() *Parser {
+	return &Parser{imports: make(map[string]string)}
+}
+
+// WithImport registers the source of a file that may be imported by path
+func (p *Parser) WithImport(path, source string) *Parser {
+	p.imports[path] = source
+	return p
+}
+
+// Parse parses proto3 source with no additional imports
+func Parse(source string) (*File, error) {
+	return NewParser().Parse(source)
+}
+
+// Parse parses and checks proto3 source. It rejects proto2 and editions
+// syntax, unresolvable types, duplicate or reserved field names and numbers,
+// invalid map keys and enums whose first value isn't zero.
+func (p *Parser) Parse(source string) (*File, error) {
+	return p.parseFile("", source, make(map[string]any), make(map[string]bool))
+}
+
+// parseFile parses a file and its imports, registering their types in types
+func (p *Parser) parseFile(path, source string, types map[string]any, loading map[string]bool) (*File, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		err.(*SyntaxError).File = path
+		return nil, err
+	}
+
+	fp := &fileParser{tokens: tokens, path: path, file: &File{types: types}}
+	if err := fp.parse(); err != nil {
+		return nil, err
+	}
+	file := fp.file
+
+	loading[path] = true
+	for _, imp := range file.Imports {
+		if loading[imp] {
+			continue
+		}
+		importSource, ok := p.imports[imp]
+		if !ok && strings.HasPrefix(imp, wellKnownTypePrefix) {
+			importSource, ok = wellKnownTypes[strings.TrimPrefix(imp, wellKnownTypePrefix)]
+		}
+		if !ok {
+			return nil, &SyntaxError{File: path, Line: 1, Column: 1, Message: fmt.Sprintf("import %q not found", imp)}
+		}
+		if _, err := p.parseFile(imp, importSource, types, loading); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := fp.register(); err != nil {
+		return nil, err
+	}
+	if err := fp.resolve(); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+type fileParser struct {
+	tokens []token
+	pos    int
+	path   string
+	file   *File
+}
+
+func (p *fileParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *fileParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *fileParser) errorf(t token, format string, args ...any) error {
+	return &SyntaxError{File: p.path, Line: t.line, Column: t.col, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *fileParser) expect(text string) error {
+	if t := p.next(); t.text != text || t.kind == tokenString {
+		return p.errorf(t, "expected %q, got %s", text, t)
+	}
+	return nil
+}
+
+func (p *fileParser) ident() (token, error) {
+	t := p.next()
+	if t.kind != tokenIdent {
+		return t, p.errorf(t, "expected identifier, got %s", t)
+	}
+	return t, nil
+}
+
+func (p *fileParser) str() (string, error) {
+	t := p.next()
+	if t.kind != tokenString {
+		return "", p.errorf(t, "expected string, got %s", t)
+	}
+	// Adjacent string literals are concatenated
+	value := t.text
+	for p.peek().kind == tokenString {
+		value += p.next().text
+	}
+	return value, nil
+}
+
+// integer reads a possibly negative integer literal
+func (p *fileParser) integer() (int64, token, error) {
+	t := p.next()
+	sign := int64(1)
+	if t.text == "-" && t.kind == tokenSymbol {
+		sign = -1
+		t = p.next()
+	}
+	if t.kind != tokenInt {
+		return 0, t, p.errorf(t, "expected integer, got %s", t)
+	}
+	n, err := strconv.ParseInt(t.text, 0, 64)
+	if err != nil {
+		return 0, t, p.errorf(t, "invalid integer %s", t.text)
+	}
+	return sign * n, t, nil
+}
+
+func (p *fileParser) parse() error {
+	first := true
+	for p.peek().kind != tokenEOF {
+		t, err := p.ident()
+		if err != nil {
+			if t.text == ";" {
+				continue
+			}
+			return err
+		}
+
+		switch t.text {
+		case "syntax":
+			if !first {
+				return p.errorf(t, "syntax must be the first statement")
+			}
+			if err := p.expect("="); err != nil {
+				return err
+			}
+			syntax, err := p.str()
+			if err != nil {
+				return err
+			}
+			if syntax != "proto3" {
+				return p.errorf(t, "only proto3 syntax is supported, got %q", syntax)
+			}
+			p.file.Syntax = syntax
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "edition":
+			return p.errorf(t, "editions are not supported, only proto3 syntax")
+		case "package":
+			name, err := p.ident()
+			if err != nil {
+				return err
+			}
+			if p.file.Package != "" {
+				return p.errorf(t, "multiple package statements")
+			}
+			p.file.Package = strings.TrimPrefix(name.text, ".")
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "import":
+			if next := p.peek(); next.kind == tokenIdent && (next.text == "public" || next.text == "weak") {
+				p.next()
+			}
+			path, err := p.str()
+			if err != nil {
+				return err
+			}
+			p.file.Imports = append(p.file.Imports, path)
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "option":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		case "message":
+			m, err := p.message(p.file.Package)
+			if err != nil {
+				return err
+			}
+			p.file.Messages = append(p.file.Messages, m)
+		case "enum":
+			e, err := p.enum(p.file.Package)
+			if err != nil {
+				return err
+			}
+			p.file.Enums = append(p.file.Enums, e)
+		case "service":
+			s, err := p.service()
+			if err != nil {
+				return err
+			}
+			p.file.Services = append(p.file.Services, s)
+		case "extend":
+			// Extensions of descriptor options for custom options
+			if _, err := p.ident(); err != nil {
+				return err
+			}
+			if err := p.skipBlock(); err != nil {
+				return err
+			}
+		default:
+			return p.errorf(t, "unexpected %s", t)
+		}
+
+		if first && p.file.Syntax == "" {
+			return p.errorf(t, `missing syntax = "proto3" statement`)
+		}
+		first = false
+	}
+
+	if p.file.Syntax == "" {
+		return p.errorf(p.peek(), `missing syntax = "proto3" statement`)
+	}
+	return nil
+}
+
+func (p *fileParser) message(scope string) (*Message, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	m := &Message{Name: name.text, FullName: qualify(scope, name.text), line: name.line, col: name.col}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	for {
+		t := p.peek()
+		if t.text == "}" && t.kind == tokenSymbol {
+			p.next()
+			return m, nil
+		}
+		if t.text == ";" && t.kind == tokenSymbol {
+			p.next()
+			continue
+		}
+		if t.kind != tokenIdent {
+			return nil, p.errorf(t, "unexpected %s in message %s", t, m.Name)
+		}
+
+		switch t.text {
+		case "message":
+			p.next()
+			nested, err := p.message(m.FullName)
+			if err != nil {
+				return nil, err
+			}
+			m.Messages = append(m.Messages, nested)
+		case "enum":
+			p.next()
+			e, err := p.enum(m.FullName)
+			if err != nil {
+				return nil, err
+			}
+			m.Enums = append(m.Enums, e)
+		case "oneof":
+			p.next()
+			if err := p.oneof(m); err != nil {
+				return nil, err
+			}
+		case "reserved":
+			p.next()
+			if err := p.reserved(&m.ReservedRanges, &m.ReservedNames); err != nil {
+				return nil, err
+			}
+		case "option":
+			p.next()
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+		case "extend":
+			p.next()
+			if _, err := p.ident(); err != nil {
+				return nil, err
+			}
+			if err := p.skipBlock(); err != nil {
+				return nil, err
+			}
+		case "extensions":
+			return nil, p.errorf(t, "extension ranges are not allowed in proto3")
+		case "required":
+			return nil, p.errorf(t, "required fields are not allowed in proto3")
+		case "group":
+			return nil, p.errorf(t, "groups are not allowed in proto3")
+		default:
+			f, err := p.field(true)
+			if err != nil {
+				return nil, err
+			}
+			m.Fields = append(m.Fields, f)
+		}
+	}
+}
+
+func (p *fileParser) oneof(m *Message) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	oneof := &Oneof{Name: name.text}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for {
+		t := p.peek()
+		switch {
+		case t.text == "}" && t.kind == tokenSymbol:
+			p.next()
+			m.Oneofs = append(m.Oneofs, oneof)
+			return nil
+		case t.text == ";" && t.kind == tokenSymbol:
+			p.next()
+		case t.text == "option" && t.kind == tokenIdent:
+			p.next()
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		default:
+			f, err := p.field(false)
+			if err != nil {
+				return err
+			}
+			if f.Repeated || f.Optional || f.Kind == KindMap {
+				return p.errorf(t, "fields in oneof %s can't be repeated, optional or maps", oneof.Name)
+			}
+			f.Oneof = oneof.Name
+			oneof.Fields = append(oneof.Fields, f)
+			m.Fields = append(m.Fields, f)
+		}
+	}
+}
+
+// field parses a field declaration, with a label if allowed
+func (p *fileParser) field(allowLabel bool) (*Field, error) {
+	f := &Field{}
+	t := p.next()
+	if allowLabel && t.kind == tokenIdent && (t.text == "repeated" || t.text == "optional") {
+		f.Repeated = t.text == "repeated"
+		f.Optional = t.text == "optional"
+		t = p.next()
+	}
+	if t.kind != tokenIdent {
+		return nil, p.errorf(t, "expected field type, got %s", t)
+	}
+	f.line, f.col = t.line, t.col
+
+	if t.text == "map" && p.peek().text == "<" {
+		if f.Repeated || f.Optional {
+			return nil, p.errorf(t, "map fields can't be repeated or optional")
+		}
+		p.next()
+		keyType, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		valueType, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(">"); err != nil {
+			return nil, err
+		}
+
+		keyKind, ok := scalarKinds[keyType.text]
+		if !ok || keyKind == KindDouble || keyKind == KindFloat || keyKind == KindBytes {
+			return nil, p.errorf(keyType, "invalid map key type %s, must be an integral or string type", keyType.text)
+		}
+		f.Kind = KindMap
+		f.Key = &Field{Name: "key", JSONName: "key", Number: 1, Kind: keyKind}
+		f.Value = &Field{Name: "value", JSONName: "value", Number: 2, line: valueType.line, col: valueType.col}
+		if kind, ok := scalarKinds[valueType.text]; ok {
+			f.Value.Kind = kind
+		} else {
+			f.Value.typeRef = valueType.text
+		}
+	} else if kind, ok := scalarKinds[t.text]; ok {
+		f.Kind = kind
+	} else {
+		f.typeRef = t.text
+	}
+
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	f.Name = name.text
+	f.JSONName = jsonName(name.text)
+
+	if err := p.expect("="); err != nil {
+		return nil, err
+	}
+	number, numberTok, err := p.integer()
+	if err != nil {
+		return nil, err
+	}
+	if number < 1 || number > maxFieldNumber {
+		return nil, p.errorf(numberTok, "field number %d of %s is out of range", number, f.Name)
+	}
+	if number >= reservedRangeStart && number <= reservedRangeEnd {
+		return nil, p.errorf(numberTok, "field number %d of %s is reserved for the protobuf implementation", number, f.Name)
+	}
+	f.Number = int(number)
+
+	if p.peek().text == "[" {
+		options, err := p.fieldOptions()
+		if err != nil {
+			return nil, err
+		}
+		if name, ok := options["json_name"]; ok {
+			f.JSONName = name
+		}
+	}
+	return f, p.expect(";")
+}
+
+// fieldOptions parses [name = value, ...], returning the values by name
+func (p *fileParser) fieldOptions() (map[string]string, error) {
+	p.next()
+	options := make(map[string]string)
+	for {
+		name := p.next()
+		if name.text == "(" {
+			// Custom option such as (validate.rules).string.min_len
+			for p.peek().text != ")" && p.peek().kind != tokenEOF {
+				p.next()
+			}
+			p.next()
+			if p.peek().kind == tokenIdent && strings.HasPrefix(p.peek().text, ".") {
+				p.next()
+			}
+		} else if name.kind != tokenIdent {
+			return nil, p.errorf(name, "expected option name, got %s", name)
+		}
+		if err := p.expect("="); err != nil {
+			return nil, err
+		}
+		value, err := p.optionValue()
+		if err != nil {
+			return nil, err
+		}
+		options[name.text] = value
+
+		switch t := p.next(); t.text {
+		case ",":
+		case "]":
+			return options, nil
+		default:
+			return nil, p.errorf(t, "expected , or ], got %s", t)
+		}
+	}
+}
+
+// optionValue reads a constant, which may be an aggregate in braces
+func (p *fileParser) optionValue() (string, error) {
+	t := p.peek()
+	switch {
+	case t.text == "{" && t.kind == tokenSymbol:
+		return "", p.skipBlock()
+	case t.text == "-" && t.kind == tokenSymbol:
+		p.next()
+		return "-" + p.next().text, nil
+	case t.kind == tokenString:
+		return p.str()
+	case t.kind == tokenEOF:
+		return "", p.errorf(t, "expected option value")
+	}
+	return p.next().text, nil
+}
+
+// reserved parses reserved field numbers or names
+func (p *fileParser) reserved(ranges *[]Range, names *[]string) error {
+	for {
+		if p.peek().kind == tokenString {
+			name, err := p.str()
+			if err != nil {
+				return err
+			}
+			*names = append(*names, name)
+		} else {
+			start, _, err := p.integer()
+			if err != nil {
+				return err
+			}
+			r := Range{Start: int(start), End: int(start)}
+			if p.peek().text == "to" {
+				p.next()
+				if p.peek().text == "max" {
+					p.next()
+					r.End = maxFieldNumber
+				} else {
+					end, endTok, err := p.integer()
+					if err != nil {
+						return err
+					}
+					if end < start {
+						return p.errorf(endTok, "reserved range end %d is before its start %d", end, start)
+					}
+					r.End = int(end)
+				}
+			}
+			*ranges = append(*ranges, r)
+		}
+
+		switch t := p.next(); t.text {
+		case ",":
+		case ";":
+			return nil
+		default:
+			return p.errorf(t, "expected , or ;, got %s", t)
+		}
+	}
+}
+
+func (p *fileParser) enum(scope string) (*Enum, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	e := &Enum{Name: name.text, FullName: qualify(scope, name.text), line: name.line, col: name.col}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	var reservedRanges []Range
+	var reservedNames []string
+	for {
+		t := p.next()
+		switch {
+		case t.text == "}" && t.kind == tokenSymbol:
+			return e, p.checkEnum(e, name, reservedRanges, reservedNames)
+		case t.text == ";" && t.kind == tokenSymbol:
+		case t.text == "option" && t.kind == tokenIdent:
+			optionName := p.peek()
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+			if optionName.text == "allow_alias" {
+				e.AllowAlias = true
+			}
+		case t.text == "reserved" && t.kind == tokenIdent:
+			if err := p.reserved(&reservedRanges, &reservedNames); err != nil {
+				return nil, err
+			}
+		case t.kind == tokenIdent:
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			number, numberTok, err := p.integer()
+			if err != nil {
+				return nil, err
+			}
+			if number < -1<<31 || number > 1<<31-1 {
+				return nil, p.errorf(numberTok, "enum value %s is out of range for int32", t.text)
+			}
+			if p.peek().text == "[" {
+				if _, err := p.fieldOptions(); err != nil {
+					return nil, err
+				}
+			}
+			if err := p.expect(";"); err != nil {
+				return nil, err
+			}
+			if len(e.Values) == 0 && number != 0 {
+				return nil, p.errorf(numberTok, "the first value of enum %s must be zero in proto3", e.Name)
+			}
+			e.Values = append(e.Values, &EnumValue{Name: t.text, Number: int32(number)})
+		default:
+			return nil, p.errorf(t, "unexpected %s in enum %s", t, e.Name)
+		}
+	}
+}
+
+func (p *fileParser) checkEnum(e *Enum, name token, reservedRanges []Range, reservedNames []string) error {
+	if len(e.Values) == 0 {
+		return p.errorf(name, "enum %s must have at least one value", e.Name)
+	}
+	names := make(map[string]bool)
+	numbers := make(map[int32]string)
+	for _, v := range e.Values {
+		if names[v.Name] {
+			return p.errorf(name, "duplicate value %s in enum %s", v.Name, e.Name)
+		}
+		names[v.Name] = true
+		if other, ok := numbers[v.Number]; ok && !e.AllowAlias {
+			return p.errorf(name, "values %s and %s of enum %s share the number %d, set option allow_alias = true to allow it", other, v.Name, e.Name, v.Number)
+		}
+		numbers[v.Number] = v.Name
+		if inRanges(int(v.Number), reservedRanges) || contains(reservedNames, v.Name) {
+			return p.errorf(name, "value %s of enum %s is reserved", v.Name, e.Name)
+		}
+	}
+	return nil
+}
+
+func (p *fileParser) service() (*Service, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{Name: name.text}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	for {
+		t := p.next()
+		switch {
+		case t.text == "}" && t.kind == tokenSymbol:
+			return s, nil
+		case t.text == ";" && t.kind == tokenSymbol:
+		case t.text == "option" && t.kind == tokenIdent:
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+		case t.text == "rpc" && t.kind == tokenIdent:
+			m, err := p.method()
+			if err != nil {
+				return nil, err
+			}
+			s.Methods = append(s.Methods, m)
+		default:
+			return nil, p.errorf(t, "unexpected %s in service %s", t, s.Name)
+		}
+	}
+}
+
+func (p *fileParser) method() (*Method, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	m := &Method{Name: name.text}
+
+	messageType := func() (string, bool, error) {
+		if err := p.expect("("); err != nil {
+			return "", false, err
+		}
+		t, err := p.ident()
+		if err != nil {
+			return "", false, err
+		}
+		streaming := false
+		if t.text == "stream" && p.peek().kind == tokenIdent {
+			streaming = true
+			if t, err = p.ident(); err != nil {
+				return "", false, err
+			}
+		}
+		return t.text, streaming, p.expect(")")
+	}
+
+	if m.InputType, m.ClientStreaming, err = messageType(); err != nil {
+		return nil, err
+	}
+	if err := p.expect("returns"); err != nil {
+		return nil, err
+	}
+	if m.OutputType, m.ServerStreaming, err = messageType(); err != nil {
+		return nil, err
+	}
+
+	if p.peek().text == "{" {
+		return m, p.skipBlock()
+	}
+	return m, p.expect(";")
+}
+
+// skipStatement skips to the end of the current statement
+func (p *fileParser) skipStatement() error {
+	for {
+		t := p.peek()
+		switch {
+		case t.kind == tokenEOF:
+			return p.errorf(t, "expected ;")
+		case t.text == "{" && t.kind == tokenSymbol:
+			if err := p.skipBlock(); err != nil {
+				return err
+			}
+		case t.text == ";" && t.kind == tokenSymbol:
+			p.next()
+			return nil
+		default:
+			p.next()
+		}
+	}
+}
+
+// skipBlock skips a block enclosed in braces, including nested blocks
+func (p *fileParser) skipBlock() error {
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	depth := 1
+	for depth > 0 {
+		t := p.next()
+		switch {
+		case t.kind == tokenEOF:
+			return p.errorf(t, "unterminated block")
+		case t.kind != tokenSymbol:
+		case t.text == "{":
+			depth++
+		case t.text == "}":
+			depth--
+		}
+	}
+	return nil
+}
+
+// register adds the file's types to the shared type table
+func (p *fileParser) register() error {
+	var registerMessages func(messages []*Message) error
+	add := func(fullName string, typ any, line, col int) error {
+		if _, ok := p.file.types[fullName]; ok {
+			return &SyntaxError{File: p.path, Line: line, Column: col, Message: fmt.Sprintf("%s is already defined", fullName)}
+		}
+		p.file.types[fullName] = typ
+		return nil
+	}
+	registerEnums := func(enums []*Enum) error {
+		for _, e := range enums {
+			if err := add(e.FullName, e, e.line, e.col); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	registerMessages = func(messages []*Message) error {
+		for _, m := range messages {
+			if err := add(m.FullName, m, m.line, m.col); err != nil {
+				return err
+			}
+			if err := registerEnums(m.Enums); err != nil {
+				return err
+			}
+			if err := registerMessages(m.Messages); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := registerEnums(p.file.Enums); err != nil {
+		return err
+	}
+	return registerMessages(p.file.Messages)
+}
+
+// resolve links field types to their definitions and checks each message
+func (p *fileParser) resolve() error {
+	for _, m := range p.file.AllMessages() {
+		if err := p.checkMessage(m); err != nil {
+			return err
+		}
+		for _, f := range m.Fields {
+			target := f
+			if f.Kind == KindMap {
+				target = f.Value
+			}
+			if target.typeRef == "" {
+				continue
+			}
+			if err := p.resolveType(m.FullName, target); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolveType finds a field's type by searching the enclosing scopes from
+// the innermost outwards, as protoc does
+func (p *fileParser) resolveType(scope string, f *Field) error {
+	name := f.typeRef
+	var candidates []string
+	if strings.HasPrefix(name, ".") {
+		candidates = []string{name[1:]}
+	} else {
+		for s := scope; ; {
+			candidates = append(candidates, qualify(s, name))
+			if s == "" {
+				break
+			}
+			if i := strings.LastIndex(s, "."); i >= 0 {
+				s = s[:i]
+			} else {
+				s = ""
+			}
+		}
+	}
+
+	for _, candidate := range candidates {
+		switch typ := p.file.types[candidate].(type) {
+		case *Message:
+			f.Kind, f.TypeName, f.Message = KindMessage, candidate, typ
+			return nil
+		case *Enum:
+			f.Kind, f.TypeName, f.Enum = KindEnum, candidate, typ
+			return nil
+		}
+	}
+	return &SyntaxError{File: p.path, Line: f.line, Column: f.col, Message: fmt.Sprintf("unknown type %s", name)}
+}
+
+// checkMessage checks field names and numbers are unique and not reserved
+func (p *fileParser) checkMessage(m *Message) error {
+	names := make(map[string]bool)
+	numbers := make(map[int]string)
+	for _, f := range m.Fields {
+		fail := func(format string, args ...any) error {
+			return &SyntaxError{File: p.path, Line: f.line, Column: f.col, Message: fmt.Sprintf(format, args...)}
+		}
+		if names[f.Name] {
+			return fail("duplicate field %s in message %s", f.Name, m.Name)
+		}
+		names[f.Name] = true
+		if other, ok := numbers[f.Number]; ok {
+			return fail("fields %s and %s of message %s share the number %d", other, f.Name, m.Name, f.Number)
+		}
+		numbers[f.Number] = f.Name
+		if inRanges(f.Number, m.ReservedRanges) {
+			return fail("field number %d of %s is reserved in message %s", f.Number, f.Name, m.Name)
+		}
+		if contains(m.ReservedNames, f.Name) {
+			return fail("field name %s is reserved in message %s", f.Name, m.Name)
+		}
+	}
+	return nil
+}
+
+func qualify(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+func inRanges(n int, ranges []Range) bool {
+	for _, r := range ranges {
+		if n >= r.Start && n <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const userProto = `
+syntax = "proto3";
+
+package example.users.v1;
+
+import "google/protobuf/timestamp.proto";
+
+option go_package = "example.com/users/v1;usersv1";
+
+// A user signed up
+message UserSignedUp {
+  string user_id = 1 [json_name = "id"];
+  string email = 2;
+  Plan plan = 3;
+  google.protobuf.Timestamp signed_up_at = 4;
+  repeated string tags = 5;
+  map<string, int64> counters = 6;
+  Address address = 7;
+  optional string referrer = 8;
+
+  oneof contact {
+    string phone = 9;
+    string slack = 10;
+  }
+
+  message Address {
+    string city = 1;
+    Country country = 2;
+  }
+
+  enum Country {
+    COUNTRY_UNSPECIFIED = 0;
+    COUNTRY_GB = 1;
+  }
+
+  reserved 11 to 15, 20;
+  reserved "legacy";
+}
+
+enum Plan {
+  PLAN_UNSPECIFIED = 0;
+  PLAN_FREE = 1;
+  PLAN_PRO = 2 [deprecated = true];
+}
+
+service Users {
+  rpc Watch(UserSignedUp) returns (stream UserSignedUp);
+}
+`
+
+func TestParse(t *testing.T) {
+	file, err := Parse(userProto)
+	require.NoError(t, err)
+
+	assert.Equal(t, "proto3", file.Syntax)
+	assert.Equal(t, "example.users.v1", file.Package)
+	assert.Equal(t, []string{"google/protobuf/timestamp.proto"}, file.Imports)
+	require.Len(t, file.Messages, 1)
+	require.Len(t, file.Enums, 1)
+
+	msg := file.FindMessage("UserSignedUp")
+	require.NotNil(t, msg)
+	assert.Same(t, msg, file.FindMessage("example.users.v1.UserSignedUp"))
+	assert.Equal(t, "example.users.v1.UserSignedUp", msg.FullName)
+	assert.Len(t, msg.Fields, 10)
+
+	userID := msg.Field("user_id")
+	assert.Equal(t, "id", userID.JSONName)
+	assert.Equal(t, KindString, userID.Kind)
+
+	assert.Equal(t, "signedUpAt", msg.Field("signed_up_at").JSONName)
+	assert.Equal(t, "google.protobuf.Timestamp", msg.Field("signed_up_at").TypeName)
+	assert.Equal(t, KindEnum, msg.Field("plan").Kind)
+	assert.Same(t, file.Enums[0], msg.Field("plan").Enum)
+	assert.True(t, msg.Field("tags").Repeated)
+	assert.True(t, msg.Field("referrer").Optional)
+	assert.Equal(t, "contact", msg.Field("phone").Oneof)
+
+	counters := msg.Field("counters")
+	assert.Equal(t, KindMap, counters.Kind)
+	assert.Equal(t, KindString, counters.Key.Kind)
+	assert.Equal(t, KindInt64, counters.Value.Kind)
+
+	// Nested types resolve from the enclosing scope
+	address := msg.Field("address")
+	assert.Equal(t, "example.users.v1.UserSignedUp.Address", address.TypeName)
+	assert.Equal(t, "example.users.v1.UserSignedUp.Country", address.Message.Field("country").TypeName)
+
+	assert.Equal(t, []Range{{Start: 11, End: 15}, {Start: 20, End: 20}}, msg.ReservedRanges)
+	assert.Equal(t, []string{"legacy"}, msg.ReservedNames)
+
+	require.Len(t, file.Services, 1)
+	assert.Equal(t, &Method{Name: "Watch", InputType: "UserSignedUp", OutputType: "UserSignedUp", ServerStreaming: true}, file.Services[0].Methods[0])
+}
+
+func TestParser_WithImport(t *testing.T) {
+	file, err := NewParser().
+		WithImport("common/money.proto", `syntax = "proto3"; package common; message Money { int64 units = 1; string currency = 2; }`).
+		Parse(`syntax = "proto3"; import "common/money.proto"; message Order { common.Money total = 1; }`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "common.Money", file.FindMessage("Order").Field("total").TypeName)
+	assert.Nil(t, file.FindMessage("Money"), "imported types are not part of the file")
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{"missing syntax", `message A {}`, `missing syntax = "proto3" statement`},
+		{"proto2", `syntax = "proto2"; message A {}`, `only proto3 syntax is supported, got "proto2"`},
+		{"required", `syntax = "proto3"; message A { required string a = 1; }`, "required fields are not allowed in proto3"},
+		{"unknown type", `syntax = "proto3"; message A { Missing a = 1; }`, "1:32: unknown type Missing"},
+		{"duplicate number", `syntax = "proto3"; message A { string a = 1; string b = 1; }`, "fields a and b of message A share the number 1"},
+		{"duplicate name", `syntax = "proto3"; message A { string a = 1; int32 a = 2; }`, "duplicate field a in message A"},
+		{"reserved number", `syntax = "proto3"; message A { reserved 2 to 4; string a = 3; }`, "field number 3 of a is reserved in message A"},
+		{"reserved name", `syntax = "proto3"; message A { reserved "a"; string a = 1; }`, "field name a is reserved in message A"},
+		{"implementation range", `syntax = "proto3"; message A { string a = 19000; }`, "reserved for the protobuf implementation"},
+		{"number out of range", `syntax = "proto3"; message A { string a = 0; }`, "field number 0 of a is out of range"},
+		{"float map key", `syntax = "proto3"; message A { map<double, string> a = 1; }`, "invalid map key type double"},
+		{"enum first value", `syntax = "proto3"; enum E { A = 1; }`, "the first value of enum E must be zero in proto3"},
+		{"enum alias", `syntax = "proto3"; enum E { A = 0; B = 0; }`, "values A and B of enum E share the number 0"},
+		{"duplicate type", `syntax = "proto3"; message A {} message A {}`, "A is already defined"},
+		{"missing import", `syntax = "proto3"; import "other.proto"; message A {}`, `import "other.proto" not found`},
+		{"unterminated", `syntax = "proto3"; message A { string a = 1;`, "unexpected end of file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.source)
+			require.Error(t, err)
+			var syntaxErr *SyntaxError
+			assert.ErrorAs(t, err, &syntaxErr)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestParse_EnumAlias(t *testing.T) {
+	file, err := Parse(`syntax = "proto3"; enum E { option allow_alias = true; A = 0; B = 0; }`)
+	require.NoError(t, err)
+	assert.True(t, file.Enums[0].AllowAlias)
+}
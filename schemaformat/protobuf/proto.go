@@ -0,0 +1,245 @@
+// Package protobuf parses proto3 definitions declared as message payloads with
+// an application/vnd.google.protobuf schemaFormat, without invoking protoc, and
+// validates binary and JSON encoded payloads against their message types.
+package protobuf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kind is the type of a field
+type Kind string
+
+const (
+	KindDouble   Kind = "double"
+	KindFloat    Kind = "float"
+	KindInt32    Kind = "int32"
+	KindInt64    Kind = "int64"
+	KindUint32   Kind = "uint32"
+	KindUint64   Kind = "uint64"
+	KindSint32   Kind = "sint32"
+	KindSint64   Kind = "sint64"
+	KindFixed32  Kind = "fixed32"
+	KindFixed64  Kind = "fixed64"
+	KindSfixed32 Kind = "sfixed32"
+	KindSfixed64 Kind = "sfixed64"
+	KindBool     Kind = "bool"
+	KindString   Kind = "string"
+	KindBytes    Kind = "bytes"
+	KindMessage  Kind = "message"
+	KindEnum     Kind = "enum"
+	KindMap      Kind = "map"
+)
+
+var scalarKinds = map[string]Kind{
+	"double": KindDouble, "float": KindFloat, "int32": KindInt32, "int64": KindInt64,
+	"uint32": KindUint32, "uint64": KindUint64, "sint32": KindSint32, "sint64": KindSint64,
+	"fixed32": KindFixed32, "fixed64": KindFixed64, "sfixed32": KindSfixed32, "sfixed64": KindSfixed64,
+	"bool": KindBool, "string": KindString, "bytes": KindBytes,
+}
+
+// File is a parsed .proto file
+type File struct {
+	Syntax   string
+	Package  string
+	Imports  []string
+	Messages []*Message
+	Enums    []*Enum
+	Services []*Service
+
+	// types holds every message and enum visible to the file by full name,
+	// including those from imports
+	types map[string]any
+}
+
+// Message is a message type
+type Message struct {
+	Name string
+	// FullName includes the package and any enclosing messages
+	FullName string
+	Fields   []*Field
+	Oneofs   []*Oneof
+	Messages []*Message
+	Enums    []*Enum
+	// ReservedRanges holds reserved field numbers, inclusive
+	ReservedRanges []Range
+	ReservedNames  []string
+
+	line, col int
+}
+
+// Field is a field of a message
+type Field struct {
+	Name string
+	// JSONName is the name used in the JSON encoding
+	JSONName string
+	Number   int
+	Kind     Kind
+	// TypeName is the full name of the message or enum type of the field
+	TypeName string
+	Message  *Message
+	Enum     *Enum
+	Repeated bool
+	// Optional is set for fields with an explicit optional label
+	Optional bool
+	// Oneof is the name of the oneof containing the field, if any
+	Oneof string
+	// Key and Value describe the entries of a map field
+	Key   *Field
+	Value *Field
+
+	// typeRef is the type as written in the source, before resolution
+	typeRef   string
+	line, col int
+}
+
+// Oneof is a set of fields of which at most one may be set
+type Oneof struct {
+	Name   string
+	Fields []*Field
+}
+
+// Enum is an enum type
+type Enum struct {
+	Name       string
+	FullName   string
+	Values     []*EnumValue
+	AllowAlias bool
+
+	line, col int
+}
+
+// EnumValue is a single value of an enum
+type EnumValue struct {
+	Name   string
+	Number int32
+}
+
+// Service is a service definition
+type Service struct {
+	Name    string
+	Methods []*Method
+}
+
+// Method is an RPC method of a service
+type Method struct {
+	Name            string
+	InputType       string
+	OutputType      string
+	ClientStreaming bool
+	ServerStreaming bool
+}
+
+// Range is an inclusive range of field numbers
+type Range struct {
+	Start int
+	End   int
+}
+
+// FindMessage returns the message type with the given full name, or the
+// single message type defined in the file with the given simple name, or nil
+func (f *File) FindMessage(name string) *Message {
+	name = strings.TrimPrefix(name, ".")
+	if m, ok := f.types[name].(*Message); ok {
+		return m
+	}
+	if f.Package != "" {
+		if m, ok := f.types[f.Package+"."+name].(*Message); ok {
+			return m
+		}
+	}
+
+	var found *Message
+	for _, m := range f.AllMessages() {
+		if m.Name == name {
+			if found != nil {
+				return nil
+			}
+			found = m
+		}
+	}
+	return found
+}
+
+// AllMessages returns every message type defined in the file, including
+// nested ones, in declaration order
+func (f *File) AllMessages() []*Message {
+	var out []*Message
+	var walk func(messages []*Message)
+	walk = func(messages []*Message) {
+		for _, m := range messages {
+			out = append(out, m)
+			walk(m.Messages)
+		}
+	}
+	walk(f.Messages)
+	return out
+}
+
+// Field returns the field with the given name, or nil
+func (m *Message) Field(name string) *Field {
+	for _, f := range m.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// FieldByNumber returns the field with the given number, or nil
+func (m *Message) FieldByNumber(number int) *Field {
+	for _, f := range m.Fields {
+		if f.Number == number {
+			return f
+		}
+	}
+	return nil
+}
+
+// Value returns the enum value with the given name, or nil
+func (e *Enum) Value(name string) *EnumValue {
+	for _, v := range e.Values {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// SyntaxError reports an invalid proto definition
+type SyntaxError struct {
+	// File is the import path of the file, empty for the main file
+	File    string
+	Line    int
+	Column  int
+	Message string
+}
+
+// Error implements error.
+func (e *SyntaxError) Error() string {
+	file := e.File
+	if file != "" {
+		file += ":"
+	}
+	return fmt.Sprintf("invalid proto definition at %s%d:%d: %s", file, e.Line, e.Column, e.Message)
+}
+
+// jsonName converts a field name to lowerCamelCase as protoc does
+func jsonName(name string) string {
+	var sb strings.Builder
+	upper := false
+	for _, r := range name {
+		switch {
+		case r == '_':
+			upper = true
+		case upper && r >= 'a' && r <= 'z':
+			sb.WriteRune(r - 'a' + 'A')
+			upper = false
+		default:
+			sb.WriteRune(r)
+			upper = false
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,566 @@
+package protobuf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/charlie-haley/asyncapi-go/spec"
+)
+
+// FieldError describes a single value that doesn't match the message type
+type FieldError struct {
+	// Path is a JSON pointer to the offending value, using proto field names
+	Path string `json:"path"`
+	// Message is a human readable description of the violation
+	Message string `json:"message"`
+}
+
+// String formats the error as "path: message"
+func (e FieldError) String() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidationError is returned when data does not match a message type
+type ValidationError struct {
+	Errors []FieldError `json:"errors"`
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, fieldErr := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("- %s", fieldErr))
+	}
+	return fmt.Sprintf("protobuf validation failed:\n%s", strings.Join(msgs, "\n"))
+}
+
+// Wire types of the binary encoding
+const (
+	wireVarint     = 0
+	wireFixed64    = 1
+	wireBytes      = 2
+	wireStartGroup = 3
+	wireEndGroup   = 4
+	wireFixed32    = 5
+)
+
+// ValidateBinary validates a payload in the protobuf binary wire format.
+// Unknown fields are skipped, as proto3 parsers preserve them, but known
+// fields must use the wire type of their declared type, strings must be
+// valid UTF-8 and nested messages must be well formed.
+func (m *Message) ValidateBinary(data []byte) error {
+	if err := validateWire(m, data, ""); err != nil {
+		return &ValidationError{Errors: []FieldError{*err}}
+	}
+	return nil
+}
+
+func validateWire(m *Message, data []byte, path string) *FieldError {
+	fail := func(path, format string, args ...any) *FieldError {
+		return &FieldError{Path: path, Message: fmt.Sprintf(format, args...)}
+	}
+
+	for pos := 0; pos < len(data); {
+		tag, n := readVarint(data[pos:])
+		if n <= 0 {
+			return fail(path, "invalid field tag at byte %d", pos)
+		}
+		pos += n
+
+		number, wireType := tag>>3, int(tag&7)
+		if number == 0 || number > maxFieldNumber {
+			return fail(path, "invalid field number %d at byte %d", number, pos-n)
+		}
+
+		field := m.FieldByNumber(int(number))
+		fieldPath := path
+		if field != nil {
+			fieldPath = path + spec.JSONPointer(field.Name)
+		}
+
+		value, n, err := readValue(data[pos:], wireType)
+		if err != "" {
+			return fail(fieldPath, "%s for field %d", err, number)
+		}
+		pos += n
+
+		if field == nil {
+			continue
+		}
+		if fieldErr := validateWireField(field, wireType, value, fieldPath); fieldErr != nil {
+			return fieldErr
+		}
+	}
+	return nil
+}
+
+// validateWireField checks a single encoded value of a known field
+func validateWireField(f *Field, wireType int, value []byte, path string) *FieldError {
+	fail := func(format string, args ...any) *FieldError {
+		return &FieldError{Path: path, Message: fmt.Sprintf(format, args...)}
+	}
+
+	if f.Kind == KindMap {
+		if wireType != wireBytes {
+			return fail("expected a length delimited map entry, got wire type %d", wireType)
+		}
+		entry := &Message{Name: f.Name + "Entry", Fields: []*Field{f.Key, f.Value}}
+		return validateWire(entry, value, path)
+	}
+
+	expected := kindWireType(f.Kind)
+	if f.Repeated && wireType == wireBytes && expected != wireBytes {
+		// Packed repeated scalars
+		for pos := 0; pos < len(value); {
+			element, n, err := readValue(value[pos:], expected)
+			if err != "" {
+				return fail("invalid packed %s: %s", f.Kind, err)
+			}
+			if fieldErr := validateScalarWire(f, element, path); fieldErr != nil {
+				return fieldErr
+			}
+			pos += n
+		}
+		return nil
+	}
+
+	if wireType != expected {
+		return fail("expected wire type %d for %s, got %d", expected, f.Kind, wireType)
+	}
+	switch f.Kind {
+	case KindMessage:
+		return validateWire(f.Message, value, path)
+	case KindString:
+		if !utf8.Valid(value) {
+			return fail("string is not valid UTF-8")
+		}
+	default:
+		return validateScalarWire(f, value, path)
+	}
+	return nil
+}
+
+// validateScalarWire checks the range of a varint encoded scalar
+func validateScalarWire(f *Field, value []byte, path string) *FieldError {
+	if kindWireType(f.Kind) != wireVarint {
+		return nil
+	}
+	v, _ := readVarint(value)
+	switch f.Kind {
+	case KindInt32, KindEnum:
+		if n := int64(v); n < math.MinInt32 || n > math.MaxInt32 {
+			return &FieldError{Path: path, Message: fmt.Sprintf("%d is out of range for %s", n, f.Kind)}
+		}
+	case KindUint32:
+		if v > math.MaxUint32 {
+			return &FieldError{Path: path, Message: fmt.Sprintf("%d is out of range for uint32", v)}
+		}
+	case KindSint32:
+		if n := int64(v>>1) ^ -int64(v&1); n < math.MinInt32 || n > math.MaxInt32 {
+			return &FieldError{Path: path, Message: fmt.Sprintf("%d is out of range for sint32", n)}
+		}
+	}
+	return nil
+}
+
+func kindWireType(kind Kind) int {
+	switch kind {
+	case KindDouble, KindFixed64, KindSfixed64:
+		return wireFixed64
+	case KindFloat, KindFixed32, KindSfixed32:
+		return wireFixed32
+	case KindString, KindBytes, KindMessage, KindMap:
+		return wireBytes
+	}
+	return wireVarint
+}
+
+// readValue reads a value of the given wire type, returning its encoded
+// bytes, the number of bytes consumed and a description of any problem
+func readValue(data []byte, wireType int) ([]byte, int, string) {
+	switch wireType {
+	case wireVarint:
+		_, n := readVarint(data)
+		if n <= 0 {
+			return nil, 0, "invalid varint"
+		}
+		return data[:n], n, ""
+	case wireFixed64:
+		if len(data) < 8 {
+			return nil, 0, "unexpected end of data"
+		}
+		return data[:8], 8, ""
+	case wireFixed32:
+		if len(data) < 4 {
+			return nil, 0, "unexpected end of data"
+		}
+		return data[:4], 4, ""
+	case wireBytes:
+		length, n := readVarint(data)
+		if n <= 0 {
+			return nil, 0, "invalid length"
+		}
+		if length > uint64(len(data)-n) {
+			return nil, 0, "unexpected end of data"
+		}
+		end := n + int(length)
+		return data[n:end], end, ""
+	case wireStartGroup, wireEndGroup:
+		return nil, 0, "groups are not supported in proto3"
+	}
+	return nil, 0, fmt.Sprintf("invalid wire type %d", wireType)
+}
+
+// readVarint decodes a base 128 varint, returning the value and the number
+// of bytes read, or 0 if the data is truncated or the varint is too long
+func readVarint(data []byte) (uint64, int) {
+	var v uint64
+	for i := 0; i < len(data) && i < 10; i++ {
+		b := data[i]
+		if i == 9 && b > 1 {
+			return 0, 0
+		}
+		v |= uint64(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return v, i + 1
+		}
+	}
+	return 0, 0
+}
+
+var durationPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]{1,9})?s$`)
+
+// ValidateJSON validates a payload in the proto3 JSON mapping. Fields may be
+// named by their JSON name or proto name, 64 bit integers may be numbers or
+// strings, enums may be names or numbers, bytes are base64 and the
+// well-known types use their special representations, such as RFC 3339
+// strings for google.protobuf.Timestamp. It returns a *ValidationError
+// listing every violation.
+func (m *Message) ValidateJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return &ValidationError{Errors: []FieldError{{Message: fmt.Sprintf("invalid JSON: %s", err)}}}
+	}
+	if dec.More() {
+		return &ValidationError{Errors: []FieldError{{Message: "invalid JSON: unexpected data after value"}}}
+	}
+
+	if errs := validateJSONMessage(m, value, ""); len(errs) > 0 {
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func validateJSONMessage(m *Message, value any, path string) []FieldError {
+	fail := func(format string, args ...any) []FieldError {
+		return []FieldError{{Path: path, Message: fmt.Sprintf(format, args...)}}
+	}
+
+	switch m.FullName {
+	case "google.protobuf.Timestamp":
+		s, ok := value.(string)
+		if _, err := time.Parse(time.RFC3339Nano, s); !ok || err != nil {
+			return fail("expected an RFC 3339 timestamp, got %s", describe(value))
+		}
+		return nil
+	case "google.protobuf.Duration":
+		if s, ok := value.(string); !ok || !durationPattern.MatchString(s) {
+			return fail("expected a duration such as \"1.5s\", got %s", describe(value))
+		}
+		return nil
+	case "google.protobuf.FieldMask":
+		if _, ok := value.(string); !ok {
+			return fail("expected a field mask string, got %s", describe(value))
+		}
+		return nil
+	case "google.protobuf.Value":
+		return nil
+	case "google.protobuf.ListValue":
+		if _, ok := value.([]any); !ok {
+			return fail("expected array, got %s", describe(value))
+		}
+		return nil
+	case "google.protobuf.Struct":
+		if _, ok := value.(map[string]any); !ok {
+			return fail("expected object, got %s", describe(value))
+		}
+		return nil
+	case "google.protobuf.Any":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return fail("expected object, got %s", describe(value))
+		}
+		if _, ok := obj["@type"].(string); !ok {
+			return fail("google.protobuf.Any must have an @type")
+		}
+		return nil
+	}
+	if isWrapper(m.FullName) {
+		return validateJSONValue(m.Fields[0], value, path)
+	}
+
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return fail("expected object for message %s, got %s", m.FullName, describe(value))
+	}
+
+	var errs []FieldError
+	seen := make(map[string]string)
+	oneofs := make(map[string]string)
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fieldPath := path + spec.JSONPointer(key)
+		f := jsonField(m, key)
+		if f == nil {
+			errs = append(errs, FieldError{Path: fieldPath, Message: fmt.Sprintf("unknown field in message %s", m.FullName)})
+			continue
+		}
+		if other, ok := seen[f.Name]; ok {
+			errs = append(errs, FieldError{Path: fieldPath, Message: fmt.Sprintf("field %s is also set as %s", f.Name, other)})
+			continue
+		}
+		seen[f.Name] = key
+
+		fieldValue := obj[key]
+		if fieldValue == nil {
+			continue
+		}
+		if f.Oneof != "" {
+			if other, ok := oneofs[f.Oneof]; ok {
+				errs = append(errs, FieldError{Path: fieldPath, Message: fmt.Sprintf("oneof %s already has %s set", f.Oneof, other)})
+				continue
+			}
+			oneofs[f.Oneof] = key
+		}
+		errs = append(errs, validateJSONField(f, fieldValue, fieldPath)...)
+	}
+	return errs
+}
+
+// jsonField finds a field by its JSON name or proto name
+func jsonField(m *Message, key string) *Field {
+	for _, f := range m.Fields {
+		if f.JSONName == key || f.Name == key {
+			return f
+		}
+	}
+	return nil
+}
+
+func validateJSONField(f *Field, value any, path string) []FieldError {
+	switch {
+	case f.Kind == KindMap:
+		entries, ok := value.(map[string]any)
+		if !ok {
+			return []FieldError{{Path: path, Message: fmt.Sprintf("expected object for map, got %s", describe(value))}}
+		}
+		var errs []FieldError
+		keys := make([]string, 0, len(entries))
+		for key := range entries {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			entryPath := path + spec.JSONPointer(key)
+			if msg := checkMapKey(f.Key.Kind, key); msg != "" {
+				errs = append(errs, FieldError{Path: entryPath, Message: msg})
+				continue
+			}
+			errs = append(errs, validateJSONValue(f.Value, entries[key], entryPath)...)
+		}
+		return errs
+	case f.Repeated:
+		items, ok := value.([]any)
+		if !ok {
+			return []FieldError{{Path: path, Message: fmt.Sprintf("expected array, got %s", describe(value))}}
+		}
+		var errs []FieldError
+		for i, item := range items {
+			errs = append(errs, validateJSONValue(f, item, path+spec.JSONPointer(strconv.Itoa(i)))...)
+		}
+		return errs
+	}
+	return validateJSONValue(f, value, path)
+}
+
+// validateJSONValue checks a single value of a field's type
+func validateJSONValue(f *Field, value any, path string) []FieldError {
+	fail := func(format string, args ...any) []FieldError {
+		return []FieldError{{Path: path, Message: fmt.Sprintf(format, args...)}}
+	}
+
+	switch f.Kind {
+	case KindMessage:
+		if value == nil && f.TypeName == "google.protobuf.Value" {
+			return nil
+		}
+		return validateJSONMessage(f.Message, value, path)
+	case KindEnum:
+		if value == nil && f.TypeName == "google.protobuf.NullValue" {
+			return nil
+		}
+		switch v := value.(type) {
+		case string:
+			if f.Enum.Value(v) == nil {
+				return fail("%q is not a value of enum %s", v, f.Enum.FullName)
+			}
+		case json.Number:
+			// Enums are open in proto3, so any int32 is allowed
+			if _, err := strconv.ParseInt(v.String(), 10, 32); err != nil {
+				return fail("%s is not a valid enum number", v)
+			}
+		default:
+			return fail("expected enum %s, got %s", f.Enum.FullName, describe(value))
+		}
+	case KindBool:
+		if _, ok := value.(bool); !ok {
+			return fail("expected bool, got %s", describe(value))
+		}
+	case KindString:
+		if _, ok := value.(string); !ok {
+			return fail("expected string, got %s", describe(value))
+		}
+	case KindBytes:
+		s, ok := value.(string)
+		if !ok || !isBase64(s) {
+			return fail("expected base64 encoded bytes, got %s", describe(value))
+		}
+	case KindFloat, KindDouble:
+		if !isJSONFloat(value) {
+			return fail("expected %s, got %s", f.Kind, describe(value))
+		}
+	default:
+		if msg := checkJSONInteger(f.Kind, value); msg != "" {
+			return fail("%s", msg)
+		}
+	}
+	return nil
+}
+
+// checkJSONInteger checks an integer given as a number or string
+func checkJSONInteger(kind Kind, value any) string {
+	var text string
+	switch v := value.(type) {
+	case json.Number:
+		text = v.String()
+	case string:
+		text = v
+	default:
+		return fmt.Sprintf("expected %s, got %s", kind, describe(value))
+	}
+
+	bits := 64
+	switch kind {
+	case KindInt32, KindSint32, KindSfixed32, KindUint32, KindFixed32:
+		bits = 32
+	}
+	var err error
+	switch kind {
+	case KindUint32, KindUint64, KindFixed32, KindFixed64:
+		_, err = strconv.ParseUint(integralText(text), 10, bits)
+	default:
+		_, err = strconv.ParseInt(integralText(text), 10, bits)
+	}
+	if err != nil {
+		return fmt.Sprintf("%s is not a valid %s", text, kind)
+	}
+	return ""
+}
+
+// integralText strips an exponent or zero fraction from a number such as
+// 1e3 or 5.0, which the JSON mapping accepts for integers
+func integralText(text string) string {
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil || f != math.Trunc(f) || strings.IndexAny(text, ".eE") < 0 || math.Abs(f) >= 1<<53 {
+		return text
+	}
+	return strconv.FormatFloat(f, 'f', 0, 64)
+}
+
+func checkMapKey(kind Kind, key string) string {
+	switch kind {
+	case KindString:
+		return ""
+	case KindBool:
+		if key != "true" && key != "false" {
+			return fmt.Sprintf("map key %q is not a bool", key)
+		}
+		return ""
+	}
+	if msg := checkJSONInteger(kind, key); msg != "" {
+		return "invalid map key: " + msg
+	}
+	return ""
+}
+
+func isJSONFloat(value any) bool {
+	switch v := value.(type) {
+	case json.Number:
+		return true
+	case string:
+		if v == "NaN" || v == "Infinity" || v == "-Infinity" {
+			return true
+		}
+		_, err := strconv.ParseFloat(v, 64)
+		return err == nil
+	}
+	return false
+}
+
+func isBase64(s string) bool {
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding} {
+		if _, err := enc.DecodeString(s); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func isWrapper(fullName string) bool {
+	switch fullName {
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue", "google.protobuf.Int64Value",
+		"google.protobuf.UInt64Value", "google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+		"google.protobuf.BoolValue", "google.protobuf.StringValue", "google.protobuf.BytesValue":
+		return true
+	}
+	return false
+}
+
+// describe names the JSON type of a value for error messages
+func describe(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,113 @@
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func userMessage(t *testing.T) *Message {
+	file, err := Parse(userProto)
+	require.NoError(t, err)
+	return file.FindMessage("UserSignedUp")
+}
+
+func TestValidateBinary(t *testing.T) {
+	msg := userMessage(t)
+
+	payload := []byte{
+		0x0a, 0x02, 'u', '1', // user_id: "u1"
+		0x18, 0x02, // plan: PLAN_PRO
+		0x2a, 0x01, 'x', // tags: ["x"]
+		0x32, 0x05, 0x0a, 0x01, 'a', 0x10, 0x05, // counters: {"a": 5}
+		0x98, 0x06, 0x01, // unknown field 99
+	}
+	assert.NoError(t, msg.ValidateBinary(payload))
+
+	packed, err := Parse(`syntax = "proto3"; message Scores { repeated int32 values = 1; }`)
+	require.NoError(t, err)
+	assert.NoError(t, packed.FindMessage("Scores").ValidateBinary([]byte{0x0a, 0x03, 0x01, 0x02, 0x03}))
+}
+
+func TestValidateBinary_Invalid(t *testing.T) {
+	msg := userMessage(t)
+
+	tests := []struct {
+		name    string
+		payload []byte
+		want    FieldError
+	}{
+		{"wrong wire type", []byte{0x08, 0x01}, FieldError{Path: "/user_id", Message: "expected wire type 2 for string, got 0"}},
+		{"invalid utf8", []byte{0x0a, 0x01, 0xff}, FieldError{Path: "/user_id", Message: "string is not valid UTF-8"}},
+		{"truncated", []byte{0x0a, 0x05, 'a'}, FieldError{Path: "/user_id", Message: "unexpected end of data for field 1"}},
+		{"group", []byte{0x0b}, FieldError{Path: "/user_id", Message: "groups are not supported in proto3 for field 1"}},
+		{"field zero", []byte{0x00}, FieldError{Message: "invalid field number 0 at byte 0"}},
+		{"nested", []byte{0x3a, 0x02, 0x0a, 0x05}, FieldError{Path: "/address/city", Message: "unexpected end of data for field 1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := msg.ValidateBinary(tt.payload)
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, []FieldError{tt.want}, validationErr.Errors)
+		})
+	}
+}
+
+func TestValidateJSON(t *testing.T) {
+	msg := userMessage(t)
+
+	tests := []struct {
+		name    string
+		payload string
+		want    []FieldError
+	}{
+		{
+			name:    "valid",
+			payload: `{"id":"u1","email":"a@example.com","plan":"PLAN_PRO","signedUpAt":"2024-01-02T15:04:05Z","tags":["x"],"counters":{"a":"5"},"address":{"city":"London","country":1},"phone":"123"}`,
+		},
+		{
+			name:    "proto field names and nulls",
+			payload: `{"user_id":"u1","signed_up_at":null,"plan":2}`,
+		},
+		{
+			name:    "invalid values",
+			payload: `{"id":1,"plan":"PLAN_GOLD","signedUpAt":"yesterday","counters":{"a":"five"},"tags":"x"}`,
+			want: []FieldError{
+				{Path: "/counters/a", Message: "five is not a valid int64"},
+				{Path: "/id", Message: "expected string, got number"},
+				{Path: "/plan", Message: `"PLAN_GOLD" is not a value of enum example.users.v1.Plan`},
+				{Path: "/signedUpAt", Message: "expected an RFC 3339 timestamp, got string"},
+				{Path: "/tags", Message: "expected array, got string"},
+			},
+		},
+		{
+			name:    "unknown field and oneof conflict",
+			payload: `{"nickname":"bob","phone":"1","slack":"@bob"}`,
+			want: []FieldError{
+				{Path: "/nickname", Message: "unknown field in message example.users.v1.UserSignedUp"},
+				{Path: "/slack", Message: "oneof contact already has phone set"},
+			},
+		},
+		{
+			name:    "field set twice",
+			payload: `{"id":"a","user_id":"b"}`,
+			want:    []FieldError{{Path: "/user_id", Message: "field user_id is also set as id"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := msg.ValidateJSON([]byte(tt.payload))
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.want, validationErr.Errors)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Package schemaformat provides a registry of handlers for the schema formats
+// a message's payload may be declared in, keyed by the media type of the
+// message's schemaFormat.
+//
+// The default registry handles AsyncAPI Schema Objects of every version,
+// JSON Schema, OpenAPI 3.0 Schema Objects, Avro and protobuf. Other formats,
+// such as RAML or in-house formats, can be added with Register.
+package schemaformat
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrUnsupportedSchemaFormat is returned for schema formats without a handler
+var ErrUnsupportedSchemaFormat = errors.New("unsupported schema format")
+
+// Media types of the built-in schema formats
+const (
+	AsyncAPI       = "application/vnd.aai.asyncapi"
+	AsyncAPIJSON   = "application/vnd.aai.asyncapi+json"
+	AsyncAPIYAML   = "application/vnd.aai.asyncapi+yaml"
+	JSONSchema     = "application/schema+json"
+	JSONSchemaYAML = "application/schema+yaml"
+	OpenAPI        = "application/vnd.oai.openapi"
+	OpenAPIJSON    = "application/vnd.oai.openapi+json"
+	OpenAPIYAML    = "application/vnd.oai.openapi+yaml"
+	Avro           = "application/vnd.apache.avro"
+	AvroJSON       = "application/vnd.apache.avro+json"
+	AvroYAML       = "application/vnd.apache.avro+yaml"
+	Protobuf       = "application/vnd.google.protobuf"
+)
+
+// Handler parses schemas in a schema format
+type Handler interface {
+	// Parse parses and validates a schema, returning a *SchemaError if the
+	// schema is invalid
+	Parse(req ParseRequest) (Schema, error)
+}
+
+// HandlerFunc adapts a function to a Handler
+type HandlerFunc func(req ParseRequest) (Schema, error)
+
+// Parse implements Handler.
+func (f HandlerFunc) Parse(req ParseRequest) (Schema, error) {
+	return f(req)
+}
+
+// ParseRequest holds a schema to parse along with details of the message it
+// describes
+type ParseRequest struct {
+	// SchemaFormat is the full schemaFormat, including parameters such as version
+	SchemaFormat string
+	// Schema is the schema as decoded from the document
+	Schema any
+	// MessageName is the name of the message, used by formats such as
+	// protobuf whose definitions may contain several types
+	MessageName string
+}
+
+// Schema is a parsed schema that can validate instances
+type Schema interface {
+	// Validate checks an encoded instance, such as a message payload, in the
+	// given content type. It returns a *ValidationError listing every
+	// violation, or another error if the instance can't be validated.
+	Validate(data []byte, contentType string) error
+}
+
+// SchemaError reports an invalid schema
+type SchemaError struct {
+	// Path is a JSON pointer to the problem within the schema, if known
+	Path    string
+	Message string
+}
+
+// Error implements error.
+func (e *SchemaError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// FieldError describes a single value that doesn't match a schema
+type FieldError struct {
+	// Path is a JSON pointer to the offending value
+	Path string `json:"path"`
+	// Message is a human readable description of the violation
+	Message string `json:"message"`
+}
+
+// String formats the error as "path: message"
+func (e FieldError) String() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidationError is returned when an instance does not match a schema
+type ValidationError struct {
+	Errors []FieldError `json:"errors"`
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, fieldErr := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("- %s", fieldErr))
+	}
+	return fmt.Sprintf("schema validation failed:\n%s", strings.Join(msgs, "\n"))
+}
+
+// Registry maps schema format media types to handlers. A Registry is safe
+// for concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	handlers map[string]Handler
+}
+
+// Default is the registry used by documents and validators unless another
+// is given
+var Default = NewRegistry()
+
+// NewRegistry creates a registry containing the built-in handlers
+func NewRegistry() *Registry {
+	r := &Registry{handlers: make(map[string]Handler)}
+
+	asyncAPI := &jsonSchemaHandler{}
+	for _, mediaType := range []string{AsyncAPI, AsyncAPIJSON, AsyncAPIYAML, JSONSchema, JSONSchemaYAML} {
+		r.Register(mediaType, asyncAPI)
+	}
+	openAPI := &jsonSchemaHandler{openAPI: true}
+	for _, mediaType := range []string{OpenAPI, OpenAPIJSON, OpenAPIYAML} {
+		r.Register(mediaType, openAPI)
+	}
+	for _, mediaType := range []string{Avro, AvroJSON, AvroYAML} {
+		r.Register(mediaType, avroHandler{})
+	}
+	r.Register(Protobuf, protobufHandler{})
+
+	return r
+}
+
+// Register adds a handler for a media type such as application/raml+yaml,
+// replacing any existing handler. Parameters such as version are ignored
+// when matching, and are passed to the handler in ParseRequest.SchemaFormat.
+func (r *Registry) Register(mediaType string, handler Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[MediaType(mediaType)] = handler
+}
+
+// Lookup returns the handler for a schemaFormat
+func (r *Registry) Lookup(schemaFormat string) (Handler, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	handler, ok := r.handlers[MediaType(schemaFormat)]
+	return handler, ok
+}
+
+// MediaTypes returns the registered media types, sorted
+func (r *Registry) MediaTypes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]string, 0, len(r.handlers))
+	for mediaType := range r.handlers {
+		out = append(out, mediaType)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Parse parses a schema with the handler for its schemaFormat, wrapping
+// ErrUnsupportedSchemaFormat if there is none. A nil schema parses to nil.
+func (r *Registry) Parse(req ParseRequest) (Schema, error) {
+	handler, ok := r.Lookup(req.SchemaFormat)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedSchemaFormat, req.SchemaFormat)
+	}
+	if req.Schema == nil {
+		return nil, nil
+	}
+	return handler.Parse(req)
+}
+
+// Register adds a handler to the default registry
+func Register(mediaType string, handler Handler) {
+	Default.Register(mediaType, handler)
+}
+
+// MediaType returns the lower case media type of a schemaFormat without
+// parameters, e.g. application/vnd.apache.avro for
+// application/vnd.apache.avro;version=1.9.0
+func MediaType(schemaFormat string) string {
+	return strings.ToLower(strings.TrimSpace(strings.Split(schemaFormat, ";")[0]))
+}
+
+// Parameter returns the value of a media type parameter such as version, or
+// an empty string
+func Parameter(schemaFormat, name string) string {
+	params := strings.Split(schemaFormat, ";")
+	for _, param := range params[1:] {
+		key, value, ok := strings.Cut(param, "=")
+		if ok && strings.EqualFold(strings.TrimSpace(key), name) {
+			return strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+	return ""
+}
+
+// IsJSONContentType reports whether a content type is a JSON type such as
+// application/json or application/vnd.apache.avro+json
+func IsJSONContentType(contentType string) bool {
+	mediaType := MediaType(contentType)
+	return strings.HasSuffix(mediaType, "/json") || strings.HasSuffix(mediaType, "+json")
+}
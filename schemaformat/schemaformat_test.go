@@ -0,0 +1,157 @@
+package schemaformat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ramlSchema accepts only strings, standing in for a real RAML type
+type ramlSchema struct{}
+
+func (ramlSchema) Validate(data []byte, contentType string) error {
+	if len(data) == 0 || data[0] != '"' {
+		return &ValidationError{Errors: []FieldError{{Message: "expected a string"}}}
+	}
+	return nil
+}
+
+func TestRegistry_Register(t *testing.T) {
+	r := NewRegistry()
+
+	_, err := r.Parse(ParseRequest{SchemaFormat: "application/raml+yaml;version=1.0", Schema: "string"})
+	assert.ErrorIs(t, err, ErrUnsupportedSchemaFormat)
+
+	var got ParseRequest
+	r.Register("application/raml+yaml", HandlerFunc(func(req ParseRequest) (Schema, error) {
+		got = req
+		return ramlSchema{}, nil
+	}))
+
+	schema, err := r.Parse(ParseRequest{SchemaFormat: "Application/RAML+YAML; version=1.0", Schema: "string"})
+	require.NoError(t, err)
+	assert.Equal(t, "1.0", Parameter(got.SchemaFormat, "version"))
+	assert.NoError(t, schema.Validate([]byte(`"x"`), ""))
+	assert.Error(t, schema.Validate([]byte(`1`), ""))
+	assert.Contains(t, r.MediaTypes(), "application/raml+yaml")
+
+	_, ok := Default.Lookup("application/raml+yaml")
+	assert.False(t, ok, "registering with a registry must not change the default")
+}
+
+func TestRegistry_BuiltIns(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     ParseRequest
+		payload string
+		want    []FieldError
+	}{
+		{
+			name:    "asyncapi",
+			req:     ParseRequest{SchemaFormat: "application/vnd.aai.asyncapi+json;version=2.6.0", Schema: map[string]any{"type": "object", "required": []any{"id"}}},
+			payload: `{}`,
+			want:    []FieldError{{Path: "", Message: "id is required"}},
+		},
+		{
+			name:    "json schema draft-04",
+			req:     ParseRequest{SchemaFormat: "application/schema+json;version=draft-04", Schema: map[string]any{"type": "number", "minimum": 1, "exclusiveMinimum": true}},
+			payload: `1`,
+			want:    []FieldError{{Path: "", Message: "Must be greater than 1"}},
+		},
+		{
+			name:    "openapi nullable",
+			req:     ParseRequest{SchemaFormat: "application/vnd.oai.openapi;version=3.0.0", Schema: map[string]any{"type": "object", "properties": map[string]any{"name": map[string]any{"type": "string", "nullable": true}}}},
+			payload: `{"name": null}`,
+		},
+		{
+			name:    "openapi exclusive maximum",
+			req:     ParseRequest{SchemaFormat: "application/vnd.oai.openapi+yaml;version=3.0.0", Schema: map[string]any{"type": "integer", "maximum": 10, "exclusiveMaximum": true}},
+			payload: `10`,
+			want:    []FieldError{{Path: "", Message: "Must be less than 10"}},
+		},
+		{
+			name:    "avro",
+			req:     ParseRequest{SchemaFormat: "application/vnd.apache.avro;version=1.9.0", Schema: "long"},
+			payload: `"one"`,
+			want:    []FieldError{{Path: "", Message: "expected long, got string"}},
+		},
+		{
+			name:    "protobuf",
+			req:     ParseRequest{SchemaFormat: "application/vnd.google.protobuf;version=3", Schema: `syntax = "proto3"; message A { int32 n = 1; }`},
+			payload: `{"n": "x"}`,
+			want:    []FieldError{{Path: "/n", Message: "x is not a valid int32"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := Default.Parse(tt.req)
+			require.NoError(t, err)
+
+			err = schema.Validate([]byte(tt.payload), "application/json")
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *ValidationError
+			require.True(t, errors.As(err, &validationErr), "got %v", err)
+			assert.Equal(t, tt.want, validationErr.Errors)
+		})
+	}
+}
+
+func TestRegistry_InvalidSchemas(t *testing.T) {
+	tests := []struct {
+		name string
+		req  ParseRequest
+		want SchemaError
+	}{
+		{
+			name: "avro",
+			req:  ParseRequest{SchemaFormat: Avro, Schema: map[string]any{"type": "record", "name": "A", "fields": []any{map[string]any{"name": "a", "type": "uuid"}}}},
+			want: SchemaError{Path: "/fields/0/type", Message: `invalid Avro schema: unknown type "uuid"`},
+		},
+		{
+			name: "protobuf",
+			req:  ParseRequest{SchemaFormat: Protobuf, Schema: map[string]any{"type": "object"}},
+			want: SchemaError{Message: "protobuf payloads must be .proto source, inline or referenced with $ref"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Default.Parse(tt.req)
+			var schemaErr *SchemaError
+			require.ErrorAs(t, err, &schemaErr)
+			assert.Equal(t, tt.want, *schemaErr)
+		})
+	}
+}
+
+func TestProtobufSchema_MessageType(t *testing.T) {
+	source := `syntax = "proto3"; message A { int32 n = 1; } message B { string s = 1; }`
+
+	schema, err := Default.Parse(ParseRequest{SchemaFormat: Protobuf, Schema: source, MessageName: "B"})
+	require.NoError(t, err)
+	assert.Equal(t, "B", schema.(*ProtobufSchema).MessageType.Name)
+
+	schema, err = Default.Parse(ParseRequest{SchemaFormat: Protobuf, Schema: source})
+	require.NoError(t, err)
+	assert.Nil(t, schema.(*ProtobufSchema).MessageType)
+	assert.ErrorContains(t, schema.Validate(nil, ""), "the proto definition has 2 message types")
+
+	// A name that isn't defined doesn't fall back to the only message type
+	_, err = Default.Parse(ParseRequest{SchemaFormat: Protobuf, Schema: `syntax = "proto3"; message A { int32 n = 1; }`, MessageName: "C"})
+	var schemaErr *SchemaError
+	require.ErrorAs(t, err, &schemaErr)
+	assert.Equal(t, `message type "C" isn't defined by the proto definition`, schemaErr.Message)
+}
+
+func TestIsJSONContentType(t *testing.T) {
+	assert.True(t, IsJSONContentType("application/json"))
+	assert.True(t, IsJSONContentType("application/vnd.apache.avro+json; charset=utf-8"))
+	assert.False(t, IsJSONContentType("avro/binary"))
+	assert.False(t, IsJSONContentType(""))
+}
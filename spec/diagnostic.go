@@ -0,0 +1,118 @@
+package spec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity specifies how serious a diagnostic is
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+	SeverityInfo
+	SeverityHint
+)
+
+// String returns the lowercase name of the severity
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "info"
+	case SeverityHint:
+		return "hint"
+	default:
+		return fmt.Sprintf("severity(%d)", int(s))
+	}
+}
+
+// Diagnostic is a single structured finding about a document
+type Diagnostic struct {
+	// Code is a stable identifier for the kind of finding, e.g. "duplicate-operation-id"
+	Code string `json:"code"`
+	// Severity specifies how serious the finding is
+	Severity Severity `json:"severity"`
+	// Path is a JSON pointer to the offending location in the document
+	Path string `json:"path"`
+	// Message is a human readable description of the finding
+	Message string `json:"message"`
+}
+
+// String formats the diagnostic as "path: message"
+func (d Diagnostic) String() string {
+	if d.Path == "" {
+		return d.Message
+	}
+	return fmt.Sprintf("%s: %s", d.Path, d.Message)
+}
+
+// Diagnostics is a list of findings. It implements error so that error
+// severity findings can be returned directly from Validate.
+type Diagnostics []Diagnostic
+
+// Error implements error.
+func (d Diagnostics) Error() string {
+	var msgs []string
+	for _, diag := range d {
+		msgs = append(msgs, fmt.Sprintf("- %s", diag))
+	}
+	return fmt.Sprintf("validation errors:\n%s", strings.Join(msgs, "\n"))
+}
+
+// Errors returns only the findings with error severity
+func (d Diagnostics) Errors() Diagnostics {
+	return d.filter(SeverityError)
+}
+
+// Warnings returns only the findings with warning severity
+func (d Diagnostics) Warnings() Diagnostics {
+	return d.filter(SeverityWarning)
+}
+
+// NonErrors returns the findings without error severity
+func (d Diagnostics) NonErrors() Diagnostics {
+	var out Diagnostics
+	for _, diag := range d {
+		if diag.Severity != SeverityError {
+			out = append(out, diag)
+		}
+	}
+	return out
+}
+
+// HasErrors reports whether any finding has error severity
+func (d Diagnostics) HasErrors() bool {
+	for _, diag := range d {
+		if diag.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func (d Diagnostics) filter(severity Severity) Diagnostics {
+	var out Diagnostics
+	for _, diag := range d {
+		if diag.Severity == severity {
+			out = append(out, diag)
+		}
+	}
+	return out
+}
+
+// JSONPointer builds an RFC 6901 JSON pointer from the given path segments
+func JSONPointer(segments ...string) string {
+	var b strings.Builder
+	for _, s := range segments {
+		b.WriteByte('/')
+		s = strings.ReplaceAll(s, "~", "~0")
+		s = strings.ReplaceAll(s, "/", "~1")
+		b.WriteString(s)
+	}
+	return b.String()
+}
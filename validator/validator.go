@@ -0,0 +1,192 @@
+// Package validator validates messages at runtime against the payload and
+// headers schemas declared for them in an AsyncAPI document.
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/charlie-haley/asyncapi-go/asyncapi2"
+	"github.com/charlie-haley/asyncapi-go/schemaformat"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// ErrUnsupportedSchemaFormat is returned for messages whose schemaFormat has
+// no handler in the validator's registry
+var ErrUnsupportedSchemaFormat = schemaformat.ErrUnsupportedSchemaFormat
+
+// Part identifies which part of a message a FieldError refers to
+type Part string
+
+const (
+	PartPayload Part = "payload"
+	PartHeaders Part = "headers"
+)
+
+// FieldError describes a single schema violation in a message
+type FieldError struct {
+	// Part is the part of the message that failed validation
+	Part Part `json:"part"`
+	// Path is a JSON pointer to the offending value within the part
+	Path string `json:"path"`
+	// Message is a human readable description of the violation
+	Message string `json:"message"`
+}
+
+// String formats the error as "part/path: message"
+func (e FieldError) String() string {
+	return fmt.Sprintf("%s%s: %s", e.Part, e.Path, e.Message)
+}
+
+// ValidationError is returned when a message does not match its schemas
+type ValidationError struct {
+	Errors []FieldError `json:"errors"`
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, fieldErr := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("- %s", fieldErr))
+	}
+	return fmt.Sprintf("message validation failed:\n%s", strings.Join(msgs, "\n"))
+}
+
+// Handler processes a single message
+type Handler func(ctx context.Context, payload []byte, headers map[string]any) error
+
+// Validator validates messages against their schemas. Schemas are compiled
+// the first time a message is validated and cached for subsequent calls, so
+// messages must not be modified once they have been validated. A Validator is
+// safe for concurrent use.
+type Validator struct {
+	registry *schemaformat.Registry
+	cache    sync.Map
+}
+
+// compiledMessage holds the compiled schemas for a message
+type compiledMessage struct {
+	payload schemaformat.Schema
+	headers *schemaformat.JSONSchemaDocument
+	err     error
+}
+
+// New creates a Validator with an empty schema cache, using the default
+// schema format registry
+func New() *Validator {
+	return &Validator{registry: schemaformat.Default}
+}
+
+// WithRegistry sets the registry used to parse payload schemas
+func (v *Validator) WithRegistry(registry *schemaformat.Registry) *Validator {
+	v.registry = registry
+	return v
+}
+
+// Validate checks a payload and a set of headers against the message's
+// payload and headers schemas. It returns a *ValidationError listing every
+// violation, or another error if the schemas can't be compiled. A message
+// without a payload or headers schema accepts any value for it.
+//
+// Payloads are validated by the handler for the message's schemaFormat, in
+// the encoding implied by its contentType or its document's
+// defaultContentType. JSON Schema payloads must be JSON encoded, while Avro
+// and protobuf payloads use their binary encodings unless the content type
+// is a JSON type such as application/json. Protobuf payloads are validated
+// against the message type named by the message's name, or the only message
+// type defined when there is just one.
+func (v *Validator) Validate(msg *asyncapi2.Message, payload []byte, headers map[string]any) error {
+	compiled := v.compile(msg)
+	if compiled.err != nil {
+		return compiled.err
+	}
+
+	var fieldErrs []FieldError
+	if compiled.payload != nil {
+		errs, err := fieldErrors(compiled.payload.Validate(payload, msg.EffectiveContentType()), PartPayload)
+		if err != nil {
+			return err
+		}
+		fieldErrs = append(fieldErrs, errs...)
+	}
+	if compiled.headers != nil {
+		if headers == nil {
+			headers = map[string]any{}
+		}
+		errs, err := fieldErrors(compiled.headers.ValidateValue(headers), PartHeaders)
+		if err != nil {
+			return err
+		}
+		fieldErrs = append(fieldErrs, errs...)
+	}
+
+	if len(fieldErrs) > 0 {
+		return &ValidationError{Errors: fieldErrs}
+	}
+	return nil
+}
+
+// Middleware returns a Handler that validates each message against msg's
+// schemas before calling next. Invalid messages are rejected with a
+// *ValidationError and never reach next.
+func (v *Validator) Middleware(msg *asyncapi2.Message, next Handler) Handler {
+	return func(ctx context.Context, payload []byte, headers map[string]any) error {
+		if err := v.Validate(msg, payload, headers); err != nil {
+			return err
+		}
+		return next(ctx, payload, headers)
+	}
+}
+
+// compile returns the cached schemas for msg, compiling them on first use
+func (v *Validator) compile(msg *asyncapi2.Message) *compiledMessage {
+	if cached, ok := v.cache.Load(msg); ok {
+		return cached.(*compiledMessage)
+	}
+
+	compiled := &compiledMessage{}
+	if msg == nil {
+		compiled.err = errors.New("message is nil")
+		return compiled
+	}
+
+	compiled.payload, compiled.err = v.registry.Parse(schemaformat.ParseRequest{
+		SchemaFormat: msg.EffectiveSchemaFormat(),
+		Schema:       msg.Payload,
+		MessageName:  msg.Name,
+	})
+	switch {
+	case errors.Is(compiled.err, ErrUnsupportedSchemaFormat):
+	case compiled.err != nil:
+		compiled.err = fmt.Errorf("failed to compile %s schema: %w", PartPayload, compiled.err)
+	case msg.Headers != nil:
+		// Headers are always a Schema Object, whatever the payload's schemaFormat
+		compiled.headers, compiled.err = schemaformat.CompileJSONSchema(msg.Headers, gojsonschema.Draft7)
+		if compiled.err != nil {
+			compiled.err = fmt.Errorf("failed to compile %s schema: %w", PartHeaders, compiled.err)
+		}
+	}
+
+	actual, _ := v.cache.LoadOrStore(msg, compiled)
+	return actual.(*compiledMessage)
+}
+
+// fieldErrors converts a schema validation error to field errors for a part
+// of the message, returning other errors unchanged
+func fieldErrors(err error, part Part) ([]FieldError, error) {
+	if err == nil {
+		return nil, nil
+	}
+	var schemaErr *schemaformat.ValidationError
+	if !errors.As(err, &schemaErr) {
+		return nil, err
+	}
+	fieldErrs := make([]FieldError, 0, len(schemaErr.Errors))
+	for _, e := range schemaErr.Errors {
+		fieldErrs = append(fieldErrs, FieldError{Part: part, Path: e.Path, Message: e.Message})
+	}
+	return fieldErrs, nil
+}
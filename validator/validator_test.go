@@ -0,0 +1,284 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/charlie-haley/asyncapi-go/asyncapi2"
+	"github.com/charlie-haley/asyncapi-go/schemaformat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+func newUserMessage() *asyncapi2.Message {
+	return asyncapi2.NewMessage().
+		WithPayload(map[string]any{
+			"type":     "object",
+			"required": []any{"userId", "email"},
+			"properties": map[string]any{
+				"userId": map[string]any{"type": "string", "format": "uuid"},
+				"email":  map[string]any{"type": "string", "format": "email"},
+				"age":    map[string]any{"type": "integer", "minimum": 0},
+			},
+		}).
+		WithHeaders(map[string]any{
+			"type":     "object",
+			"required": []any{"correlationId"},
+			"properties": map[string]any{
+				"correlationId": map[string]any{"type": "string"},
+			},
+		})
+}
+
+func TestValidate(t *testing.T) {
+	msg := newUserMessage()
+	v := New()
+
+	tests := []struct {
+		name     string
+		payload  string
+		headers  map[string]any
+		expected []FieldError
+	}{
+		{
+			name:    "valid",
+			payload: `{"userId": "3f1c2a4e-8b1d-4c8e-9a57-1f2e3d4c5b6a", "email": "jane@example.com", "age": 30}`,
+			headers: map[string]any{"correlationId": "abc"},
+		},
+		{
+			name:    "invalid payload",
+			payload: `{"userId": "not-a-uuid", "age": -1}`,
+			headers: map[string]any{"correlationId": "abc"},
+			expected: []FieldError{
+				{Part: PartPayload, Path: "", Message: "email is required"},
+				{Part: PartPayload, Path: "/age", Message: "Must be greater than or equal to 0"},
+				{Part: PartPayload, Path: "/userId", Message: "Does not match format 'uuid'"},
+			},
+		},
+		{
+			name:    "missing headers",
+			payload: `{"userId": "3f1c2a4e-8b1d-4c8e-9a57-1f2e3d4c5b6a", "email": "jane@example.com"}`,
+			expected: []FieldError{
+				{Part: PartHeaders, Path: "", Message: "correlationId is required"},
+			},
+		},
+		{
+			name:    "malformed JSON",
+			payload: `{"userId":`,
+			headers: map[string]any{"correlationId": "abc"},
+			expected: []FieldError{
+				{Part: PartPayload, Path: "", Message: "invalid JSON: unexpected EOF"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Validate(msg, []byte(tt.payload), tt.headers)
+			if tt.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.ElementsMatch(t, tt.expected, validationErr.Errors)
+		})
+	}
+}
+
+func TestValidate_CachesSchemas(t *testing.T) {
+	msg := newUserMessage()
+	v := New()
+
+	require.Error(t, v.Validate(msg, []byte(`{}`), nil))
+	first, ok := v.cache.Load(msg)
+	require.True(t, ok)
+
+	require.Error(t, v.Validate(msg, []byte(`{}`), nil))
+	second, _ := v.cache.Load(msg)
+	assert.Same(t, first, second)
+}
+
+func TestValidate_NoSchemas(t *testing.T) {
+	assert.NoError(t, New().Validate(asyncapi2.NewMessage(), []byte(`"anything"`), nil))
+}
+
+func TestValidate_UnsupportedSchemaFormat(t *testing.T) {
+	msg := asyncapi2.NewMessage().
+		WithSchemaFormat("application/raml+yaml;version=1.0").
+		WithPayload(map[string]any{"type": "object"})
+
+	err := New().Validate(msg, []byte(`{}`), nil)
+	assert.ErrorIs(t, err, ErrUnsupportedSchemaFormat)
+}
+
+func TestValidate_CustomRegistry(t *testing.T) {
+	registry := schemaformat.NewRegistry()
+	registry.Register("application/raml+yaml", schemaformat.HandlerFunc(func(req schemaformat.ParseRequest) (schemaformat.Schema, error) {
+		return schemaformat.CompileJSONSchema(map[string]any{"type": "string"}, gojsonschema.Draft7)
+	}))
+	msg := asyncapi2.NewMessage().
+		WithSchemaFormat("application/raml+yaml;version=1.0").
+		WithPayload("string")
+	v := New().WithRegistry(registry)
+
+	require.NoError(t, v.Validate(msg, []byte(`"x"`), nil))
+
+	err := v.Validate(msg, []byte(`1`), nil)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []FieldError{{Part: PartPayload, Path: "", Message: "Invalid type. Expected: string, given: integer"}}, validationErr.Errors)
+}
+
+func TestValidate_DefaultContentType(t *testing.T) {
+	doc, err := asyncapi2.ParseFromJSON([]byte(`{
+		"asyncapi": "2.6.0",
+		"info": {"title": "Users", "version": "1.0.0"},
+		"defaultContentType": "application/vnd.apache.avro+json",
+		"channels": {
+			"users": {
+				"publish": {
+					"message": {
+						"schemaFormat": "application/vnd.apache.avro;version=1.9.0",
+						"payload": {"type": "record", "name": "User", "fields": [{"name": "id", "type": "long"}]}
+					}
+				}
+			}
+		}
+	}`))
+	require.NoError(t, err)
+	channel, _ := doc.(*asyncapi2.Document).Channels.Get("users")
+	msg := channel.Publish.Message
+	v := New()
+
+	// Without the document's defaultContentType this would be decoded as binary
+	require.NoError(t, v.Validate(msg, []byte(`{"id": 1}`), nil))
+	assert.Error(t, v.Validate(msg, []byte(`{"id": "one"}`), nil))
+}
+
+func newAvroMessage(contentType string) *asyncapi2.Message {
+	return asyncapi2.NewMessage().
+		WithSchemaFormat("application/vnd.apache.avro;version=1.9.0").
+		WithContentType(contentType).
+		WithPayload(map[string]any{
+			"type": "record",
+			"name": "User",
+			"fields": []any{
+				map[string]any{"name": "id", "type": "long"},
+				map[string]any{"name": "email", "type": []any{"null", "string"}},
+			},
+		})
+}
+
+func TestValidate_AvroJSON(t *testing.T) {
+	msg := newAvroMessage("application/vnd.apache.avro+json")
+	v := New()
+
+	require.NoError(t, v.Validate(msg, []byte(`{"id": 1, "email": {"string": "jane@example.com"}}`), nil))
+
+	err := v.Validate(msg, []byte(`{"id": "one", "email": null}`), nil)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []FieldError{{Part: PartPayload, Path: "/id", Message: "expected long, got string"}}, validationErr.Errors)
+}
+
+func TestValidate_AvroBinary(t *testing.T) {
+	msg := newAvroMessage("application/vnd.apache.avro+binary")
+	v := New()
+
+	// id: 1, email: union branch 1, "a"
+	require.NoError(t, v.Validate(msg, []byte{0x02, 0x02, 0x02, 'a'}, nil))
+
+	err := v.Validate(msg, []byte{0x02, 0x04}, nil)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "/email", validationErr.Errors[0].Path)
+	assert.Contains(t, validationErr.Errors[0].Message, "union index 2 is out of range")
+}
+
+func newProtobufMessage(name, contentType string) *asyncapi2.Message {
+	return asyncapi2.NewMessage().
+		WithName(name).
+		WithSchemaFormat("application/vnd.google.protobuf;version=3").
+		WithContentType(contentType).
+		WithPayload(`syntax = "proto3";
+message User {
+  string id = 1;
+  int32 age = 2;
+}
+message Order {
+  string order_id = 1;
+}`)
+}
+
+func TestValidate_ProtobufBinary(t *testing.T) {
+	msg := newProtobufMessage("User", "application/x-protobuf")
+	v := New()
+
+	// id: "a", age: 30
+	require.NoError(t, v.Validate(msg, []byte{0x0a, 0x01, 'a', 0x10, 0x1e}, nil))
+
+	err := v.Validate(msg, []byte{0x08, 0x01}, nil)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []FieldError{{Part: PartPayload, Path: "/id", Message: "expected wire type 2 for string, got 0"}}, validationErr.Errors)
+}
+
+func TestValidate_ProtobufJSON(t *testing.T) {
+	msg := newProtobufMessage("Order", "application/json")
+	v := New()
+
+	require.NoError(t, v.Validate(msg, []byte(`{"orderId": "o-1"}`), nil))
+
+	err := v.Validate(msg, []byte(`{"id": "o-1"}`), nil)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []FieldError{{Part: PartPayload, Path: "/id", Message: "unknown field in message Order"}}, validationErr.Errors)
+}
+
+func TestValidate_ProtobufAmbiguousMessageType(t *testing.T) {
+	msg := newProtobufMessage("", "application/json")
+
+	err := New().Validate(msg, []byte(`{}`), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the proto definition has 2 message types")
+}
+
+func TestValidate_InvalidAvroSchema(t *testing.T) {
+	msg := asyncapi2.NewMessage().
+		WithSchemaFormat("application/vnd.apache.avro;version=1.9.0").
+		WithPayload(map[string]any{"type": "record", "name": "User"})
+
+	err := New().Validate(msg, []byte(`{}`), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to compile payload schema")
+}
+
+func TestValidate_InvalidSchema(t *testing.T) {
+	msg := asyncapi2.NewMessage().WithPayload(map[string]any{"type": 42})
+
+	err := New().Validate(msg, []byte(`{}`), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to compile payload schema")
+}
+
+func TestMiddleware(t *testing.T) {
+	msg := newUserMessage()
+	var handled int
+	handler := New().Middleware(msg, func(ctx context.Context, payload []byte, headers map[string]any) error {
+		handled++
+		return nil
+	})
+
+	err := handler(context.Background(), []byte(`{"userId": "3f1c2a4e-8b1d-4c8e-9a57-1f2e3d4c5b6a", "email": "jane@example.com"}`), map[string]any{"correlationId": "abc"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, handled)
+
+	err = handler(context.Background(), []byte(`{}`), map[string]any{"correlationId": "abc"})
+	var validationErr *ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, 1, handled)
+}
@@ -0,0 +1,216 @@
+package workspace
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/charlie-haley/asyncapi-go/asyncapi2"
+	"github.com/charlie-haley/asyncapi-go/spec"
+)
+
+// ChannelRef is a channel defined by a document in the workspace
+type ChannelRef struct {
+	Spec    *Spec
+	Address string
+	Channel *asyncapi2.Channel
+}
+
+// Produces reports whether the document's application sends messages to the
+// channel. In AsyncAPI 2.x this is the subscribe operation, which describes
+// what other applications can subscribe to.
+func (c *ChannelRef) Produces() bool {
+	return c.Channel.Subscribe != nil
+}
+
+// Consumes reports whether the document's application receives messages
+// from the channel, the publish operation in AsyncAPI 2.x
+func (c *ChannelRef) Consumes() bool {
+	return c.Channel.Publish != nil
+}
+
+// ServerRef is a server defined by a document in the workspace
+type ServerRef struct {
+	Spec   *Spec
+	Name   string
+	Server *asyncapi2.Server
+}
+
+// MessageRef is a message defined by a document in the workspace
+type MessageRef struct {
+	Spec *Spec
+	// Path is a JSON pointer to the message within the document
+	Path    string
+	Message *asyncapi2.Message
+}
+
+// index maps the names used in queries to what they identify
+type index struct {
+	channels     map[string][]*ChannelRef
+	servers      map[string][]*ServerRef
+	messages     map[string][]*MessageRef
+	referencedBy map[string][]*Spec
+}
+
+func newIndex() *index {
+	return &index{
+		channels:     make(map[string][]*ChannelRef),
+		servers:      make(map[string][]*ServerRef),
+		messages:     make(map[string][]*MessageRef),
+		referencedBy: make(map[string][]*Spec),
+	}
+}
+
+// add indexes a document. Channels are indexed by address and by the topic
+// of their Kafka binding when it differs, servers by URL and name, and
+// messages by name, falling back to their key in components.
+func (idx *index) add(s *Spec) {
+	for _, ref := range s.Refs {
+		idx.referencedBy[ref] = append(idx.referencedBy[ref], s)
+	}
+
+	doc := s.Document
+	if doc == nil {
+		return
+	}
+
+	for _, address := range doc.Channels.Keys() {
+		channel, _ := doc.Channels.Get(address)
+		if channel == nil {
+			continue
+		}
+		ref := &ChannelRef{Spec: s, Address: address, Channel: channel}
+		idx.channels[address] = append(idx.channels[address], ref)
+		if topic := kafkaTopic(channel); topic != "" && topic != address {
+			idx.channels[topic] = append(idx.channels[topic], ref)
+		}
+
+		for _, op := range []struct {
+			kind      string
+			operation *asyncapi2.Operation
+		}{{"publish", channel.Publish}, {"subscribe", channel.Subscribe}} {
+			if op.operation == nil || op.operation.Message == nil {
+				continue
+			}
+			message := op.operation.Message
+			if message.Name != "" {
+				idx.addMessage(message.Name, s, spec.JSONPointer("channels", address, op.kind, "message"), message)
+			}
+		}
+	}
+
+	for _, name := range doc.Servers.Keys() {
+		if server, _ := doc.Servers.Get(name); server != nil {
+			idx.addServer(s, name, server)
+		}
+	}
+
+	if doc.Components != nil {
+		for _, key := range doc.Components.Messages.Keys() {
+			message, _ := doc.Components.Messages.Get(key)
+			if message == nil {
+				continue
+			}
+			name := message.Name
+			if name == "" {
+				name = key
+			}
+			idx.addMessage(name, s, spec.JSONPointer("components", "messages", key), message)
+		}
+	}
+}
+
+func (idx *index) addServer(s *Spec, name string, server *asyncapi2.Server) {
+	ref := &ServerRef{Spec: s, Name: name, Server: server}
+	idx.servers[name] = append(idx.servers[name], ref)
+	if server.URL != "" && server.URL != name {
+		idx.servers[server.URL] = append(idx.servers[server.URL], ref)
+	}
+}
+
+func (idx *index) addMessage(name string, s *Spec, pointer string, message *asyncapi2.Message) {
+	idx.messages[name] = append(idx.messages[name], &MessageRef{Spec: s, Path: pointer, Message: message})
+}
+
+// Channels returns every channel with the given address, or whose Kafka
+// binding uses the given topic, across the workspace
+func (w *Workspace) Channels(address string) []*ChannelRef {
+	return w.index.channels[address]
+}
+
+// Producers returns the documents whose applications send messages to the
+// channel with the given address or topic
+func (w *Workspace) Producers(address string) []*Spec {
+	var specs []*Spec
+	for _, ref := range w.Channels(address) {
+		if ref.Produces() {
+			specs = appendSpec(specs, ref.Spec)
+		}
+	}
+	return specs
+}
+
+// Consumers returns the documents whose applications receive messages from
+// the channel with the given address or topic
+func (w *Workspace) Consumers(address string) []*Spec {
+	var specs []*Spec
+	for _, ref := range w.Channels(address) {
+		if ref.Consumes() {
+			specs = appendSpec(specs, ref.Spec)
+		}
+	}
+	return specs
+}
+
+// Servers returns every server with the given name or URL across the workspace
+func (w *Workspace) Servers(nameOrURL string) []*ServerRef {
+	return w.index.servers[nameOrURL]
+}
+
+// Messages returns every message with the given name across the workspace.
+// Messages in components without a name are found by their key.
+func (w *Workspace) Messages(name string) []*MessageRef {
+	return w.index.messages[name]
+}
+
+// ReferencedBy returns the documents that reference a file, given as a slash
+// separated path within the workspace, or a URL
+func (w *Workspace) ReferencedBy(location string) []*Spec {
+	if !strings.Contains(location, "://") {
+		location = path.Clean(location)
+	}
+	return w.index.referencedBy[location]
+}
+
+// ChannelAddresses returns every indexed channel address and topic, sorted
+func (w *Workspace) ChannelAddresses() []string {
+	return sortedKeys(w.index.channels)
+}
+
+// kafkaTopic returns the topic of a channel's Kafka binding, if it has one
+func kafkaTopic(channel *asyncapi2.Channel) string {
+	binding, ok := channel.Bindings["kafka"].(map[string]any)
+	if !ok {
+		return ""
+	}
+	topic, _ := binding["topic"].(string)
+	return topic
+}
+
+// appendSpec appends a document unless it's already the last one, which is
+// enough to deduplicate since refs are indexed document by document
+func appendSpec(specs []*Spec, s *Spec) []*Spec {
+	if len(specs) > 0 && specs[len(specs)-1] == s {
+		return specs
+	}
+	return append(specs, s)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
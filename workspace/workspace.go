@@ -0,0 +1,211 @@
+// Package workspace parses a tree of AsyncAPI documents, such as one per
+// service in a monorepo, and indexes their channels, servers and messages so
+// that questions spanning documents can be answered, e.g. which services
+// produce to a topic or which documents reference a shared file.
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	asyncapi "github.com/charlie-haley/asyncapi-go"
+	"github.com/charlie-haley/asyncapi-go/asyncapi2"
+)
+
+// asyncAPIKey matches the asyncapi key that every document starts with,
+// unindented in YAML or anywhere in JSON
+var asyncAPIKey = regexp.MustCompile(`(?m)^["']?asyncapi["']?\s*:|"asyncapi"\s*:`)
+
+// Spec is a document in a workspace
+type Spec struct {
+	// Path is the slash separated path of the document within the workspace
+	Path string
+	// Document is the parsed document. It's nil if parsing failed, unless
+	// the parse options allow partial documents.
+	Document *asyncapi2.Document
+	// Refs are the files and URLs the document references, directly or
+	// through other referenced files, sorted
+	Refs []string
+	// Err is the error parsing the document, if any
+	Err error
+}
+
+// Workspace is a set of documents parsed from a file system
+type Workspace struct {
+	fsys        fs.FS
+	concurrency int
+	opts        asyncapi.ParseOptions
+
+	specs []*Spec
+	index *index
+}
+
+// New creates a workspace for the documents in fsys, which are parsed by Load
+func New(fsys fs.FS) *Workspace {
+	return &Workspace{
+		fsys:        fsys,
+		concurrency: runtime.GOMAXPROCS(0),
+		index:       newIndex(),
+	}
+}
+
+// WithConcurrency sets how many documents are parsed at once, defaulting to
+// GOMAXPROCS
+func (w *Workspace) WithConcurrency(n int) *Workspace {
+	if n < 1 {
+		n = 1
+	}
+	w.concurrency = n
+	return w
+}
+
+// WithParseOptions sets the options every document is parsed with. A shared
+// RefCache is created if the options don't include one.
+func (w *Workspace) WithParseOptions(opts asyncapi.ParseOptions) *Workspace {
+	w.opts = opts
+	return w
+}
+
+// Load finds every AsyncAPI document in the workspace's file system, parses
+// them concurrently and indexes them, replacing anything loaded before.
+// Files are documents if they have a .yaml, .yml or .json extension and an
+// asyncapi key; other files, such as shared schemas, are only read when
+// referenced. Hidden directories are skipped.
+//
+// Documents that fail to parse are kept with their error in Spec.Err, so
+// Load only returns an error if the file system can't be walked or ctx is
+// done.
+func (w *Workspace) Load(ctx context.Context) error {
+	paths, err := w.findSpecs()
+	if err != nil {
+		return err
+	}
+
+	opts := w.opts
+	if opts.RefCache == nil {
+		opts.RefCache = asyncapi.NewRefCache()
+	}
+
+	specs := make([]*Spec, len(paths))
+	sem := make(chan struct{}, w.concurrency)
+	var wg sync.WaitGroup
+	for i, name := range paths {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			specs[i] = w.parse(ctx, name, opts)
+		}(i, name)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("failed to load workspace: %w", err)
+	}
+
+	w.specs = specs
+	w.index = newIndex()
+	for _, spec := range specs {
+		w.index.add(spec)
+	}
+	return nil
+}
+
+// parse parses a single document, recording the files it references
+func (w *Workspace) parse(ctx context.Context, name string, opts asyncapi.ParseOptions) *Spec {
+	refs := make(map[string]bool)
+	onRef := opts.OnRef
+	opts.OnRef = func(location string) {
+		refs[location] = true
+		if onRef != nil {
+			onRef(location)
+		}
+	}
+
+	spec := &Spec{Path: name}
+	doc, err := asyncapi.ParseFS(ctx, w.fsys, name, opts)
+	spec.Err = err
+	if v2Doc, ok := doc.(*asyncapi2.Document); ok {
+		spec.Document = v2Doc
+	}
+
+	for location := range refs {
+		spec.Refs = append(spec.Refs, location)
+	}
+	sort.Strings(spec.Refs)
+	return spec
+}
+
+// findSpecs returns the paths of the AsyncAPI documents in the file system
+func (w *Workspace) findSpecs() ([]string, error) {
+	var paths []string
+	err := fs.WalkDir(w.fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if name != "." && strings.HasPrefix(entry.Name(), ".") {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		switch strings.ToLower(path.Ext(name)) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+		data, err := fs.ReadFile(w.fsys, name)
+		if err != nil {
+			return err
+		}
+		if asyncAPIKey.Match(data) {
+			paths = append(paths, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk workspace: %w", err)
+	}
+	return paths, nil
+}
+
+// Specs returns every document in the workspace, sorted by path
+func (w *Workspace) Specs() []*Spec {
+	return w.specs
+}
+
+// Spec returns the document at a path, or nil if there is none
+func (w *Workspace) Spec(name string) *Spec {
+	for _, spec := range w.specs {
+		if spec.Path == name {
+			return spec
+		}
+	}
+	return nil
+}
+
+// Errors returns the documents that failed to parse
+func (w *Workspace) Errors() []*Spec {
+	var failed []*Spec
+	for _, spec := range w.specs {
+		if spec.Err != nil {
+			failed = append(failed, spec)
+		}
+	}
+	return failed
+}
@@ -0,0 +1,179 @@
+package workspace
+
+import (
+	"context"
+	"io/fs"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	asyncapi "github.com/charlie-haley/asyncapi-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingFS counts how many times each file is opened
+type countingFS struct {
+	fs.FS
+	mu    sync.Mutex
+	opens map[string]int
+}
+
+func (c *countingFS) Open(name string) (fs.File, error) {
+	c.mu.Lock()
+	c.opens[name]++
+	c.mu.Unlock()
+	return c.FS.Open(name)
+}
+
+func newTestFS() *countingFS {
+	return &countingFS{opens: make(map[string]int), FS: fstest.MapFS{
+		"services/users/asyncapi.yaml": {Data: []byte(`
+asyncapi: 2.6.0
+info:
+  title: Users
+  version: 1.0.0
+servers:
+  production:
+    url: kafka.example.com:9092
+    protocol: kafka
+channels:
+  user.signedup:
+    subscribe:
+      message:
+        $ref: ../../common/events.yaml
+`)},
+		"services/mailer/asyncapi.json": {Data: []byte(`{
+  "asyncapi": "2.6.0",
+  "info": {"title": "Mailer", "version": "1.0.0"},
+  "servers": {"kafka": {"url": "kafka.example.com:9092", "protocol": "kafka"}},
+  "channels": {
+    "signups": {
+      "bindings": {"kafka": {"topic": "user.signedup"}},
+      "publish": {"message": {"$ref": "../../common/events.yaml"}}
+    }
+  }
+}`)},
+		"services/audit/asyncapi.yml": {Data: []byte(`
+asyncapi: 2.6.0
+info:
+  title: Audit
+  version: 1.0.0
+channels:
+  user.signedup:
+    publish:
+      message:
+        $ref: '#/components/messages/Audited'
+components:
+  messages:
+    Audited:
+      payload:
+        type: object
+`)},
+		"services/broken/asyncapi.yaml": {Data: []byte(`
+asyncapi: 2.6.0
+info:
+  title: Broken
+  version: 1.0.0
+`)},
+		"common/events.yaml": {Data: []byte(`
+name: UserSignedUp
+payload:
+  $ref: schemas/user.json
+`)},
+		"common/schemas/user.json":  {Data: []byte(`{"type": "object"}`)},
+		".github/workflows/ci.yaml": {Data: []byte("asyncapi: not a spec\n")},
+	}}
+}
+
+func TestWorkspace_Load(t *testing.T) {
+	fsys := newTestFS()
+	w := New(fsys).WithConcurrency(4)
+	require.NoError(t, w.Load(context.Background()))
+
+	var paths []string
+	for _, spec := range w.Specs() {
+		paths = append(paths, spec.Path)
+	}
+	assert.Equal(t, []string{
+		"services/audit/asyncapi.yml",
+		"services/broken/asyncapi.yaml",
+		"services/mailer/asyncapi.json",
+		"services/users/asyncapi.yaml",
+	}, paths)
+
+	failed := w.Errors()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "services/broken/asyncapi.yaml", failed[0].Path)
+	assert.Nil(t, failed[0].Document)
+
+	users := w.Spec("services/users/asyncapi.yaml")
+	require.NotNil(t, users)
+	assert.Equal(t, []string{"common/events.yaml", "common/schemas/user.json"}, users.Refs)
+
+	// Common files are read once while looking for documents, then the
+	// shared ref cache means they're read once more for both services
+	assert.Equal(t, 2, fsys.opens["common/events.yaml"])
+	assert.Equal(t, 2, fsys.opens["common/schemas/user.json"])
+}
+
+func TestWorkspace_Queries(t *testing.T) {
+	w := New(newTestFS())
+	require.NoError(t, w.Load(context.Background()))
+
+	specPaths := func(specs []*Spec) []string {
+		var paths []string
+		for _, spec := range specs {
+			paths = append(paths, spec.Path)
+		}
+		return paths
+	}
+
+	assert.Equal(t, []string{"services/users/asyncapi.yaml"}, specPaths(w.Producers("user.signedup")))
+	assert.Equal(t, []string{"services/audit/asyncapi.yml", "services/mailer/asyncapi.json"}, specPaths(w.Consumers("user.signedup")))
+	assert.Equal(t, []string{"services/mailer/asyncapi.json", "services/users/asyncapi.yaml"}, specPaths(w.ReferencedBy("common/events.yaml")))
+	assert.Equal(t, []string{"services/mailer/asyncapi.json", "services/users/asyncapi.yaml"}, specPaths(w.ReferencedBy("./common/schemas/../events.yaml")))
+	assert.Empty(t, w.Producers("orders"))
+
+	channels := w.Channels("user.signedup")
+	require.Len(t, channels, 3)
+	assert.Equal(t, "signups", channels[1].Address)
+
+	servers := w.Servers("kafka.example.com:9092")
+	require.Len(t, servers, 2)
+	assert.Equal(t, "kafka", servers[0].Name)
+	assert.Equal(t, "production", servers[1].Name)
+
+	messages := w.Messages("UserSignedUp")
+	require.Len(t, messages, 2)
+	assert.Equal(t, "/channels/signups/publish/message", messages[0].Path)
+	assert.Equal(t, "/channels/user.signedup/subscribe/message", messages[1].Path)
+	assert.Len(t, w.Messages("Audited"), 1)
+
+	assert.Equal(t, []string{"signups", "user.signedup"}, w.ChannelAddresses())
+}
+
+func TestWorkspace_ParseOptions(t *testing.T) {
+	var mu sync.Mutex
+	var refs []string
+	w := New(newTestFS()).WithParseOptions(asyncapi.ParseOptions{
+		Validation: asyncapi.ValidationOff,
+		OnRef: func(location string) {
+			mu.Lock()
+			defer mu.Unlock()
+			refs = append(refs, location)
+		},
+	})
+	require.NoError(t, w.Load(context.Background()))
+
+	assert.Empty(t, w.Errors(), "validation is off")
+	assert.NotEmpty(t, refs)
+}
+
+func TestWorkspace_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := New(newTestFS()).Load(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,311 @@
+// Package yamlwriter writes edited AsyncAPI documents back to YAML, keeping
+// the comments and formatting of the source for the parts of the document
+// that weren't changed, so that programmatic edits produce readable diffs.
+package yamlwriter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	asyncapi "github.com/charlie-haley/asyncapi-go"
+	"github.com/charlie-haley/asyncapi-go/spec"
+	"gopkg.in/yaml.v3"
+)
+
+// Writer writes documents as YAML, merging them into a source document
+type Writer struct {
+	source []byte
+	opts   asyncapi.ParseOptions
+	indent int
+}
+
+// New creates a writer that merges documents into source, the JSON or YAML
+// they were parsed from. With no source, documents are written as is.
+func New(source []byte) *Writer {
+	return &Writer{source: source, indent: 2}
+}
+
+// WithParseOptions sets the options the source was parsed with, so that its
+// refs resolve to the same documents. Validation is always off when the
+// writer parses the source.
+func (w *Writer) WithParseOptions(opts asyncapi.ParseOptions) *Writer {
+	w.opts = opts
+	return w
+}
+
+// WithIndent sets the number of spaces to indent by, defaulting to 2
+func (w *Writer) WithIndent(n int) *Writer {
+	w.indent = n
+	return w
+}
+
+// Write writes doc to out as YAML. Values that are the same as in the source
+// are written as they appear there, including refs, quoting, comments and
+// fields the model doesn't hold, such as specification extensions. Changed
+// values are written from doc and keep the comments of the value they
+// replace, and new keys follow the existing ones. A ref is kept while the
+// value it stands for is unchanged, or for a local ref, while the value
+// still matches its target; otherwise it's replaced by the value.
+// Indentation is normalized.
+func (w *Writer) Write(out io.Writer, doc spec.Document) error {
+	root, err := w.merge(doc)
+	if err != nil {
+		return err
+	}
+
+	enc := yaml.NewEncoder(out)
+	enc.SetIndent(w.indent)
+	if err := enc.Encode(root); err != nil {
+		return fmt.Errorf("failed to write YAML: %w", err)
+	}
+	return enc.Close()
+}
+
+// Marshal returns doc as YAML, as written by Write
+func (w *Writer) Marshal(doc spec.Document) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := w.Write(&buf, doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// merge returns the YAML document to write for doc
+func (w *Writer) merge(doc spec.Document) (*yaml.Node, error) {
+	updated, err := modelNode(doc)
+	if err != nil {
+		return nil, err
+	}
+	if len(w.source) == 0 {
+		normalize(updated)
+		return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{updated}}, nil
+	}
+
+	var source yaml.Node
+	if err := yaml.Unmarshal(w.source, &source); err != nil {
+		return nil, fmt.Errorf("failed to parse source: %w", err)
+	}
+	if source.Kind != yaml.DocumentNode || len(source.Content) == 0 {
+		normalize(updated)
+		return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{updated}}, nil
+	}
+
+	// The source as the model holds it tells changed values apart from
+	// values the model holds differently, or not at all
+	opts := w.opts
+	opts.Validation = asyncapi.ValidationOff
+	originalDoc, err := asyncapi.Parse(w.source, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse source: %w", err)
+	}
+	original, err := modelNode(originalDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &merger{updated: updated}
+	source.Content[0] = m.mergeNode(source.Content[0], original, updated)
+	return &source, nil
+}
+
+// modelNode returns a document as the model marshals it
+func modelNode(doc spec.Document) (*yaml.Node, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal document: %w", err)
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, fmt.Errorf("failed to read marshalled document: %w", err)
+	}
+	return node.Content[0], nil
+}
+
+// merger merges an updated document into its source
+type merger struct {
+	// updated is the root of the updated document, which local refs are
+	// resolved against
+	updated *yaml.Node
+}
+
+// mergeNode returns the node to write for a value, given how it appears in
+// the source, how the model held it before any changes, which is nil if it
+// didn't, and how it holds it now
+func (m *merger) mergeNode(source, original, updated *yaml.Node) *yaml.Node {
+	if original != nil && equalNodes(original, updated) {
+		return source
+	}
+	if ref := refValue(source); ref != "" {
+		// A local ref still holds if its target was changed the same way
+		if target := m.localTarget(ref); target != nil && equalNodes(target, updated) {
+			return source
+		}
+	}
+
+	switch {
+	case source.Kind == yaml.MappingNode && updated.Kind == yaml.MappingNode && refValue(source) == "":
+		return m.mergeMapping(source, original, updated)
+	case source.Kind == yaml.SequenceNode && updated.Kind == yaml.SequenceNode:
+		return m.mergeSequence(source, original, updated)
+	}
+
+	normalize(updated)
+	if source.Kind == yaml.ScalarNode && updated.Kind == yaml.ScalarNode &&
+		source.ShortTag() == "!!str" && updated.ShortTag() == "!!str" {
+		updated.Style = source.Style
+	}
+	updated.HeadComment = source.HeadComment
+	updated.LineComment = source.LineComment
+	updated.FootComment = source.FootComment
+	return updated
+}
+
+func (m *merger) mergeMapping(source, original, updated *yaml.Node) *yaml.Node {
+	merged := *source
+	merged.Content = nil
+	inSource := make(map[string]bool)
+	for i := 0; i+1 < len(source.Content); i += 2 {
+		key, value := source.Content[i], source.Content[i+1]
+		inSource[key.Value] = true
+
+		updatedValue := mappingValue(updated, key.Value)
+		originalValue := mappingValue(original, key.Value)
+		switch {
+		case updatedValue != nil:
+			value = m.mergeNode(value, originalValue, updatedValue)
+		case originalValue != nil:
+			// Removed from the model
+			continue
+		}
+		merged.Content = append(merged.Content, key, value)
+	}
+
+	for i := 0; i+1 < len(updated.Content); i += 2 {
+		key, value := updated.Content[i], updated.Content[i+1]
+		if inSource[key.Value] {
+			continue
+		}
+		// Values the model holds for keys missing from the source, such as
+		// defaults, are only written when they've changed
+		if originalValue := mappingValue(original, key.Value); originalValue != nil && equalNodes(originalValue, value) {
+			continue
+		}
+		normalize(key)
+		normalize(value)
+		merged.Content = append(merged.Content, key, value)
+	}
+	return &merged
+}
+
+func (m *merger) mergeSequence(source, original, updated *yaml.Node) *yaml.Node {
+	merged := *source
+	merged.Content = nil
+	for i, value := range updated.Content {
+		if i >= len(source.Content) {
+			normalize(value)
+			merged.Content = append(merged.Content, value)
+			continue
+		}
+		var originalValue *yaml.Node
+		if original != nil && original.Kind == yaml.SequenceNode && i < len(original.Content) {
+			originalValue = original.Content[i]
+		}
+		merged.Content = append(merged.Content, m.mergeNode(source.Content[i], originalValue, value))
+	}
+	return &merged
+}
+
+// mappingValue returns the value of a key in a mapping, or nil
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping == nil || mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// refValue returns the $ref of a mapping, or "" if it isn't a reference
+func refValue(node *yaml.Node) string {
+	ref := mappingValue(node, "$ref")
+	if ref == nil || ref.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return ref.Value
+}
+
+// localTarget returns the value a ref within the document points to in the
+// updated document, or nil if it's not a local ref or the target is missing
+func (m *merger) localTarget(ref string) *yaml.Node {
+	pointer, ok := strings.CutPrefix(ref, "#")
+	if !ok {
+		return nil
+	}
+
+	node := m.updated
+	if pointer == "" {
+		return node
+	}
+	for _, segment := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		segment = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+		switch node.Kind {
+		case yaml.MappingNode:
+			node = mappingValue(node, segment)
+		case yaml.SequenceNode:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(node.Content) {
+				return nil
+			}
+			node = node.Content[i]
+		default:
+			return nil
+		}
+		if node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+// equalNodes reports whether two nodes decoded from JSON hold the same
+// value, ignoring key order
+func equalNodes(a, b *yaml.Node) bool {
+	if a.Kind != b.Kind || len(a.Content) != len(b.Content) {
+		return false
+	}
+	switch a.Kind {
+	case yaml.ScalarNode:
+		return a.ShortTag() == b.ShortTag() && a.Value == b.Value
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(a.Content); i += 2 {
+			value := mappingValue(b, a.Content[i].Value)
+			if value == nil || !equalNodes(a.Content[i+1], value) {
+				return false
+			}
+		}
+		return true
+	default:
+		for i := range a.Content {
+			if !equalNodes(a.Content[i], b.Content[i]) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// normalize clears the flow and quoting styles of a node decoded from JSON,
+// so it's written in block style and only quoted where YAML needs it
+func normalize(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		normalize(child)
+	}
+}
@@ -0,0 +1,150 @@
+package yamlwriter
+
+import (
+	"testing"
+
+	asyncapi "github.com/charlie-haley/asyncapi-go"
+	"github.com/charlie-haley/asyncapi-go/asyncapi2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const source = `# Users service
+asyncapi: '2.6.0'
+info:
+  title: Users
+  version: 1.0.0 # bumped by CI
+  x-owner: identity
+servers:
+  production:
+    url: broker.example.com
+    protocol: kafka
+channels:
+  # Emitted on signup
+  user/signedup:
+    description: A user signed up
+    subscribe:
+      message:
+        $ref: '#/components/messages/UserSignedUp'
+  user/deleted:
+    publish:
+      message:
+        payload: {type: string}
+components:
+  messages:
+    UserSignedUp:
+      payload:
+        type: object
+        properties:
+          id: {type: string, format: uuid}
+`
+
+func parse(t *testing.T) *asyncapi2.Document {
+	t.Helper()
+	doc, err := asyncapi.Parse([]byte(source))
+	require.NoError(t, err)
+	return doc.(*asyncapi2.Document)
+}
+
+func TestWriter_Unchanged(t *testing.T) {
+	out, err := New([]byte(source)).Marshal(parse(t))
+	require.NoError(t, err)
+	assert.Equal(t, source, string(out))
+}
+
+func TestWriter_Edits(t *testing.T) {
+	doc := parse(t)
+	doc.Info.Version = "1.1.0"
+	doc.Channels.Delete("user/deleted")
+	doc.WithChannel("user/updated", asyncapi2.NewChannel().
+		WithDescription("A user changed their profile").
+		WithPublish(asyncapi2.NewOperation()))
+	message, _ := doc.Components.Messages.Get("UserSignedUp")
+	message.Description = "Sent after signup"
+
+	out, err := New([]byte(source)).Marshal(doc)
+	require.NoError(t, err)
+	assert.Equal(t, `# Users service
+asyncapi: '2.6.0'
+info:
+  title: Users
+  version: 1.1.0 # bumped by CI
+  x-owner: identity
+servers:
+  production:
+    url: broker.example.com
+    protocol: kafka
+channels:
+  # Emitted on signup
+  user/signedup:
+    description: A user signed up
+    subscribe:
+      message:
+        $ref: '#/components/messages/UserSignedUp'
+  user/updated:
+    description: A user changed their profile
+    publish: {}
+components:
+  messages:
+    UserSignedUp:
+      payload:
+        type: object
+        properties:
+          id: {type: string, format: uuid}
+      description: Sent after signup
+`, string(out))
+}
+
+func TestWriter_Refs(t *testing.T) {
+	// Changing a referenced value the same way as its target keeps the ref
+	doc := parse(t)
+	for _, message := range []*asyncapi2.Message{
+		mustMessage(t, doc, "UserSignedUp"),
+		mustChannel(t, doc, "user/signedup").Subscribe.Message,
+	} {
+		message.Name = "UserSignedUp"
+	}
+	out, err := New([]byte(source)).Marshal(doc)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "      message:\n        $ref: '#/components/messages/UserSignedUp'\n")
+
+	// Otherwise the ref is replaced by the value
+	mustChannel(t, doc, "user/signedup").Subscribe.Message.Name = "Changed"
+	out, err = New([]byte(source)).Marshal(doc)
+	require.NoError(t, err)
+	assert.NotContains(t, string(out), "$ref")
+	assert.Contains(t, string(out), "        name: Changed\n")
+}
+
+func TestWriter_NoSource(t *testing.T) {
+	doc := asyncapi2.NewDocument().
+		WithInfo(asyncapi2.NewInfo().WithTitle("Users").WithVersion("1.0")).
+		WithChannel("b", asyncapi2.NewChannel()).
+		WithChannel("a", asyncapi2.NewChannel())
+
+	out, err := New(nil).WithIndent(4).Marshal(doc)
+	require.NoError(t, err)
+	assert.Equal(t, `asyncapi: 2.6.0
+info:
+    title: Users
+    version: "1.0"
+channels:
+    b: {}
+    a: {}
+components: {}
+`, string(out))
+}
+
+func mustChannel(t *testing.T, doc *asyncapi2.Document, name string) *asyncapi2.Channel {
+	t.Helper()
+	channel, ok := doc.Channels.Get(name)
+	require.True(t, ok)
+	return channel
+}
+
+func mustMessage(t *testing.T, doc *asyncapi2.Document, name string) *asyncapi2.Message {
+	t.Helper()
+	message, ok := doc.Components.Messages.Get(name)
+	require.True(t, ok)
+	return message
+}